@@ -30,6 +30,7 @@ import (
 	"strings"
 
 	"github.com/onflow/cadence"
+	"github.com/onflow/cadence/runtime/sema"
 )
 
 // A Decoder decodes JSON-encoded representations of Cadence values.
@@ -182,6 +183,8 @@ func decodeJSON(v interface{}) cadence.Value {
 		return decodeEvent(valueJSON)
 	case contractTypeStr:
 		return decodeContract(valueJSON)
+	case enumTypeStr:
+		return decodeEnum(valueJSON)
 	case storageReferenceTypeStr:
 		return decodeStorageReference(valueJSON)
 	case linkTypeStr:
@@ -562,6 +565,27 @@ func decodeContract(valueJSON interface{}) cadence.Contract {
 	})
 }
 
+func decodeEnum(valueJSON interface{}) cadence.Enum {
+	comp := decodeComposite(valueJSON)
+
+	// NOTE: the raw type of the enum is the type of its raw value field
+
+	var rawType cadence.Type
+	for _, field := range comp.fieldTypes {
+		if field.Identifier == sema.EnumRawValueFieldName {
+			rawType = field.Type
+			break
+		}
+	}
+
+	return cadence.NewEnum(comp.fieldValues).WithType(&cadence.EnumType{
+		TypeID:     comp.typeID,
+		Identifier: comp.identifier,
+		RawType:    rawType,
+		Fields:     comp.fieldTypes,
+	})
+}
+
 func decodeStorageReference(valueJSON interface{}) cadence.StorageReference {
 	obj := toObject(valueJSON)
 
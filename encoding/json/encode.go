@@ -168,6 +168,7 @@ const (
 	resourceTypeStr         = "Resource"
 	eventTypeStr            = "Event"
 	contractTypeStr         = "Contract"
+	enumTypeStr             = "Enum"
 	storageReferenceTypeStr = "StorageReference"
 	linkTypeStr             = "Link"
 )
@@ -238,6 +239,8 @@ func (e *Encoder) prepare(v cadence.Value) jsonValue {
 		return e.prepareEvent(x)
 	case cadence.Contract:
 		return e.prepareContract(x)
+	case cadence.Enum:
+		return e.prepareEnum(x)
 	case cadence.StorageReference:
 		return e.prepareStorageReference(x)
 	case cadence.Link:
@@ -470,6 +473,10 @@ func (e *Encoder) prepareContract(v cadence.Contract) jsonValue {
 	return e.prepareComposite(contractTypeStr, v.ContractType.ID(), v.ContractType.Fields, v.Fields)
 }
 
+func (e *Encoder) prepareEnum(v cadence.Enum) jsonValue {
+	return e.prepareComposite(enumTypeStr, v.EnumType.ID(), v.EnumType.Fields, v.Fields)
+}
+
 func (e *Encoder) prepareComposite(kind, id string, fieldTypes []cadence.Field, fields []cadence.Value) jsonValue {
 	nonFunctionFieldTypes := make([]cadence.Field, 0)
 
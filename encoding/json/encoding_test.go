@@ -957,6 +957,35 @@ func TestEncodeContract(t *testing.T) {
 	testAllEncodeAndDecode(t, simpleContract, resourceContract)
 }
 
+func TestEncodeEnum(t *testing.T) {
+
+	t.Parallel()
+
+	simpleEnumType := &cadence.EnumType{
+		TypeID:     "S.test.FooEnum",
+		Identifier: "FooEnum",
+		RawType:    cadence.UInt8Type{},
+		Fields: []cadence.Field{
+			{
+				Identifier: "rawValue",
+				Type:       cadence.UInt8Type{},
+			},
+		},
+	}
+
+	simpleEnum := encodeTest{
+		"Simple",
+		cadence.NewEnum(
+			[]cadence.Value{
+				cadence.NewUInt8(1),
+			},
+		).WithType(simpleEnumType),
+		`{"type":"Enum","value":{"id":"S.test.FooEnum","fields":[{"name":"rawValue","value":{"type":"UInt8","value":"1"}}]}}`,
+	}
+
+	testAllEncodeAndDecode(t, simpleEnum)
+}
+
 func TestEncodeStorageReference(t *testing.T) {
 
 	t.Parallel()
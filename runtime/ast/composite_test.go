@@ -94,6 +94,42 @@ func TestFieldDeclaration_MarshalJSON(t *testing.T) {
 	)
 }
 
+func TestEnumCaseDeclaration_MarshalJSON(t *testing.T) {
+
+	t.Parallel()
+
+	decl := &EnumCaseDeclaration{
+		Access: AccessPublic,
+		Identifier: Identifier{
+			Identifier: "xyz",
+			Pos:        Position{Offset: 1, Line: 2, Column: 3},
+		},
+		DocString: "test",
+		StartPos:  Position{Offset: 4, Line: 5, Column: 6},
+	}
+
+	actual, err := json.Marshal(decl)
+	require.NoError(t, err)
+
+	assert.JSONEq(t,
+		`
+        {
+            "Type": "EnumCaseDeclaration",
+            "Access": "AccessPublic",
+            "Identifier": {
+                "Identifier": "xyz",
+                "StartPos": {"Offset": 1, "Line": 2, "Column": 3},
+                "EndPos": {"Offset": 3, "Line": 2, "Column": 5}
+            },
+            "DocString": "test",
+            "StartPos": {"Offset": 4, "Line": 5, "Column": 6},
+            "EndPos": {"Offset": 3, "Line": 2, "Column": 5}
+        }
+        `,
+		string(actual),
+	)
+}
+
 func TestCompositeDeclaration_MarshalJSON(t *testing.T) {
 
 	t.Parallel()
@@ -34,6 +34,7 @@ const (
 	CompositeKindResource
 	CompositeKindContract
 	CompositeKindEvent
+	CompositeKindEnum
 )
 
 func CompositeKindCount() int {
@@ -63,6 +64,8 @@ func (k CompositeKind) Name() string {
 		return "contract"
 	case CompositeKindEvent:
 		return "event"
+	case CompositeKindEnum:
+		return "enum"
 	}
 
 	panic(errors.NewUnreachableError())
@@ -78,6 +81,8 @@ func (k CompositeKind) Keyword() string {
 		return "contract"
 	case CompositeKindEvent:
 		return "event"
+	case CompositeKindEnum:
+		return "enum"
 	}
 
 	panic(errors.NewUnreachableError())
@@ -108,6 +113,12 @@ func (k CompositeKind) DeclarationKind(isInterface bool) DeclarationKind {
 			return DeclarationKindUnknown
 		}
 		return DeclarationKindEvent
+
+	case CompositeKindEnum:
+		if isInterface {
+			return DeclarationKindUnknown
+		}
+		return DeclarationKindEnum
 	}
 
 	panic(errors.NewUnreachableError())
@@ -156,7 +167,9 @@ func (k CompositeKind) SupportsInterfaces() bool {
 
 		return true
 
-	case CompositeKindEvent:
+	case CompositeKindEvent,
+		CompositeKindEnum:
+
 		return false
 	}
 
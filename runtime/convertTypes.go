@@ -221,6 +221,14 @@ func exportCompositeType(t *sema.CompositeType, results map[sema.TypeID]cadence.
 			Fields:     fields,
 		}
 
+	case common.CompositeKindEnum:
+		result = &cadence.EnumType{
+			TypeID:     id,
+			Identifier: t.Identifier,
+			RawType:    exportType(t.EnumRawType, results),
+			Fields:     fields,
+		}
+
 	default:
 		panic(fmt.Sprintf("cannot export composite type %v of unknown kind %v", t, t.Kind))
 	}
@@ -26,6 +26,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/onflow/cadence"
+	"github.com/onflow/cadence/runtime/common"
 	"github.com/onflow/cadence/runtime/interpreter"
 	"github.com/onflow/cadence/runtime/sema"
 	"github.com/onflow/cadence/runtime/tests/utils"
@@ -564,6 +565,93 @@ func TestExportEventValue(t *testing.T) {
 	assert.Equal(t, expected, actual)
 }
 
+func TestExportEnumValue(t *testing.T) {
+
+	t.Parallel()
+
+	script := `
+        access(all) enum Foo: UInt8 {
+            access(all) case a
+            access(all) case b
+        }
+
+        access(all) fun main(): Foo {
+            return Foo.b
+        }
+    `
+
+	actual := exportValueFromScript(t, script)
+	expected := cadence.NewEnum([]cadence.Value{cadence.NewUInt8(1)}).WithType(fooEnumType)
+
+	assert.Equal(t, expected, actual)
+}
+
+func TestExportStructWithEnumFieldValue(t *testing.T) {
+
+	t.Parallel()
+
+	script := `
+        access(all) enum Bar: UInt8 {
+            access(all) case a
+            access(all) case b
+        }
+
+        access(all) struct Foo {
+            access(all) let bar: Bar
+
+            init(bar: Bar) {
+                self.bar = bar
+            }
+        }
+
+        access(all) fun main(): Foo {
+            return Foo(bar: Bar.a)
+        }
+    `
+
+	barEnumType := &cadence.EnumType{
+		TypeID:     fmt.Sprintf("S.%s.Bar", utils.TestLocation),
+		Identifier: "Bar",
+		RawType:    cadence.UInt8Type{},
+		Fields:     fooEnumFields,
+	}
+
+	actual := exportValueFromScript(t, script)
+	expected := cadence.NewStruct([]cadence.Value{
+		cadence.NewEnum([]cadence.Value{cadence.NewUInt8(0)}).WithType(barEnumType),
+	}).WithType(&cadence.StructType{
+		TypeID:     fooTypeID,
+		Identifier: fooID,
+		Fields: []cadence.Field{
+			{
+				Identifier: "bar",
+				Type:       barEnumType,
+			},
+		},
+	})
+
+	assert.Equal(t, expected, actual)
+}
+
+func TestImportEnumValue(t *testing.T) {
+
+	t.Parallel()
+
+	value := cadence.NewEnum([]cadence.Value{cadence.NewUInt8(1)}).WithType(fooEnumType)
+
+	actual := importValue(value)
+
+	require.IsType(t, &interpreter.CompositeValue{}, actual)
+	compositeValue := actual.(*interpreter.CompositeValue)
+
+	assert.Equal(t, common.CompositeKindEnum, compositeValue.Kind)
+	assert.Equal(t, sema.TypeID(fooTypeID), compositeValue.TypeID)
+	assert.Equal(t,
+		interpreter.UInt8Value(1),
+		compositeValue.Fields[sema.EnumRawValueFieldName],
+	)
+}
+
 // mock runtime.Interface to capture events
 type eventCapturingInterface struct {
 	EmptyRuntimeInterface
@@ -641,6 +729,20 @@ var fooResourceType = &cadence.ResourceType{
 	Fields:     fooResourceFields,
 }
 
+var fooEnumFields = []cadence.Field{
+	{
+		Identifier: "rawValue",
+		Type:       cadence.UInt8Type{},
+	},
+}
+
+var fooEnumType = &cadence.EnumType{
+	TypeID:     fooTypeID,
+	Identifier: fooID,
+	RawType:    cadence.UInt8Type{},
+	Fields:     fooEnumFields,
+}
+
 var fooEventType = &cadence.EventType{
 	TypeID:     fooTypeID,
 	Identifier: fooID,
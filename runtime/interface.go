@@ -108,6 +108,18 @@ type HighLevelStorage interface {
 	SetCadenceValue(owner Address, key string, value cadence.Value) (err error)
 }
 
+type StorageKeysEnumerator interface {
+	Interface
+
+	// StorageKeysEnumerationEnabled should return true
+	// if the functions of StorageKeysEnumerator should be called,
+	// e.g. GetStorageKeys
+	StorageKeysEnumerationEnabled() bool
+
+	// GetStorageKeys returns the keys of all values in the storage, owned by the given account.
+	GetStorageKeys(owner Address) (keys []string, err error)
+}
+
 type Metrics interface {
 	ProgramParsed(location ast.Location, duration time.Duration)
 	ProgramChecked(location ast.Location, duration time.Duration)
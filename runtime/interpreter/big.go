@@ -55,3 +55,19 @@ func UnsignedBigIntToBigEndianBytes(bigInt *big.Int) []byte {
 		panic(errors.NewUnreachableError())
 	}
 }
+
+func BigEndianBytesToSignedBigInt(bytes []byte) *big.Int {
+	result := new(big.Int).SetBytes(bytes)
+
+	// Decode two's complement
+	if len(bytes) > 0 && bytes[0]&0x80 != 0 {
+		offset := new(big.Int).Lsh(big.NewInt(1), uint(len(bytes)*8))
+		result.Sub(result, offset)
+	}
+
+	return result
+}
+
+func BigEndianBytesToUnsignedBigInt(bytes []byte) *big.Int {
+	return new(big.Int).SetBytes(bytes)
+}
@@ -19,6 +19,7 @@
 package interpreter
 
 import (
+	"github.com/onflow/cadence/runtime/errors"
 	"github.com/onflow/cadence/runtime/sema"
 )
 
@@ -178,3 +179,136 @@ func (AuthAccountDynamicType) IsDynamicType() {}
 type PublicAccountDynamicType struct{}
 
 func (PublicAccountDynamicType) IsDynamicType() {}
+
+// ConvertDynamicToSemaType returns the most specific type
+// which can be determined for a value of the given dynamic type.
+//
+// Arrays and dictionaries do not record their element types,
+// so the element type is inferred from the elements:
+// If all elements have the same type, it is used,
+// otherwise the element type is `AnyStruct` or `AnyResource`.
+//
+// Function values do not record their type,
+// so their type is `AnyStruct`.
+//
+func ConvertDynamicToSemaType(dynamicType DynamicType) sema.Type {
+	switch dynamicType := dynamicType.(type) {
+	case MetaTypeDynamicType:
+		return &sema.MetaType{}
+
+	case VoidDynamicType:
+		return &sema.VoidType{}
+
+	case StringDynamicType:
+		return &sema.StringType{}
+
+	case BoolDynamicType:
+		return &sema.BoolType{}
+
+	case AddressDynamicType:
+		return &sema.AddressType{}
+
+	case PathDynamicType:
+		return &sema.PathType{}
+
+	case AuthAccountDynamicType:
+		return &sema.AuthAccountType{}
+
+	case PublicAccountDynamicType:
+		return &sema.PublicAccountType{}
+
+	case NumberDynamicType:
+		return dynamicType.StaticType
+
+	case CompositeDynamicType:
+		return dynamicType.StaticType
+
+	case ArrayDynamicType:
+		elementTypes := make([]sema.Type, len(dynamicType.ElementTypes))
+		for i, elementType := range dynamicType.ElementTypes {
+			elementTypes[i] = ConvertDynamicToSemaType(elementType)
+		}
+
+		return &sema.VariableSizedType{
+			Type: commonSemaType(elementTypes),
+		}
+
+	case DictionaryDynamicType:
+		keyTypes := make([]sema.Type, len(dynamicType.EntryTypes))
+		valueTypes := make([]sema.Type, len(dynamicType.EntryTypes))
+		for i, entryType := range dynamicType.EntryTypes {
+			keyTypes[i] = ConvertDynamicToSemaType(entryType.KeyType)
+			valueTypes[i] = ConvertDynamicToSemaType(entryType.ValueType)
+		}
+
+		return &sema.DictionaryType{
+			KeyType:   commonSemaType(keyTypes),
+			ValueType: commonSemaType(valueTypes),
+		}
+
+	case NilDynamicType:
+		return &sema.OptionalType{
+			Type: &sema.NeverType{},
+		}
+
+	case SomeDynamicType:
+		return &sema.OptionalType{
+			Type: ConvertDynamicToSemaType(dynamicType.InnerType),
+		}
+
+	case ReferenceDynamicType:
+		return &sema.ReferenceType{
+			Authorized: dynamicType.Authorized(),
+			Type:       ConvertDynamicToSemaType(dynamicType.InnerType()),
+		}
+
+	case CapabilityDynamicType:
+		capabilityType := &sema.CapabilityType{}
+		if dynamicType.BorrowType != nil {
+			capabilityType.BorrowType = dynamicType.BorrowType
+		}
+		return capabilityType
+
+	case FunctionDynamicType:
+		return &sema.AnyStructType{}
+
+	default:
+		panic(errors.NewUnreachableError())
+	}
+}
+
+// commonSemaType returns the given types' common type if they are all equal.
+// Otherwise, it returns `AnyResource` if any of the types is a resource type,
+// and `AnyStruct` if not
+//
+func commonSemaType(types []sema.Type) sema.Type {
+	isResource := false
+	for _, ty := range types {
+		if ty.IsResourceType() {
+			isResource = true
+			break
+		}
+	}
+
+	if len(types) > 0 {
+		firstType := types[0]
+
+		allEqual := true
+		for _, ty := range types[1:] {
+			if !ty.Equal(firstType) {
+				allEqual = false
+				break
+			}
+		}
+
+		if allEqual {
+			return firstType
+		}
+	}
+
+	if isResource {
+		return &sema.AnyResourceType{}
+	}
+
+	return &sema.AnyStructType{}
+}
@@ -180,6 +180,48 @@ func (e DivisionByZeroError) Error() string {
 	return "division by zero"
 }
 
+// InvalidClampRangeError
+
+type InvalidClampRangeError struct {
+	Min NumberValue
+	Max NumberValue
+	LocationRange
+}
+
+func (e *InvalidClampRangeError) Error() string {
+	return fmt.Sprintf(
+		"invalid clamp range: minimum %s is greater than maximum %s",
+		e.Min,
+		e.Max,
+	)
+}
+
+// ArrayLengthMismatchError
+
+type ArrayLengthMismatchError struct {
+	ExpectedLength int
+	ActualLength   int
+	LocationRange
+}
+
+func (e *ArrayLengthMismatchError) Error() string {
+	return fmt.Sprintf(
+		"array length mismatch: expected %d, got %d",
+		e.ExpectedLength,
+		e.ActualLength,
+	)
+}
+
+// EmptySubstringError
+
+type EmptySubstringError struct {
+	LocationRange
+}
+
+func (e *EmptySubstringError) Error() string {
+	return "substring must not be empty"
+}
+
 // DestroyedCompositeError
 
 type DestroyedCompositeError struct {
@@ -225,6 +267,22 @@ func (e *TypeMismatchError) Error() string {
 	)
 }
 
+// StoredValueTypeMismatchError
+
+type StoredValueTypeMismatchError struct {
+	ExpectedType sema.Type
+	ActualType   sema.Type
+	LocationRange
+}
+
+func (e *StoredValueTypeMismatchError) Error() string {
+	return fmt.Sprintf(
+		"stored value has type `%s`, which is not a subtype of `%s`",
+		e.ActualType.QualifiedString(),
+		e.ExpectedType.QualifiedString(),
+	)
+}
+
 // InvalidPathDomainError
 
 type InvalidPathDomainError struct {
@@ -252,6 +310,16 @@ func (e *InvalidPathDomainError) SecondaryError() string {
 	)
 }
 
+// StorageIterationUnsupportedError
+
+type StorageIterationUnsupportedError struct {
+	LocationRange
+}
+
+func (e *StorageIterationUnsupportedError) Error() string {
+	return "iteration over stored values is not supported"
+}
+
 // OverwriteError
 
 type OverwriteError struct {
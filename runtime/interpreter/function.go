@@ -37,8 +37,11 @@ type Invocation struct {
 	Arguments          []Value
 	ArgumentTypes      []sema.Type
 	TypeParameterTypes map[*sema.TypeParameter]sema.Type
-	LocationRange      LocationRange
-	Interpreter        *Interpreter
+	// OverloadIndex is the index of the invoked overload,
+	// if the invoked function is overloaded
+	OverloadIndex int
+	LocationRange LocationRange
+	Interpreter   *Interpreter
 }
 
 // FunctionValue
@@ -155,7 +158,15 @@ func (f HostFunctionValue) Invoke(invocation Invocation) Trampoline {
 }
 
 func (f HostFunctionValue) GetMember(_ *Interpreter, _ LocationRange, name string) Value {
-	return f.Members[name]
+	value, ok := f.Members[name]
+	if !ok {
+		return nil
+	}
+
+	// The members are shared by all accesses, e.g. the `values` of an enum,
+	// so return a copy, so that mutating the result does not affect later accesses
+
+	return value.Copy()
 }
 
 func (f HostFunctionValue) SetMember(_ *Interpreter, _ LocationRange, _ string, _ Value) {
@@ -20,7 +20,9 @@ package interpreter
 
 import (
 	"fmt"
+	"math/big"
 	goRuntime "runtime"
+	"strings"
 
 	"github.com/onflow/cadence/fixedpoint"
 	"github.com/onflow/cadence/runtime/activations"
@@ -126,6 +128,14 @@ type StorageWriteHandlerFunc func(
 	value OptionalValue,
 )
 
+// StorageKeysHandlerFunc is a function that handles the enumeration
+// of the keys of the stored values of an account.
+//
+type StorageKeysHandlerFunc func(
+	inter *Interpreter,
+	storageAddress common.Address,
+) []string
+
 // StorageKeyHandlerFunc is a function that handles storage indexing types.
 //
 type StorageKeyHandlerFunc func(
@@ -228,6 +238,7 @@ type Interpreter struct {
 	storageReadHandler             StorageReadHandlerFunc
 	storageWriteHandler            StorageWriteHandlerFunc
 	storageKeyHandler              StorageKeyHandlerFunc
+	storageKeysHandler             StorageKeysHandlerFunc
 	injectedCompositeFieldsHandler InjectedCompositeFieldsHandlerFunc
 	contractValueHandler           ContractValueHandlerFunc
 	importLocationHandler          ImportLocationHandlerFunc
@@ -334,6 +345,16 @@ func WithStorageKeyHandler(handler StorageKeyHandlerFunc) Option {
 	}
 }
 
+// WithStorageKeysHandler returns an interpreter option which sets the given function
+// as the function that is used when the stored values of an account are enumerated.
+//
+func WithStorageKeysHandler(handler StorageKeysHandlerFunc) Option {
+	return func(interpreter *Interpreter) error {
+		interpreter.SetStorageKeysHandler(handler)
+		return nil
+	}
+}
+
 // WithInjectedCompositeFieldsHandler returns an interpreter option which sets the given function
 // as the function that is used to initialize new composite values' fields
 //
@@ -480,6 +501,12 @@ func (interpreter *Interpreter) SetStorageKeyHandler(function StorageKeyHandlerF
 	interpreter.storageKeyHandler = function
 }
 
+// SetStorageKeysHandler sets the function that is used when the stored values of an account are enumerated.
+//
+func (interpreter *Interpreter) SetStorageKeysHandler(function StorageKeysHandlerFunc) {
+	interpreter.storageKeysHandler = function
+}
+
 // SetInjectedCompositeFieldsHandler sets the function that is used to initialize
 // new composite values' fields
 //
@@ -1535,6 +1562,31 @@ func (interpreter *Interpreter) visitNumberBinaryOperation(
 		})
 }
 
+// visitComparisonBinaryOperation evaluates an ordering comparison.
+// Numbers are compared using the given number comparison function,
+// addresses are compared numerically and the result is tested
+// using the given address comparison result test function
+//
+func (interpreter *Interpreter) visitComparisonBinaryOperation(
+	expression *ast.BinaryExpression,
+	compareNumbers func(left, right NumberValue) Value,
+	testAddressComparison func(comparison int) bool,
+) ast.Repr {
+	return interpreter.visitBinaryOperation(expression).
+		Map(func(result interface{}) interface{} {
+			tuple := result.(valueTuple)
+
+			if left, ok := tuple.left.(AddressValue); ok {
+				right := tuple.right.(AddressValue)
+				return BoolValue(testAddressComparison(left.Compare(right)))
+			}
+
+			left := tuple.left.(NumberValue)
+			right := tuple.right.(NumberValue)
+			return compareNumbers(left, right)
+		})
+}
+
 func (interpreter *Interpreter) VisitBinaryExpression(expression *ast.BinaryExpression) ast.Repr {
 	switch expression.Operation {
 	case ast.OperationPlus:
@@ -1628,35 +1680,47 @@ func (interpreter *Interpreter) VisitBinaryExpression(expression *ast.BinaryExpr
 		)
 
 	case ast.OperationLess:
-		return interpreter.visitNumberBinaryOperation(
+		return interpreter.visitComparisonBinaryOperation(
 			expression,
 			func(left, right NumberValue) Value {
 				return left.Less(right)
 			},
+			func(comparison int) bool {
+				return comparison < 0
+			},
 		)
 
 	case ast.OperationLessEqual:
-		return interpreter.visitNumberBinaryOperation(
+		return interpreter.visitComparisonBinaryOperation(
 			expression,
 			func(left, right NumberValue) Value {
 				return left.LessEqual(right)
 			},
+			func(comparison int) bool {
+				return comparison <= 0
+			},
 		)
 
 	case ast.OperationGreater:
-		return interpreter.visitNumberBinaryOperation(
+		return interpreter.visitComparisonBinaryOperation(
 			expression,
 			func(left, right NumberValue) Value {
 				return left.Greater(right)
 			},
+			func(comparison int) bool {
+				return comparison > 0
+			},
 		)
 
 	case ast.OperationGreaterEqual:
-		return interpreter.visitNumberBinaryOperation(
+		return interpreter.visitComparisonBinaryOperation(
 			expression,
 			func(left, right NumberValue) Value {
 				return left.GreaterEqual(right)
 			},
+			func(comparison int) bool {
+				return comparison >= 0
+			},
 		)
 
 	case ast.OperationEqual:
@@ -1758,10 +1822,6 @@ func (interpreter *Interpreter) testEqual(left, right Value) BoolValue {
 		}
 		return left.Equal(interpreter, right)
 
-	case *CompositeValue:
-		// TODO: call `equals` if RHS is composite
-		return false
-
 	case *ArrayValue,
 		*DictionaryValue:
 		// TODO:
@@ -1928,7 +1988,18 @@ func (interpreter *Interpreter) VisitMemberExpression(expression *ast.MemberExpr
 
 			value := result.(Value)
 			locationRange := interpreter.locationRange(expression)
-			resultValue := interpreter.getMember(value, locationRange, expression.Identifier.Identifier)
+			identifier := expression.Identifier.Identifier
+
+			var resultValue Value
+			if interpreter.isBuiltinMemberAccess(expression) {
+				self := interpreter.dereference(value, locationRange)
+				resultValue = interpreter.getBuiltinMember(self, identifier)
+				if resultValue == nil {
+					panic(errors.NewUnreachableError())
+				}
+			} else {
+				resultValue = interpreter.getMember(value, locationRange, identifier)
+			}
 
 			// If the member access is optional chaining, only wrap the result value
 			// in an optional, if it is not already an optional value
@@ -1942,6 +2013,53 @@ func (interpreter *Interpreter) VisitMemberExpression(expression *ast.MemberExpr
 		})
 }
 
+// isBuiltinMemberAccess returns true if the member expression accesses
+// a built-in member of a top type, e.g. `getType` of `AnyStruct`.
+//
+// The accessed value might have a user-defined member with the same name,
+// which must not be used, as the access was type-checked against the built-in member
+//
+func (interpreter *Interpreter) isBuiltinMemberAccess(expression *ast.MemberExpression) bool {
+	memberInfo, ok := interpreter.Checker.Elaboration.MemberExpressionMemberInfos[expression]
+	if !ok || memberInfo.Member == nil {
+		return false
+	}
+
+	switch memberInfo.Member.ContainerType.(type) {
+	case *sema.AnyType, *sema.AnyStructType, *sema.AnyResourceType:
+		return true
+
+	default:
+		return false
+	}
+}
+
+// dereference returns the value referenced by the given value, if it is a reference,
+// or the value itself otherwise
+//
+func (interpreter *Interpreter) dereference(value Value, locationRange LocationRange) Value {
+	var referencedValue *Value
+
+	switch reference := value.(type) {
+	case *EphemeralReferenceValue:
+		referencedValue = reference.referencedValue()
+
+	case *StorageReferenceValue:
+		referencedValue = reference.referencedValue(interpreter)
+
+	default:
+		return value
+	}
+
+	if referencedValue == nil {
+		panic(&DereferenceError{
+			LocationRange: locationRange,
+		})
+	}
+
+	return *referencedValue
+}
+
 func (interpreter *Interpreter) VisitIndexExpression(expression *ast.IndexExpression) ast.Repr {
 	return expression.TargetExpression.Accept(interpreter).(Trampoline).
 		FlatMap(func(result interface{}) Trampoline {
@@ -2015,6 +2133,8 @@ func (interpreter *Interpreter) VisitInvocationExpression(invocationExpression *
 						interpreter.Checker.Elaboration.InvocationExpressionArgumentTypes[invocationExpression]
 					parameterTypes :=
 						interpreter.Checker.Elaboration.InvocationExpressionParameterTypes[invocationExpression]
+					overloadIndex :=
+						interpreter.Checker.Elaboration.InvocationExpressionOverloadIndices[invocationExpression]
 
 					invocation := interpreter.functionValueInvocationTrampoline(
 						function,
@@ -2022,6 +2142,7 @@ func (interpreter *Interpreter) VisitInvocationExpression(invocationExpression *
 						argumentTypes,
 						parameterTypes,
 						typeParameterTypes,
+						overloadIndex,
 						ast.NewRangeFromPositioned(invocationExpression),
 					)
 
@@ -2059,6 +2180,7 @@ func (interpreter *Interpreter) InvokeFunctionValue(
 		argumentTypes,
 		parameterTypes,
 		nil,
+		0,
 		invocationRange,
 	)
 
@@ -2075,6 +2197,7 @@ func (interpreter *Interpreter) functionValueInvocationTrampoline(
 	argumentTypes []sema.Type,
 	parameterTypes []sema.Type,
 	typeParameterTypes map[*sema.TypeParameter]sema.Type,
+	overloadIndex int,
 	invocationRange ast.Range,
 ) Trampoline {
 
@@ -2103,6 +2226,7 @@ func (interpreter *Interpreter) functionValueInvocationTrampoline(
 			Arguments:          argumentCopies,
 			ArgumentTypes:      argumentTypes,
 			TypeParameterTypes: typeParameterTypes,
+			OverloadIndex:      overloadIndex,
 			LocationRange:      locationRange,
 			Interpreter:        interpreter,
 		},
@@ -2355,22 +2479,25 @@ func (interpreter *Interpreter) declareCompositeValue(
 	compositeType := interpreter.Checker.Elaboration.CompositeDeclarationTypes[declaration]
 	typeID := compositeType.ID()
 
-	var initializerFunction FunctionValue
+	// NOTE: the initializer functions are in declaration order,
+	// i.e. the index of an initializer function is the overload index
+	// of an invocation of the constructor
+
+	var initializerFunctions []FunctionValue
 	if declaration.CompositeKind == common.CompositeKindEvent {
-		initializerFunction = NewHostFunctionValue(
-			func(invocation Invocation) Trampoline {
-				for i, argument := range invocation.Arguments {
-					parameter := compositeType.ConstructorParameters[i]
-					invocation.Self.Fields[parameter.Identifier] = argument
-				}
-				return Done{}
-			},
-		)
-	} else {
-		compositeInitializerFunction := interpreter.compositeInitializerFunction(declaration, lexicalScope)
-		if compositeInitializerFunction != nil {
-			initializerFunction = *compositeInitializerFunction
+		initializerFunctions = []FunctionValue{
+			NewHostFunctionValue(
+				func(invocation Invocation) Trampoline {
+					for i, argument := range invocation.Arguments {
+						parameter := compositeType.ConstructorParameters[i]
+						invocation.Self.Fields[parameter.Identifier] = argument
+					}
+					return Done{}
+				},
+			),
 		}
+	} else {
+		initializerFunctions = interpreter.compositeInitializerFunctions(declaration, lexicalScope)
 	}
 
 	var destructorFunction FunctionValue
@@ -2383,13 +2510,20 @@ func (interpreter *Interpreter) declareCompositeValue(
 
 	wrapFunctions := func(code WrapperCode) {
 
-		// Wrap initializer
+		// Wrap initializer.
+		//
+		// NOTE: the checker rejects overloaded initializers
+		// if a conformance declares an initializer requirement,
+		// so a wrapper only ever applies to a single initializer
 
 		initializerFunctionWrapper :=
 			code.InitializerFunctionWrapper
 
 		if initializerFunctionWrapper != nil {
-			initializerFunction = initializerFunctionWrapper(initializerFunction)
+			if len(initializerFunctions) == 0 {
+				initializerFunctions = []FunctionValue{nil}
+			}
+			initializerFunctions[0] = initializerFunctionWrapper(initializerFunctions[0])
 		}
 
 		// Wrap destructor
@@ -2481,7 +2615,9 @@ func (interpreter *Interpreter) declareCompositeValue(
 
 			var initializationTrampoline Trampoline = Done{}
 
-			if initializerFunction != nil {
+			if len(initializerFunctions) > 0 {
+				initializerFunction := initializerFunctions[invocation.OverloadIndex]
+
 				// NOTE: arguments are already properly boxed by invocation expression
 
 				initializationTrampoline = initializerFunction.Invoke(invocation)
@@ -2494,6 +2630,13 @@ func (interpreter *Interpreter) declareCompositeValue(
 		},
 	)
 
+	// Enum declarations declare a constructor which looks up
+	// an existing case, instead of creating a new value
+
+	if declaration.CompositeKind == common.CompositeKindEnum {
+		constructor = interpreter.declareEnumConstructor(declaration, compositeType, members)
+	}
+
 	// Contract declarations declare a value / instance (singleton),
 	// for all other composite kinds, the constructor is declared
 
@@ -2519,20 +2662,94 @@ func (interpreter *Interpreter) declareCompositeValue(
 	return lexicalScope, value
 }
 
-func (interpreter *Interpreter) compositeInitializerFunction(
+// declareEnumConstructor creates the values for the cases of the given enum declaration,
+// declares them as members of the constructor, i.e. in the given `members`,
+// together with the array of all cases, `values`, and returns the constructor.
+//
+// The raw values of the cases are their indices in declaration order.
+// The constructor returns the case with the given raw value, or nil if there is no such case.
+//
+func (interpreter *Interpreter) declareEnumConstructor(
+	declaration *ast.CompositeDeclaration,
+	compositeType *sema.CompositeType,
+	members map[string]Value,
+) HostFunctionValue {
+
+	location := interpreter.Checker.Location
+	typeID := compositeType.ID()
+
+	enumCases := declaration.Members.EnumCases()
+	caseValues := make([]*CompositeValue, len(enumCases))
+
+	for i, enumCase := range enumCases {
+		rawValue := interpreter.convert(
+			NewIntValueFromInt64(int64(i)),
+			&sema.IntType{},
+			compositeType.EnumRawType,
+		)
+
+		caseValue := &CompositeValue{
+			Location: location,
+			TypeID:   typeID,
+			Kind:     declaration.CompositeKind,
+			Fields: map[string]Value{
+				sema.EnumRawValueFieldName: rawValue,
+			},
+			Functions: map[string]FunctionValue{},
+			// NOTE: new value has no owner
+			Owner:    nil,
+			modified: true,
+		}
+
+		caseValues[i] = caseValue
+		members[enumCase.Identifier.Identifier] = caseValue
+	}
+
+	values := make([]Value, len(caseValues))
+	for i, caseValue := range caseValues {
+		values[i] = caseValue
+	}
+
+	members[sema.EnumValuesFieldName] = NewArrayValueUnownedNonCopying(values...)
+
+	return NewHostFunctionValue(
+		func(invocation Invocation) Trampoline {
+			rawValue := invocation.Arguments[0]
+
+			for _, caseValue := range caseValues {
+				if interpreter.testEqual(caseValue.Fields[sema.EnumRawValueFieldName], rawValue) {
+					return Done{Result: NewSomeValueOwningNonCopying(caseValue)}
+				}
+			}
+
+			return Done{Result: NilValue{}}
+		},
+	)
+}
+
+func (interpreter *Interpreter) compositeInitializerFunctions(
 	compositeDeclaration *ast.CompositeDeclaration,
 	lexicalScope activations.Activation,
-) *InterpretedFunctionValue {
-
-	// TODO: support multiple overloaded initializers
+) []FunctionValue {
 
 	initializers := compositeDeclaration.Members.Initializers()
-	var initializer *ast.SpecialFunctionDeclaration
 	if len(initializers) == 0 {
 		return nil
 	}
 
-	initializer = initializers[0]
+	initializerFunctions := make([]FunctionValue, len(initializers))
+	for i, initializer := range initializers {
+		initializerFunctions[i] = interpreter.compositeInitializerFunction(initializer, lexicalScope)
+	}
+
+	return initializerFunctions
+}
+
+func (interpreter *Interpreter) compositeInitializerFunction(
+	initializer *ast.SpecialFunctionDeclaration,
+	lexicalScope activations.Activation,
+) InterpretedFunctionValue {
+
 	functionType := interpreter.Checker.Elaboration.SpecialFunctionTypes[initializer].FunctionType
 
 	parameterList := initializer.FunctionDeclaration.ParameterList
@@ -2556,7 +2773,7 @@ func (interpreter *Interpreter) compositeInitializerFunction(
 		rewrittenPostConditions = postConditionsRewrite.RewrittenPostConditions
 	}
 
-	return &InterpretedFunctionValue{
+	return InterpretedFunctionValue{
 		Interpreter:      interpreter,
 		ParameterList:    parameterList,
 		Type:             functionType,
@@ -2700,6 +2917,11 @@ func (interpreter *Interpreter) VisitFieldDeclaration(_ *ast.FieldDeclaration) a
 	panic(errors.NewUnreachableError())
 }
 
+func (interpreter *Interpreter) VisitEnumCaseDeclaration(_ *ast.EnumCaseDeclaration) ast.Repr {
+	// enum cases can't be interpreted, the enum's case values are declared in `declareEnumConstructor`
+	panic(errors.NewUnreachableError())
+}
+
 func (interpreter *Interpreter) copyAndConvert(value Value, valueType, targetType sema.Type) Value {
 	return interpreter.convertAndBox(value.Copy(), valueType, targetType)
 }
@@ -3096,6 +3318,7 @@ func (interpreter *Interpreter) ensureLoaded(
 		WithStorageReadHandler(interpreter.storageReadHandler),
 		WithStorageWriteHandler(interpreter.storageWriteHandler),
 		WithStorageKeyHandler(interpreter.storageKeyHandler),
+		WithStorageKeysHandler(interpreter.storageKeysHandler),
 		WithInjectedCompositeFieldsHandler(interpreter.injectedCompositeFieldsHandler),
 		WithContractValueHandler(interpreter.contractValueHandler),
 		WithImportLocationHandler(interpreter.importLocationHandler),
@@ -3478,11 +3701,45 @@ func init() {
 	}
 }
 
+// integerTypes are the leaf integer types, by name,
+// which have a `fromBigEndianBytes` function
+//
+var integerTypes = map[string]sema.IntegerRangedType{}
+
+func init() {
+	for _, integerType := range sema.AllIntegerTypes {
+		switch integerType.(type) {
+		case *sema.IntegerType, *sema.SignedIntegerType:
+			continue
+		}
+
+		integerTypes[integerType.String()] = integerType.(sema.IntegerRangedType)
+	}
+}
+
 func (interpreter *Interpreter) defineBaseFunctions() {
 	for name, converter := range converters {
+		function := interpreter.newConverterFunction(converter)
+
+		if integerType, ok := integerTypes[name]; ok {
+			function.Members = map[string]Value{
+				sema.NumberTypeFromBigEndianBytesFunctionName: interpreter.newFromBigEndianBytesFunction(
+					integerType,
+					converter,
+				),
+			}
+		}
+
+		err := interpreter.ImportValue(name, function)
+		if err != nil {
+			panic(errors.NewUnreachableError())
+		}
+	}
+
+	for domain, name := range sema.PathConstructorFunctionNames {
 		err := interpreter.ImportValue(
 			name,
-			interpreter.newConverterFunction(converter),
+			newPathConstructorFunction(domain),
 		)
 		if err != nil {
 			panic(errors.NewUnreachableError())
@@ -3514,7 +3771,29 @@ func (interpreter *Interpreter) defineBaseFunctions() {
 	}
 }
 
-func (interpreter *Interpreter) newConverterFunction(converter ValueConverter) FunctionValue {
+// newPathConstructorFunction returns a function which constructs a path
+// of the given domain from an identifier, or returns nil if the identifier is invalid
+//
+func newPathConstructorFunction(domain common.PathDomain) FunctionValue {
+	return NewHostFunctionValue(
+		func(invocation Invocation) Trampoline {
+			identifier := invocation.Arguments[0].(*StringValue).Str
+
+			if !sema.IsValidPathIdentifier(identifier) {
+				return Done{Result: NilValue{}}
+			}
+
+			path := PathValue{
+				Domain:     domain,
+				Identifier: identifier,
+			}
+
+			return Done{Result: NewSomeValueOwningNonCopying(path)}
+		},
+	)
+}
+
+func (interpreter *Interpreter) newConverterFunction(converter ValueConverter) HostFunctionValue {
 	return NewHostFunctionValue(
 		func(invocation Invocation) Trampoline {
 			value := invocation.Arguments[0]
@@ -3523,6 +3802,59 @@ func (interpreter *Interpreter) newConverterFunction(converter ValueConverter) F
 	)
 }
 
+// newFromBigEndianBytesFunction returns the `fromBigEndianBytes` function of the given integer type.
+//
+// Fixed-size integer types of up to 64 bits require exactly as many bytes as their width,
+// as their `toBigEndianBytes` function always returns the full width.
+// Larger fixed-size integer types accept at most as many bytes as their width,
+// and arbitrary-precision integer types accept any number of bytes.
+//
+func (interpreter *Interpreter) newFromBigEndianBytesFunction(
+	integerType sema.IntegerRangedType,
+	converter ValueConverter,
+) HostFunctionValue {
+
+	signed := sema.IsSubType(integerType, &sema.SignedIntegerType{})
+
+	// Arbitrary-precision integer types have no maximum, and so no width
+
+	var width int
+	if maxInt := integerType.MaxInt(); maxInt != nil {
+		bitLength := maxInt.BitLen()
+		if signed {
+			bitLength++
+		}
+		width = bitLength / 8
+	}
+
+	return NewHostFunctionValue(
+		func(invocation Invocation) Trampoline {
+			bytes, err := ByteArrayValueToByteSlice(invocation.Arguments[0])
+			if err != nil {
+				panic(err)
+			}
+
+			if width > 0 {
+				if width <= 8 && len(bytes) != width ||
+					len(bytes) > width {
+
+					return Done{Result: NilValue{}}
+				}
+			}
+
+			var bigInt *big.Int
+			if signed {
+				bigInt = BigEndianBytesToSignedBigInt(bytes)
+			} else {
+				bigInt = BigEndianBytesToUnsignedBigInt(bytes)
+			}
+
+			result := converter(NewIntValueFromBigInt(bigInt), interpreter)
+			return Done{Result: NewSomeValueOwningNonCopying(result)}
+		},
+	)
+}
+
 // TODO:
 // - FunctionType
 //
@@ -3714,6 +4046,26 @@ func storageKey(path PathValue) string {
 	return fmt.Sprintf("%s\x1F%s", path.Domain.Identifier(), path.Identifier)
 }
 
+// storagePathFromKey returns the path for the given storage key,
+// i.e. it is the inverse of storageKey
+//
+func storagePathFromKey(key string) (PathValue, bool) {
+	separatorIndex := strings.IndexRune(key, '\x1F')
+	if separatorIndex < 0 {
+		return PathValue{}, false
+	}
+
+	domain := common.PathDomainFromIdentifier(key[:separatorIndex])
+	if domain == common.PathDomainUnknown {
+		return PathValue{}, false
+	}
+
+	return PathValue{
+		Domain:     domain,
+		Identifier: key[separatorIndex+1:],
+	}, true
+}
+
 func mustPathDomain(
 	path PathValue,
 	locationRange LocationRange,
@@ -3785,15 +4137,59 @@ func (interpreter *Interpreter) authAccountSaveFunction(addressValue AddressValu
 	})
 }
 
+func (interpreter *Interpreter) authAccountTrySaveFunction(addressValue AddressValue) HostFunctionValue {
+	return NewHostFunctionValue(func(invocation Invocation) Trampoline {
+
+		value := invocation.Arguments[0]
+		path := invocation.Arguments[1].(PathValue)
+
+		address := addressValue.ToAddress()
+		key := storageKey(path)
+
+		// Ensure the path has a `storage` domain
+
+		mustPathDomain(
+			path,
+			invocation.LocationRange,
+			common.PathDomainStorage,
+		)
+
+		// Prevent an overwrite
+
+		if interpreter.storedValueExists(address, key) {
+			return Done{Result: BoolValue(false)}
+		}
+
+		// Write new value
+
+		interpreter.writeStored(
+			address,
+			key,
+			NewSomeValueOwningNonCopying(value),
+		)
+
+		return Done{Result: BoolValue(true)}
+	})
+}
+
 func (interpreter *Interpreter) authAccountLoadFunction(addressValue AddressValue) HostFunctionValue {
-	return interpreter.authAccountReadFunction(addressValue, true)
+	return interpreter.authAccountReadFunction(addressValue, true, false)
+}
+
+func (interpreter *Interpreter) authAccountLoadStrictFunction(addressValue AddressValue) HostFunctionValue {
+	return interpreter.authAccountReadFunction(addressValue, true, true)
 }
 
 func (interpreter *Interpreter) authAccountCopyFunction(addressValue AddressValue) HostFunctionValue {
-	return interpreter.authAccountReadFunction(addressValue, false)
+	return interpreter.authAccountReadFunction(addressValue, false, false)
 }
 
-func (interpreter *Interpreter) authAccountReadFunction(addressValue AddressValue, clear bool) HostFunctionValue {
+// authAccountReadFunction returns a function which reads a value from storage.
+// If clear is true, the value is removed from storage.
+// If strict is true, the function aborts if the stored value does not have the requested type,
+// instead of returning nil.
+//
+func (interpreter *Interpreter) authAccountReadFunction(addressValue AddressValue, clear bool, strict bool) HostFunctionValue {
 
 	return NewHostFunctionValue(func(invocation Invocation) Trampoline {
 
@@ -3831,6 +4227,16 @@ func (interpreter *Interpreter) authAccountReadFunction(addressValue AddressValu
 
 			dynamicType := value.Value.DynamicType(interpreter)
 			if !IsSubType(dynamicType, ty) {
+				if strict {
+					panic(
+						&StoredValueTypeMismatchError{
+							ExpectedType:  ty,
+							ActualType:    ConvertDynamicToSemaType(dynamicType),
+							LocationRange: invocation.LocationRange,
+						},
+					)
+				}
+
 				return Done{Result: NilValue{}}
 			}
 
@@ -3849,6 +4255,120 @@ func (interpreter *Interpreter) authAccountReadFunction(addressValue AddressValu
 	})
 }
 
+func (interpreter *Interpreter) authAccountTypeFunction(addressValue AddressValue) HostFunctionValue {
+
+	return NewHostFunctionValue(func(invocation Invocation) Trampoline {
+
+		address := addressValue.ToAddress()
+
+		path := invocation.Arguments[0].(PathValue)
+		key := storageKey(path)
+
+		// Ensure the path has a `storage` domain
+
+		mustPathDomain(
+			path,
+			invocation.LocationRange,
+			common.PathDomainStorage,
+		)
+
+		value := interpreter.readStored(address, key, false)
+
+		switch value := value.(type) {
+		case NilValue:
+			return Done{Result: value}
+
+		case *SomeValue:
+			dynamicType := value.Value.DynamicType(interpreter)
+
+			typeValue := TypeValue{
+				Type: ConvertSemaToStaticType(
+					ConvertDynamicToSemaType(dynamicType),
+				),
+			}
+
+			return Done{Result: NewSomeValueOwningNonCopying(typeValue)}
+
+		default:
+			panic(errors.NewUnreachableError())
+		}
+	})
+}
+
+func (interpreter *Interpreter) authAccountForEachStoredFunction(addressValue AddressValue) HostFunctionValue {
+	return NewHostFunctionValue(func(invocation Invocation) Trampoline {
+
+		if interpreter.storageKeysHandler == nil {
+			panic(&StorageIterationUnsupportedError{
+				LocationRange: invocation.LocationRange,
+			})
+		}
+
+		address := addressValue.ToAddress()
+
+		function := invocation.Arguments[0].(FunctionValue)
+
+		functionType := invocation.ArgumentTypes[0].(*sema.FunctionType)
+		parameterTypes := []sema.Type{
+			functionType.Parameters[0].TypeAnnotation.Type,
+			functionType.Parameters[1].TypeAnnotation.Type,
+		}
+
+		// Only iterate over the values stored in the `storage` domain.
+		// NOTE: determine the keys before iterating,
+		// as the function might modify storage
+
+		var paths []PathValue
+
+		for _, key := range interpreter.storageKeysHandler(interpreter, address) {
+			path, ok := storagePathFromKey(key)
+			if !ok || path.Domain != common.PathDomainStorage {
+				continue
+			}
+			paths = append(paths, path)
+		}
+
+		var iterate func(index int) Trampoline
+		iterate = func(index int) Trampoline {
+			if index >= len(paths) {
+				return Done{Result: VoidValue{}}
+			}
+
+			path := paths[index]
+
+			someValue, ok := interpreter.readStored(address, storageKey(path), false).(*SomeValue)
+			if !ok {
+				return iterate(index + 1)
+			}
+
+			dynamicType := someValue.Value.DynamicType(interpreter)
+
+			typeValue := TypeValue{
+				Type: ConvertSemaToStaticType(
+					ConvertDynamicToSemaType(dynamicType),
+				),
+			}
+
+			return function.
+				Invoke(Invocation{
+					Arguments:     []Value{path, typeValue},
+					ArgumentTypes: parameterTypes,
+					LocationRange: invocation.LocationRange,
+					Interpreter:   invocation.Interpreter,
+				}).
+				FlatMap(func(result interface{}) Trampoline {
+					if !result.(BoolValue) {
+						return Done{Result: VoidValue{}}
+					}
+
+					return iterate(index + 1)
+				})
+		}
+
+		return iterate(0)
+	})
+}
+
 func (interpreter *Interpreter) authAccountBorrowFunction(addressValue AddressValue) HostFunctionValue {
 	return NewHostFunctionValue(func(invocation Invocation) Trampoline {
 
@@ -4049,15 +4569,17 @@ func (interpreter *Interpreter) capabilityBorrowFunction(
 	return NewHostFunctionValue(
 		func(invocation Invocation) Trampoline {
 
-			if borrowType == nil {
-
-				// `Invocation.TypeParameterTypes` is a map, so get the first
-				// element / type by iterating over the values of the map.
+			// The borrow type may be refined by a type argument,
+			// e.g. when the capability's borrow type is an authorized reference,
+			// so prefer the type argument, if any.
+			//
+			// `Invocation.TypeParameterTypes` is a map, so get the first
+			// element / type by iterating over the values of the map.
 
-				for _, ty := range invocation.TypeParameterTypes {
-					borrowType = ty.(*sema.ReferenceType)
-					break
-				}
+			borrowType := borrowType
+			for _, ty := range invocation.TypeParameterTypes {
+				borrowType = ty.(*sema.ReferenceType)
+				break
 			}
 
 			if borrowType == nil {
@@ -4098,15 +4620,17 @@ func (interpreter *Interpreter) capabilityCheckFunction(
 	return NewHostFunctionValue(
 		func(invocation Invocation) Trampoline {
 
-			if borrowType == nil {
-
-				// `Invocation.TypeParameterTypes` is a map, so get the first
-				// element / type by iterating over the values of the map.
+			// The borrow type may be refined by a type argument,
+			// e.g. when the capability's borrow type is an authorized reference,
+			// so prefer the type argument, if any.
+			//
+			// `Invocation.TypeParameterTypes` is a map, so get the first
+			// element / type by iterating over the values of the map.
 
-				for _, ty := range invocation.TypeParameterTypes {
-					borrowType = ty.(*sema.ReferenceType)
-					break
-				}
+			borrowType := borrowType
+			for _, ty := range invocation.TypeParameterTypes {
+				borrowType = ty.(*sema.ReferenceType)
+				break
 			}
 
 			if borrowType == nil {
@@ -4262,22 +4786,7 @@ func (interpreter *Interpreter) getMember(self Value, locationRange LocationRang
 		result = memberAccessibleValue.GetMember(interpreter, locationRange, identifier)
 	}
 	if result == nil {
-		switch identifier {
-		case sema.IsInstanceFunctionName:
-			return NewHostFunctionValue(
-				func(invocation Invocation) Trampoline {
-					firstArgument := invocation.Arguments[0]
-
-					// NOTE: not invocation.Self, as that is only set for composite values
-					dynamicType := self.DynamicType(interpreter)
-					ty := interpreter.ConvertStaticToSemaType(
-						firstArgument.(TypeValue).Type,
-					)
-					result := IsSubType(dynamicType, ty)
-					return Done{Result: BoolValue(result)}
-				},
-			)
-		}
+		result = interpreter.getBuiltinMember(self, identifier)
 	}
 	if result == nil {
 		panic(errors.NewUnreachableError())
@@ -4285,6 +4794,75 @@ func (interpreter *Interpreter) getMember(self Value, locationRange LocationRang
 	return result
 }
 
+// getBuiltinMember returns the built-in member with the given identifier
+// which is available for all values, e.g. `isInstance`, or nil if there is none
+//
+func (interpreter *Interpreter) getBuiltinMember(self Value, identifier string) Value {
+	switch identifier {
+	case sema.IsInstanceFunctionName:
+		return NewHostFunctionValue(
+			func(invocation Invocation) Trampoline {
+				staticType := invocation.Arguments[0].(TypeValue).Type
+
+				// Fast path: a composite value is an instance of a composite type
+				// if the type IDs match. This avoids the determination of the dynamic type,
+				// and the conversion of the static type to a sema type
+
+				if compositeValue, ok := self.(*CompositeValue); ok {
+					if compositeStaticType, ok := staticType.(CompositeStaticType); ok &&
+						compositeStaticType.TypeID == compositeValue.TypeID {
+
+						return Done{Result: BoolValue(true)}
+					}
+				}
+
+				// NOTE: not invocation.Self, as that is only set for composite values
+				dynamicType := self.DynamicType(interpreter)
+				ty := interpreter.ConvertStaticToSemaType(staticType)
+				result := IsSubType(dynamicType, ty)
+				return Done{Result: BoolValue(result)}
+			},
+		)
+
+	case sema.DowncastFunctionName:
+		return NewHostFunctionValue(
+			func(invocation Invocation) Trampoline {
+
+				// `Invocation.TypeParameterTypes` is a map, so get the first
+				// element / type by iterating over the values of the map.
+
+				var ty sema.Type
+				for _, ty = range invocation.TypeParameterTypes {
+					break
+				}
+
+				// NOTE: not invocation.Self, as that is only set for composite values
+				dynamicType := self.DynamicType(interpreter)
+				if !IsSubType(dynamicType, ty) {
+					return Done{Result: NilValue{}}
+				}
+
+				return Done{Result: NewSomeValueOwningNonCopying(self)}
+			},
+		)
+
+	case sema.GetTypeFunctionName:
+		return NewHostFunctionValue(
+			func(invocation Invocation) Trampoline {
+				dynamicType := self.DynamicType(interpreter)
+				result := TypeValue{
+					Type: ConvertSemaToStaticType(
+						ConvertDynamicToSemaType(dynamicType),
+					),
+				}
+				return Done{Result: result}
+			},
+		)
+	}
+
+	return nil
+}
+
 func (interpreter *Interpreter) setMember(self Value, locationRange LocationRange, identifier string, value Value) {
 	self.(MemberAccessibleValue).SetMember(interpreter, locationRange, identifier, value)
 }
@@ -19,11 +19,13 @@
 package interpreter
 
 import (
+	"bytes"
 	"encoding/binary"
 	"encoding/hex"
 	"fmt"
 	"math"
 	"math/big"
+	"sort"
 	"strconv"
 	"strings"
 
@@ -132,17 +134,45 @@ func (v TypeValue) Equal(inter *Interpreter, other Value) BoolValue {
 		return false
 	}
 
+	// Fast path: composite and interface static types already carry their type ID,
+	// so they can be compared without converting them to sema types,
+	// which would recompute the type IDs
+
+	typeID, ok := staticTypeID(v.Type)
+	if ok {
+		otherTypeID, ok := staticTypeID(otherMetaType.Type)
+		if ok {
+			return typeID == otherTypeID
+		}
+	}
+
 	ty := inter.ConvertStaticToSemaType(v.Type)
 	otherTy := inter.ConvertStaticToSemaType(otherMetaType.Type)
 
 	return BoolValue(ty.Equal(otherTy))
 }
 
+// staticTypeID returns the type ID of the given static type,
+// if it is readily available without a conversion to a sema type.
+//
+func staticTypeID(staticType StaticType) (sema.TypeID, bool) {
+	switch staticType := staticType.(type) {
+	case CompositeStaticType:
+		return staticType.TypeID, true
+	case InterfaceStaticType:
+		return staticType.TypeID, true
+	default:
+		return "", false
+	}
+}
+
 func (v TypeValue) GetMember(inter *Interpreter, _ LocationRange, name string) Value {
 	switch name {
 	case "identifier":
+		// NOTE: the identifier is the type ID, which is fully-qualified,
+		// i.e. it includes the locations of composite and interface types
 		ty := inter.ConvertStaticToSemaType(v.Type)
-		return NewStringValue(ty.QualifiedString())
+		return NewStringValue(string(ty.ID()))
 	}
 
 	return nil
@@ -388,6 +418,39 @@ func (v *StringValue) GetMember(_ *Interpreter, _ LocationRange, name string) Va
 			},
 		)
 
+	case "replaceAll":
+		return NewHostFunctionValue(
+			func(invocation Invocation) trampoline.Trampoline {
+				original := invocation.Arguments[0].(*StringValue)
+				replacement := invocation.Arguments[1].(*StringValue)
+
+				if original.Str == "" {
+					panic(&EmptySubstringError{
+						LocationRange: invocation.LocationRange,
+					})
+				}
+
+				result := v.ReplaceAll(original, replacement)
+				return trampoline.Done{Result: result}
+			},
+		)
+
+	case "count":
+		return NewHostFunctionValue(
+			func(invocation Invocation) trampoline.Trampoline {
+				substring := invocation.Arguments[0].(*StringValue)
+
+				if substring.Str == "" {
+					panic(&EmptySubstringError{
+						LocationRange: invocation.LocationRange,
+					})
+				}
+
+				result := NewIntValueFromInt64(int64(v.Count(substring)))
+				return trampoline.Done{Result: result}
+			},
+		)
+
 	case "decodeHex":
 		return NewHostFunctionValue(
 			func(invocation Invocation) trampoline.Trampoline {
@@ -395,11 +458,57 @@ func (v *StringValue) GetMember(_ *Interpreter, _ LocationRange, name string) Va
 				return trampoline.Done{Result: result}
 			},
 		)
+
+	case "compare":
+		return NewHostFunctionValue(
+			func(invocation Invocation) trampoline.Trampoline {
+				other := invocation.Arguments[0].(*StringValue)
+				result := NewIntValueFromInt64(int64(v.Compare(other)))
+				return trampoline.Done{Result: result}
+			},
+		)
+
+	// NOTE: characters are represented as string values,
+	// so the following members are only available for characters
+
+	case sema.ToStringFunctionName:
+		return NewHostFunctionValue(
+			func(invocation Invocation) trampoline.Trampoline {
+				result := NewStringValue(v.Str)
+				return trampoline.Done{Result: result}
+			},
+		)
+
+	case "utf8":
+		return ByteSliceToByteArrayValue([]byte(v.Str))
 	}
 
 	return nil
 }
 
+// Compare compares this string to the given string lexicographically
+// by Unicode code points, after normalizing both strings.
+// The result is -1, 0, or 1
+//
+func (v *StringValue) Compare(other *StringValue) int {
+	return strings.Compare(v.NormalForm(), other.NormalForm())
+}
+
+// ReplaceAll returns a new string in which all non-overlapping occurrences
+// of the given original substring are replaced with the given replacement.
+// The original substring must not be empty
+//
+func (v *StringValue) ReplaceAll(original, replacement *StringValue) *StringValue {
+	return NewStringValue(strings.ReplaceAll(v.Str, original.Str, replacement.Str))
+}
+
+// Count returns the number of non-overlapping occurrences of the given substring.
+// The substring must not be empty
+//
+func (v *StringValue) Count(substring *StringValue) int {
+	return strings.Count(v.Str, substring.Str)
+}
+
 // Length returns the number of characters (grapheme clusters)
 //
 func (v *StringValue) Length() int {
@@ -607,11 +716,28 @@ func (v *ArrayValue) RemoveLast() Value {
 	return lastElement
 }
 
-func (v *ArrayValue) Contains(needleValue Value) BoolValue {
+// Sort sorts the elements of the array in ascending order.
+// The sort is stable and the elements must be comparable (see lessValue)
+//
+func (v *ArrayValue) Sort() {
+	v.modified = true
+
+	sort.SliceStable(v.Values, func(i, j int) bool {
+		return lessValue(v.Values[i], v.Values[j])
+	})
+}
+
+func (v *ArrayValue) RemoveAll() {
+	v.modified = true
+
+	v.Values = []Value{}
+}
+
+func (v *ArrayValue) Contains(inter *Interpreter, needleValue Value) BoolValue {
 	needleEquatable := needleValue.(EquatableValue)
 
 	for _, arrayValue := range v.Values {
-		if needleEquatable.Equal(nil, arrayValue) {
+		if needleEquatable.Equal(inter, arrayValue) {
 			return true
 		}
 	}
@@ -619,6 +745,84 @@ func (v *ArrayValue) Contains(needleValue Value) BoolValue {
 	return false
 }
 
+// LastIndexOf returns the index of the last element which is equal to the given value,
+// or nil if no element is equal to it
+//
+func (v *ArrayValue) LastIndexOf(inter *Interpreter, needleValue Value) OptionalValue {
+	needleEquatable := needleValue.(EquatableValue)
+
+	for i := len(v.Values) - 1; i >= 0; i-- {
+		if needleEquatable.Equal(inter, v.Values[i]) {
+			return NewSomeValueOwningNonCopying(NewIntValueFromInt64(int64(i)))
+		}
+	}
+
+	return NilValue{}
+}
+
+// lessValue returns true if the given value is ordered before the other value.
+// Both values must be of the same comparable kind:
+// numbers, strings, addresses, or paths
+//
+func lessValue(value, other Value) bool {
+	switch value := value.(type) {
+	case NumberValue:
+		return bool(value.Less(other.(NumberValue)))
+
+	case *StringValue:
+		return value.Compare(other.(*StringValue)) < 0
+
+	case AddressValue:
+		return value.Compare(other.(AddressValue)) < 0
+
+	case PathValue:
+		otherPath := other.(PathValue)
+		if value.Domain != otherPath.Domain {
+			return value.Domain < otherPath.Domain
+		}
+		return value.Identifier < otherPath.Identifier
+
+	default:
+		panic(errors.NewUnreachableError())
+	}
+}
+
+// Min returns the smallest element of the array, or nil if the array is empty
+//
+func (v *ArrayValue) Min() OptionalValue {
+	var result Value
+
+	for _, element := range v.Values {
+		if result == nil || lessValue(element, result) {
+			result = element
+		}
+	}
+
+	if result == nil {
+		return NilValue{}
+	}
+
+	return NewSomeValueOwningNonCopying(result.Copy())
+}
+
+// Max returns the largest element of the array, or nil if the array is empty
+//
+func (v *ArrayValue) Max() OptionalValue {
+	var result Value
+
+	for _, element := range v.Values {
+		if result == nil || lessValue(result, element) {
+			result = element
+		}
+	}
+
+	if result == nil {
+		return NilValue{}
+	}
+
+	return NewSomeValueOwningNonCopying(result.Copy())
+}
+
 func (v *ArrayValue) GetMember(_ *Interpreter, _ LocationRange, name string) Value {
 	switch name {
 	case "length":
@@ -660,68 +864,681 @@ func (v *ArrayValue) GetMember(_ *Interpreter, _ LocationRange, name string) Val
 			},
 		)
 
-	case "removeFirst":
-		return NewHostFunctionValue(
-			func(invocation Invocation) trampoline.Trampoline {
-				result := v.RemoveFirst()
-				return trampoline.Done{Result: result}
-			},
-		)
+	case "removeFirst":
+		return NewHostFunctionValue(
+			func(invocation Invocation) trampoline.Trampoline {
+				result := v.RemoveFirst()
+				return trampoline.Done{Result: result}
+			},
+		)
+
+	case "removeLast":
+		return NewHostFunctionValue(
+			func(invocation Invocation) trampoline.Trampoline {
+				result := v.RemoveLast()
+				return trampoline.Done{Result: result}
+			},
+		)
+
+	case "sort":
+		return NewHostFunctionValue(
+			func(invocation Invocation) trampoline.Trampoline {
+				v.Sort()
+				return trampoline.Done{Result: VoidValue{}}
+			},
+		)
+
+	case "sorted":
+		return NewHostFunctionValue(
+			func(invocation Invocation) trampoline.Trampoline {
+				result := v.Copy().(*ArrayValue)
+				result.Sort()
+				return trampoline.Done{Result: result}
+			},
+		)
+
+	case "removeAll":
+		return NewHostFunctionValue(
+			func(invocation Invocation) trampoline.Trampoline {
+				v.RemoveAll()
+				return trampoline.Done{Result: VoidValue{}}
+			},
+		)
+
+	case "encodeHex":
+		return NewHostFunctionValue(
+			func(invocation Invocation) trampoline.Trampoline {
+				result := v.EncodeHex()
+				return trampoline.Done{Result: result}
+			},
+		)
+
+	case "contains":
+		return NewHostFunctionValue(
+			func(invocation Invocation) trampoline.Trampoline {
+				result := v.Contains(invocation.Interpreter, invocation.Arguments[0])
+				return trampoline.Done{Result: result}
+			},
+		)
+
+	case "lastIndexOf":
+		return NewHostFunctionValue(
+			func(invocation Invocation) trampoline.Trampoline {
+				result := v.LastIndexOf(invocation.Interpreter, invocation.Arguments[0])
+				return trampoline.Done{Result: result}
+			},
+		)
+
+	case "withExactLength":
+		return NewHostFunctionValue(
+			func(invocation Invocation) trampoline.Trampoline {
+				expectedLength := invocation.Arguments[0].(NumberValue).ToInt()
+				actualLength := v.Count()
+
+				if actualLength != expectedLength {
+					panic(&ArrayLengthMismatchError{
+						ExpectedLength: expectedLength,
+						ActualLength:   actualLength,
+						LocationRange:  invocation.LocationRange,
+					})
+				}
+
+				result := v.Copy()
+				return trampoline.Done{Result: result}
+			},
+		)
+
+	case "toVariableSized":
+		return NewHostFunctionValue(
+			func(invocation Invocation) trampoline.Trampoline {
+				result := v.Copy()
+				return trampoline.Done{Result: result}
+			},
+		)
+
+	case "min":
+		return NewHostFunctionValue(
+			func(invocation Invocation) trampoline.Trampoline {
+				result := v.Min()
+				return trampoline.Done{Result: result}
+			},
+		)
+
+	case "max":
+		return NewHostFunctionValue(
+			func(invocation Invocation) trampoline.Trampoline {
+				result := v.Max()
+				return trampoline.Done{Result: result}
+			},
+		)
+
+	case "filter":
+		return NewHostFunctionValue(
+			func(invocation Invocation) trampoline.Trampoline {
+				predicate := invocation.Arguments[0].(FunctionValue)
+				predicateType := invocation.ArgumentTypes[0].(*sema.FunctionType)
+				elementType := predicateType.Parameters[0].TypeAnnotation.Type
+
+				// NOTE: iterate over a copy of the elements,
+				// as the predicate might modify the array
+
+				elements := make([]Value, v.Count())
+				copy(elements, v.Values)
+
+				var filtered []Value
+
+				var iterate func(index int) trampoline.Trampoline
+				iterate = func(index int) trampoline.Trampoline {
+					if index >= len(elements) {
+						result := NewArrayValueUnownedNonCopying(filtered...)
+						return trampoline.Done{Result: result}
+					}
+
+					element := elements[index]
+
+					return predicate.
+						Invoke(Invocation{
+							Arguments:     []Value{element.Copy()},
+							ArgumentTypes: []sema.Type{elementType},
+							LocationRange: invocation.LocationRange,
+							Interpreter:   invocation.Interpreter,
+						}).
+						FlatMap(func(include interface{}) trampoline.Trampoline {
+							if include.(BoolValue) {
+								filtered = append(filtered, element.Copy())
+							}
+
+							return iterate(index + 1)
+						})
+				}
+
+				return iterate(0)
+			},
+		)
+
+	case "reduce":
+		return NewHostFunctionValue(
+			func(invocation Invocation) trampoline.Trampoline {
+				initial := invocation.Arguments[0]
+				combineFunction := invocation.Arguments[1].(FunctionValue)
+				combineFunctionType := invocation.ArgumentTypes[1].(*sema.FunctionType)
+				accumulatorType := combineFunctionType.Parameters[0].TypeAnnotation.Type
+				elementType := combineFunctionType.Parameters[1].TypeAnnotation.Type
+
+				// NOTE: iterate over a copy of the elements,
+				// as the combine function might modify the array
+
+				elements := make([]Value, v.Count())
+				copy(elements, v.Values)
+
+				var fold func(index int, accumulator Value) trampoline.Trampoline
+				fold = func(index int, accumulator Value) trampoline.Trampoline {
+					if index >= len(elements) {
+						return trampoline.Done{Result: accumulator}
+					}
+
+					return combineFunction.
+						Invoke(Invocation{
+							Arguments:     []Value{accumulator, elements[index].Copy()},
+							ArgumentTypes: []sema.Type{accumulatorType, elementType},
+							LocationRange: invocation.LocationRange,
+							Interpreter:   invocation.Interpreter,
+						}).
+						FlatMap(func(result interface{}) trampoline.Trampoline {
+							return fold(index+1, result.(Value))
+						})
+				}
+
+				return fold(0, initial)
+			},
+		)
+
+	}
+
+	return nil
+}
+
+// EncodeHex returns the hexadecimal string representation of the array,
+// which must only contain `UInt8` values
+//
+func (v *ArrayValue) EncodeHex() *StringValue {
+	bs := make([]byte, v.Count())
+	for i, value := range v.Values {
+		bs[i] = byte(value.(UInt8Value))
+	}
+
+	return NewStringValue(hex.EncodeToString(bs))
+}
+
+func (v *ArrayValue) SetMember(_ *Interpreter, _ LocationRange, _ string, _ Value) {
+	panic(errors.NewUnreachableError())
+}
+
+func (v *ArrayValue) Count() int {
+	return len(v.Values)
+}
+
+// NumberValue
+
+type NumberValue interface {
+	EquatableValue
+	ToInt() int
+	Negate() NumberValue
+	Plus(other NumberValue) NumberValue
+	Minus(other NumberValue) NumberValue
+	Mod(other NumberValue) NumberValue
+	Mul(other NumberValue) NumberValue
+	Div(other NumberValue) NumberValue
+	Less(other NumberValue) BoolValue
+	LessEqual(other NumberValue) BoolValue
+	Greater(other NumberValue) BoolValue
+	GreaterEqual(other NumberValue) BoolValue
+	ToBigEndianBytes() []byte
+}
+
+type IntegerValue interface {
+	NumberValue
+	BitwiseOr(other IntegerValue) IntegerValue
+	BitwiseXor(other IntegerValue) IntegerValue
+	BitwiseAnd(other IntegerValue) IntegerValue
+	BitwiseLeftShift(other IntegerValue) IntegerValue
+	BitwiseRightShift(other IntegerValue) IntegerValue
+}
+
+// getNumberValueMember returns the members which are available for all number values,
+// independent of their concrete type
+//
+func getNumberValueMember(inter *Interpreter, v NumberValue, name string) Value {
+	switch name {
+
+	case sema.NumberTypeClampFunctionName:
+		return NewHostFunctionValue(
+			func(invocation Invocation) trampoline.Trampoline {
+				min := invocation.Arguments[0].(NumberValue)
+				max := invocation.Arguments[1].(NumberValue)
+
+				if min.Greater(max) {
+					panic(&InvalidClampRangeError{
+						Min:           min,
+						Max:           max,
+						LocationRange: invocation.LocationRange,
+					})
+				}
+
+				var result NumberValue = v
+				if v.Less(min) {
+					result = min
+				} else if v.Greater(max) {
+					result = max
+				}
+
+				return trampoline.Done{Result: result}
+			},
+		)
+
+	case sema.NumberTypePowFunctionName:
+		return NewHostFunctionValue(
+			func(invocation Invocation) trampoline.Trampoline {
+				var exponent *big.Int
+				switch argument := invocation.Arguments[0].(type) {
+				case IntValue:
+					exponent = argument.ToBigInt()
+				case UInt8Value:
+					exponent = big.NewInt(int64(argument))
+				default:
+					panic(errors.NewUnreachableError())
+				}
+
+				result := numberValuePow(inter, v, exponent)
+				return trampoline.Done{Result: result}
+			},
+		)
+
+	case sema.SignedNumberTypeAbsFunctionName:
+		return NewHostFunctionValue(
+			func(invocation Invocation) trampoline.Trampoline {
+				zero := convertNumberValue(inter, v, NewIntValueFromInt64(0))
+
+				// NOTE: negation checks for overflow,
+				// e.g. the minimum value of a signed integer type
+
+				var result = v
+				if v.Less(zero) {
+					result = v.Negate()
+				}
+
+				return trampoline.Done{Result: result}
+			},
+		)
+
+	case sema.IntegerTypeGCDFunctionName:
+		return NewHostFunctionValue(
+			func(invocation Invocation) trampoline.Trampoline {
+				other := invocation.Arguments[0].(NumberValue)
+				result := integerValueGCD(inter, v, other)
+				return trampoline.Done{Result: result}
+			},
+		)
+
+	case sema.IntegerTypeLCMFunctionName:
+		return NewHostFunctionValue(
+			func(invocation Invocation) trampoline.Trampoline {
+				other := invocation.Arguments[0].(NumberValue)
+				result := integerValueLCM(inter, v, other)
+				return trampoline.Done{Result: result}
+			},
+		)
+
+	case sema.IntegerTypeBitwiseAndFunctionName:
+		return NewHostFunctionValue(
+			func(invocation Invocation) trampoline.Trampoline {
+				other := invocation.Arguments[0].(IntegerValue)
+				result := v.(IntegerValue).BitwiseAnd(other)
+				return trampoline.Done{Result: result}
+			},
+		)
+
+	case sema.IntegerTypeBitwiseOrFunctionName:
+		return NewHostFunctionValue(
+			func(invocation Invocation) trampoline.Trampoline {
+				other := invocation.Arguments[0].(IntegerValue)
+				result := v.(IntegerValue).BitwiseOr(other)
+				return trampoline.Done{Result: result}
+			},
+		)
+
+	case sema.IntegerTypeBitwiseXorFunctionName:
+		return NewHostFunctionValue(
+			func(invocation Invocation) trampoline.Trampoline {
+				other := invocation.Arguments[0].(IntegerValue)
+				result := v.(IntegerValue).BitwiseXor(other)
+				return trampoline.Done{Result: result}
+			},
+		)
+
+	case sema.IntegerTypeShiftLeftFunctionName:
+		return NewHostFunctionValue(
+			func(invocation Invocation) trampoline.Trampoline {
+				bits := uint(invocation.Arguments[0].(UInt8Value))
+				shifted := new(big.Int).Lsh(integerValueBigInt(v), bits)
+				result := integerValueFromBigInt(inter, v, shifted)
+				return trampoline.Done{Result: result}
+			},
+		)
+
+	case sema.IntegerTypeShiftRightFunctionName:
+		return NewHostFunctionValue(
+			func(invocation Invocation) trampoline.Trampoline {
+				bits := uint(invocation.Arguments[0].(UInt8Value))
+				// NOTE: big.Int.Rsh shifts negative numbers arithmetically
+				shifted := new(big.Int).Rsh(integerValueBigInt(v), bits)
+				result := integerValueFromBigInt(inter, v, shifted)
+				return trampoline.Done{Result: result}
+			},
+		)
+
+	case sema.UnsignedIntegerTypeWrappingAddFunctionName:
+		return NewHostFunctionValue(
+			func(invocation Invocation) trampoline.Trampoline {
+				other := invocation.Arguments[0].(NumberValue)
+				result := unsignedIntegerValueWrappingOperation(v, other, func(a, b uint64) uint64 {
+					return a + b
+				})
+				return trampoline.Done{Result: result}
+			},
+		)
+
+	case sema.UnsignedIntegerTypeWrappingSubtractFunctionName:
+		return NewHostFunctionValue(
+			func(invocation Invocation) trampoline.Trampoline {
+				other := invocation.Arguments[0].(NumberValue)
+				result := unsignedIntegerValueWrappingOperation(v, other, func(a, b uint64) uint64 {
+					return a - b
+				})
+				return trampoline.Done{Result: result}
+			},
+		)
+
+	case sema.UnsignedIntegerTypeWrappingMultiplyFunctionName:
+		return NewHostFunctionValue(
+			func(invocation Invocation) trampoline.Trampoline {
+				other := invocation.Arguments[0].(NumberValue)
+				result := unsignedIntegerValueWrappingOperation(v, other, func(a, b uint64) uint64 {
+					return a * b
+				})
+				return trampoline.Done{Result: result}
+			},
+		)
+
+	case sema.FixedPointTypeTruncateFunctionName:
+		return NewHostFunctionValue(
+			func(invocation Invocation) trampoline.Trampoline {
+				scaled := fixedPointValueScaledBigInt(v)
+
+				// NOTE: big.Int.Quo truncates toward zero
+
+				integer := new(big.Int).Quo(scaled, fixedPointFactorBig)
+
+				return trampoline.Done{Result: NewIntValueFromBigInt(integer)}
+			},
+		)
+
+	case sema.FixedPointTypeRoundFunctionName:
+		return NewHostFunctionValue(
+			func(invocation Invocation) trampoline.Trampoline {
+				scaled := fixedPointValueScaledBigInt(v)
+
+				// Round half away from zero: move the value away from zero
+				// by half of the factor, then truncate toward zero
+
+				half := new(big.Int).Quo(fixedPointFactorBig, big.NewInt(2))
+				if scaled.Sign() < 0 {
+					scaled.Sub(scaled, half)
+				} else {
+					scaled.Add(scaled, half)
+				}
+
+				integer := new(big.Int).Quo(scaled, fixedPointFactorBig)
+
+				return trampoline.Done{Result: NewIntValueFromBigInt(integer)}
+			},
+		)
+
+	case sema.FixedPointTypeMulDivFunctionName:
+		return NewHostFunctionValue(
+			func(invocation Invocation) trampoline.Trampoline {
+				a := fixedPointValueScaledBigInt(invocation.Arguments[0].(NumberValue))
+				b := fixedPointValueScaledBigInt(invocation.Arguments[1].(NumberValue))
+
+				if b.Sign() == 0 {
+					panic(DivisionByZeroError{})
+				}
+
+				// The factors of the scaled values cancel out:
+				// (v / f) * (a / f) / (b / f) * f = v * a / b
+				//
+				// NOTE: big.Int.Quo truncates toward zero
+
+				scaled := fixedPointValueScaledBigInt(v)
+				scaled.Mul(scaled, a)
+				scaled.Quo(scaled, b)
+
+				result := fixedPointValueFromScaledBigInt(v, scaled)
+				return trampoline.Done{Result: result}
+			},
+		)
+	}
+
+	if targetType, ok := sema.NumberConversionFunctionTargetTypes[name]; ok {
+		converter := converters[targetType.String()]
+
+		return NewHostFunctionValue(
+			func(invocation Invocation) trampoline.Trampoline {
+				// NOTE: the converter aborts if the value is out of range
+				result := converter(v, inter)
+				return trampoline.Done{Result: result}
+			},
+		)
+	}
+
+	return nil
+}
+
+var fixedPointFactorBig = big.NewInt(sema.Fix64Factor)
+
+// fixedPointValueScaledBigInt returns the underlying scaled integer
+// of the given fixed-point value, i.e. the value multiplied by the factor
+//
+func fixedPointValueScaledBigInt(v NumberValue) *big.Int {
+	switch v := v.(type) {
+	case Fix64Value:
+		return big.NewInt(int64(v))
+	case UFix64Value:
+		return new(big.Int).SetUint64(uint64(v))
+	default:
+		panic(errors.NewUnreachableError())
+	}
+}
+
+// fixedPointValueFromScaledBigInt converts the given scaled integer,
+// i.e. a value multiplied by the factor, to the type of the given fixed-point value.
+//
+// Aborts if the value is not in the range of the type
+//
+func fixedPointValueFromScaledBigInt(v NumberValue, scaled *big.Int) NumberValue {
+	switch v.(type) {
+	case Fix64Value:
+		if !scaled.IsInt64() {
+			if scaled.Sign() < 0 {
+				panic(UnderflowError{})
+			}
+			panic(OverflowError{})
+		}
+		return Fix64Value(scaled.Int64())
+
+	case UFix64Value:
+		if scaled.Sign() < 0 {
+			panic(UnderflowError{})
+		}
+		if !scaled.IsUint64() {
+			panic(OverflowError{})
+		}
+		return UFix64Value(scaled.Uint64())
+
+	default:
+		panic(errors.NewUnreachableError())
+	}
+}
+
+// integerValueAbs returns the absolute value of the given integer value.
+//
+// NOTE: negation checks for overflow,
+// e.g. the minimum value of a signed integer type
+//
+func integerValueAbs(v NumberValue, zero NumberValue) NumberValue {
+	if v.Less(zero) {
+		return v.Negate()
+	}
+	return v
+}
+
+// unsignedIntegerValueWrappingOperation applies the given operation
+// to the given fixed-size unsigned integer values, wrapping around on overflow and underflow.
+//
+// The operation is performed on 64-bit unsigned integers, which wrap around modulo 2^64,
+// and the result is masked to the bit width of the type, i.e. reduced modulo 2^N.
+// This is correct for addition, subtraction, and multiplication,
+// as 2^N divides 2^64
+//
+func unsignedIntegerValueWrappingOperation(v, other NumberValue, operation func(a, b uint64) uint64) NumberValue {
+	switch v := v.(type) {
+	case UInt8Value:
+		result := operation(uint64(v), uint64(other.(UInt8Value)))
+		return UInt8Value(result & math.MaxUint8)
+
+	case UInt16Value:
+		result := operation(uint64(v), uint64(other.(UInt16Value)))
+		return UInt16Value(result & math.MaxUint16)
+
+	case UInt32Value:
+		result := operation(uint64(v), uint64(other.(UInt32Value)))
+		return UInt32Value(result & math.MaxUint32)
+
+	case UInt64Value:
+		return UInt64Value(operation(uint64(v), uint64(other.(UInt64Value))))
+
+	default:
+		panic(errors.NewUnreachableError())
+	}
+}
 
-	case "removeLast":
-		return NewHostFunctionValue(
-			func(invocation Invocation) trampoline.Trampoline {
-				result := v.RemoveLast()
-				return trampoline.Done{Result: result}
-			},
-		)
+// integerValueGCD returns the greatest common divisor
+// of the given integer values, using the Euclidean algorithm
+//
+func integerValueGCD(inter *Interpreter, a, b NumberValue) NumberValue {
+	zero := convertNumberValue(inter, a, NewIntValueFromInt64(0))
 
-	case "contains":
-		return NewHostFunctionValue(
-			func(invocation Invocation) trampoline.Trampoline {
-				result := v.Contains(invocation.Arguments[0])
-				return trampoline.Done{Result: result}
-			},
-		)
+	a = integerValueAbs(a, zero)
+	b = integerValueAbs(b, zero)
 
+	for !b.Equal(inter, zero) {
+		a, b = b, a.Mod(b)
 	}
 
-	return nil
+	return a
 }
 
-func (v *ArrayValue) SetMember(_ *Interpreter, _ LocationRange, _ string, _ Value) {
-	panic(errors.NewUnreachableError())
+// integerValueLCM returns the least common multiple of the given integer values.
+//
+// Overflow is checked by the multiplication of the number type,
+// i.e. checked types abort and `Word*` types wrap around.
+//
+func integerValueLCM(inter *Interpreter, a, b NumberValue) NumberValue {
+	zero := convertNumberValue(inter, a, NewIntValueFromInt64(0))
+
+	if a.Equal(inter, zero) || b.Equal(inter, zero) {
+		return zero
+	}
+
+	gcd := integerValueGCD(inter, a, b)
+
+	return integerValueAbs(a, zero).Div(gcd).Mul(integerValueAbs(b, zero))
 }
 
-func (v *ArrayValue) Count() int {
-	return len(v.Values)
+// integerValueBigInt returns the given integer value as a big.Int
+//
+func integerValueBigInt(v NumberValue) *big.Int {
+	switch v := v.(type) {
+	case BigNumberValue:
+		return v.ToBigInt()
+	case UInt64Value:
+		return new(big.Int).SetUint64(uint64(v))
+	case Word64Value:
+		return new(big.Int).SetUint64(uint64(v))
+	default:
+		return big.NewInt(int64(v.ToInt()))
+	}
 }
 
-// NumberValue
+// integerValueFromBigInt converts the given big.Int to the type of the given integer value.
+//
+// Word types wrap around, i.e. the bits outside of the range of the type are discarded.
+// All other types abort if the value is not in the range of the type
+//
+func integerValueFromBigInt(inter *Interpreter, v NumberValue, value *big.Int) NumberValue {
+	switch v.(type) {
+	case Word8Value, Word16Value, Word32Value, Word64Value:
+		numberType := v.DynamicType(inter).(NumberDynamicType).StaticType
+		maxInt := numberType.(sema.IntegerRangedType).MaxInt()
+		modulus := new(big.Int).Add(maxInt, big.NewInt(1))
+		value = new(big.Int).Mod(value, modulus)
+	}
 
-type NumberValue interface {
-	EquatableValue
-	ToInt() int
-	Negate() NumberValue
-	Plus(other NumberValue) NumberValue
-	Minus(other NumberValue) NumberValue
-	Mod(other NumberValue) NumberValue
-	Mul(other NumberValue) NumberValue
-	Div(other NumberValue) NumberValue
-	Less(other NumberValue) BoolValue
-	LessEqual(other NumberValue) BoolValue
-	Greater(other NumberValue) BoolValue
-	GreaterEqual(other NumberValue) BoolValue
-	ToBigEndianBytes() []byte
+	return convertNumberValue(inter, v, NewIntValueFromBigInt(value))
 }
 
-type IntegerValue interface {
-	NumberValue
-	BitwiseOr(other IntegerValue) IntegerValue
-	BitwiseXor(other IntegerValue) IntegerValue
-	BitwiseAnd(other IntegerValue) IntegerValue
-	BitwiseLeftShift(other IntegerValue) IntegerValue
-	BitwiseRightShift(other IntegerValue) IntegerValue
+// convertNumberValue converts the given value to the type of the given number value
+//
+func convertNumberValue(inter *Interpreter, v NumberValue, value Value) NumberValue {
+	numberType := v.DynamicType(inter).(NumberDynamicType).StaticType
+	converter := converters[numberType.String()]
+	return converter(value, inter).(NumberValue)
+}
+
+// numberValuePow raises the given number value to the given exponent
+// using exponentiation by squaring.
+//
+// Overflow is checked by the multiplication of the number type,
+// i.e. checked types abort and `Word*` types wrap around.
+//
+// A negative exponent is only valid for fixed-point values,
+// the result is the reciprocal of the value raised to the absolute exponent.
+//
+func numberValuePow(inter *Interpreter, v NumberValue, exponent *big.Int) NumberValue {
+	one := convertNumberValue(inter, v, NewIntValueFromInt64(1))
+
+	if exponent.Sign() < 0 {
+		positiveExponent := new(big.Int).Neg(exponent)
+		return one.Div(numberValuePow(inter, v, positiveExponent))
+	}
+
+	result := one
+	base := v
+
+	for i := 0; i < exponent.BitLen(); i++ {
+		if exponent.Bit(i) == 1 {
+			result = result.Mul(base)
+		}
+
+		// Only square the base if it is needed for a further bit,
+		// so the squaring does not overflow unnecessarily
+
+		if i+1 < exponent.BitLen() {
+			base = base.Mul(base)
+		}
+	}
+
+	return result
 }
 
 // BigNumberValue.
@@ -927,7 +1744,7 @@ func (v IntValue) BitwiseRightShift(other IntegerValue) IntegerValue {
 	return IntValue{res}
 }
 
-func (v IntValue) GetMember(_ *Interpreter, _ LocationRange, name string) Value {
+func (v IntValue) GetMember(inter *Interpreter, _ LocationRange, name string) Value {
 	switch name {
 
 	case sema.ToStringFunctionName:
@@ -947,7 +1764,7 @@ func (v IntValue) GetMember(_ *Interpreter, _ LocationRange, name string) Value
 		)
 	}
 
-	return nil
+	return getNumberValueMember(inter, v, name)
 }
 
 func (IntValue) SetMember(_ *Interpreter, _ LocationRange, _ string, _ Value) {
@@ -1157,9 +1974,15 @@ func (v Int8Value) BitwiseRightShift(other IntegerValue) IntegerValue {
 	return v >> o
 }
 
-func (v Int8Value) GetMember(_ *Interpreter, _ LocationRange, name string) Value {
+func (v Int8Value) GetMember(inter *Interpreter, _ LocationRange, name string) Value {
 	switch name {
 
+	case sema.NumberTypeMinFieldName:
+		return Int8Value(math.MinInt8)
+
+	case sema.NumberTypeMaxFieldName:
+		return Int8Value(math.MaxInt8)
+
 	case sema.ToStringFunctionName:
 		return NewHostFunctionValue(
 			func(invocation Invocation) trampoline.Trampoline {
@@ -1177,7 +2000,7 @@ func (v Int8Value) GetMember(_ *Interpreter, _ LocationRange, name string) Value
 		)
 	}
 
-	return nil
+	return getNumberValueMember(inter, v, name)
 }
 
 func (Int8Value) SetMember(_ *Interpreter, _ LocationRange, _ string, _ Value) {
@@ -1387,9 +2210,15 @@ func (v Int16Value) BitwiseRightShift(other IntegerValue) IntegerValue {
 	return v >> o
 }
 
-func (v Int16Value) GetMember(_ *Interpreter, _ LocationRange, name string) Value {
+func (v Int16Value) GetMember(inter *Interpreter, _ LocationRange, name string) Value {
 	switch name {
 
+	case sema.NumberTypeMinFieldName:
+		return Int16Value(math.MinInt16)
+
+	case sema.NumberTypeMaxFieldName:
+		return Int16Value(math.MaxInt16)
+
 	case sema.ToStringFunctionName:
 		return NewHostFunctionValue(
 			func(invocation Invocation) trampoline.Trampoline {
@@ -1407,7 +2236,7 @@ func (v Int16Value) GetMember(_ *Interpreter, _ LocationRange, name string) Valu
 		)
 	}
 
-	return nil
+	return getNumberValueMember(inter, v, name)
 }
 
 func (Int16Value) SetMember(_ *Interpreter, _ LocationRange, _ string, _ Value) {
@@ -1619,9 +2448,15 @@ func (v Int32Value) BitwiseRightShift(other IntegerValue) IntegerValue {
 	return v >> o
 }
 
-func (v Int32Value) GetMember(_ *Interpreter, _ LocationRange, name string) Value {
+func (v Int32Value) GetMember(inter *Interpreter, _ LocationRange, name string) Value {
 	switch name {
 
+	case sema.NumberTypeMinFieldName:
+		return Int32Value(math.MinInt32)
+
+	case sema.NumberTypeMaxFieldName:
+		return Int32Value(math.MaxInt32)
+
 	case sema.ToStringFunctionName:
 		return NewHostFunctionValue(
 			func(invocation Invocation) trampoline.Trampoline {
@@ -1639,7 +2474,7 @@ func (v Int32Value) GetMember(_ *Interpreter, _ LocationRange, name string) Valu
 		)
 	}
 
-	return nil
+	return getNumberValueMember(inter, v, name)
 }
 
 func (Int32Value) SetMember(_ *Interpreter, _ LocationRange, _ string, _ Value) {
@@ -1850,9 +2685,15 @@ func (v Int64Value) BitwiseRightShift(other IntegerValue) IntegerValue {
 	return v >> o
 }
 
-func (v Int64Value) GetMember(_ *Interpreter, _ LocationRange, name string) Value {
+func (v Int64Value) GetMember(inter *Interpreter, _ LocationRange, name string) Value {
 	switch name {
 
+	case sema.NumberTypeMinFieldName:
+		return Int64Value(math.MinInt64)
+
+	case sema.NumberTypeMaxFieldName:
+		return Int64Value(math.MaxInt64)
+
 	case sema.ToStringFunctionName:
 		return NewHostFunctionValue(
 			func(invocation Invocation) trampoline.Trampoline {
@@ -1870,7 +2711,7 @@ func (v Int64Value) GetMember(_ *Interpreter, _ LocationRange, name string) Valu
 		)
 	}
 
-	return nil
+	return getNumberValueMember(inter, v, name)
 }
 
 func (Int64Value) SetMember(_ *Interpreter, _ LocationRange, _ string, _ Value) {
@@ -2141,9 +2982,15 @@ func (v Int128Value) BitwiseRightShift(other IntegerValue) IntegerValue {
 	return Int128Value{res}
 }
 
-func (v Int128Value) GetMember(_ *Interpreter, _ LocationRange, name string) Value {
+func (v Int128Value) GetMember(inter *Interpreter, _ LocationRange, name string) Value {
 	switch name {
 
+	case sema.NumberTypeMinFieldName:
+		return NewInt128ValueFromBigInt(new(big.Int).Set(sema.Int128TypeMinIntBig))
+
+	case sema.NumberTypeMaxFieldName:
+		return NewInt128ValueFromBigInt(new(big.Int).Set(sema.Int128TypeMaxIntBig))
+
 	case sema.ToStringFunctionName:
 		return NewHostFunctionValue(
 			func(invocation Invocation) trampoline.Trampoline {
@@ -2161,7 +3008,7 @@ func (v Int128Value) GetMember(_ *Interpreter, _ LocationRange, name string) Val
 		)
 	}
 
-	return nil
+	return getNumberValueMember(inter, v, name)
 }
 
 func (Int128Value) SetMember(_ *Interpreter, _ LocationRange, _ string, _ Value) {
@@ -2431,9 +3278,15 @@ func (v Int256Value) BitwiseRightShift(other IntegerValue) IntegerValue {
 	return Int256Value{res}
 }
 
-func (v Int256Value) GetMember(_ *Interpreter, _ LocationRange, name string) Value {
+func (v Int256Value) GetMember(inter *Interpreter, _ LocationRange, name string) Value {
 	switch name {
 
+	case sema.NumberTypeMinFieldName:
+		return NewInt256ValueFromBigInt(new(big.Int).Set(sema.Int256TypeMinIntBig))
+
+	case sema.NumberTypeMaxFieldName:
+		return NewInt256ValueFromBigInt(new(big.Int).Set(sema.Int256TypeMaxIntBig))
+
 	case sema.ToStringFunctionName:
 		return NewHostFunctionValue(
 			func(invocation Invocation) trampoline.Trampoline {
@@ -2451,7 +3304,7 @@ func (v Int256Value) GetMember(_ *Interpreter, _ LocationRange, name string) Val
 		)
 	}
 
-	return nil
+	return getNumberValueMember(inter, v, name)
 }
 
 func (Int256Value) SetMember(_ *Interpreter, _ LocationRange, _ string, _ Value) {
@@ -2667,9 +3520,12 @@ func (v UIntValue) BitwiseRightShift(other IntegerValue) IntegerValue {
 	return UIntValue{res}
 }
 
-func (v UIntValue) GetMember(_ *Interpreter, _ LocationRange, name string) Value {
+func (v UIntValue) GetMember(inter *Interpreter, _ LocationRange, name string) Value {
 	switch name {
 
+	case sema.NumberTypeMinFieldName:
+		return NewUIntValueFromUint64(0)
+
 	case sema.ToStringFunctionName:
 		return NewHostFunctionValue(
 			func(invocation Invocation) trampoline.Trampoline {
@@ -2687,7 +3543,7 @@ func (v UIntValue) GetMember(_ *Interpreter, _ LocationRange, name string) Value
 		)
 	}
 
-	return nil
+	return getNumberValueMember(inter, v, name)
 }
 
 func (UIntValue) SetMember(_ *Interpreter, _ LocationRange, _ string, _ Value) {
@@ -2865,9 +3721,15 @@ func (v UInt8Value) BitwiseRightShift(other IntegerValue) IntegerValue {
 	return v >> o
 }
 
-func (v UInt8Value) GetMember(_ *Interpreter, _ LocationRange, name string) Value {
+func (v UInt8Value) GetMember(inter *Interpreter, _ LocationRange, name string) Value {
 	switch name {
 
+	case sema.NumberTypeMinFieldName:
+		return UInt8Value(0)
+
+	case sema.NumberTypeMaxFieldName:
+		return UInt8Value(math.MaxUint8)
+
 	case sema.ToStringFunctionName:
 		return NewHostFunctionValue(
 			func(invocation Invocation) trampoline.Trampoline {
@@ -2885,7 +3747,7 @@ func (v UInt8Value) GetMember(_ *Interpreter, _ LocationRange, name string) Valu
 		)
 	}
 
-	return nil
+	return getNumberValueMember(inter, v, name)
 }
 
 func (UInt8Value) SetMember(_ *Interpreter, _ LocationRange, _ string, _ Value) {
@@ -3061,9 +3923,15 @@ func (v UInt16Value) BitwiseRightShift(other IntegerValue) IntegerValue {
 	return v >> o
 }
 
-func (v UInt16Value) GetMember(_ *Interpreter, _ LocationRange, name string) Value {
+func (v UInt16Value) GetMember(inter *Interpreter, _ LocationRange, name string) Value {
 	switch name {
 
+	case sema.NumberTypeMinFieldName:
+		return UInt16Value(0)
+
+	case sema.NumberTypeMaxFieldName:
+		return UInt16Value(math.MaxUint16)
+
 	case sema.ToStringFunctionName:
 		return NewHostFunctionValue(
 			func(invocation Invocation) trampoline.Trampoline {
@@ -3081,7 +3949,7 @@ func (v UInt16Value) GetMember(_ *Interpreter, _ LocationRange, name string) Val
 		)
 	}
 
-	return nil
+	return getNumberValueMember(inter, v, name)
 }
 
 func (UInt16Value) SetMember(_ *Interpreter, _ LocationRange, _ string, _ Value) {
@@ -3261,9 +4129,15 @@ func (v UInt32Value) BitwiseRightShift(other IntegerValue) IntegerValue {
 	return v >> o
 }
 
-func (v UInt32Value) GetMember(_ *Interpreter, _ LocationRange, name string) Value {
+func (v UInt32Value) GetMember(inter *Interpreter, _ LocationRange, name string) Value {
 	switch name {
 
+	case sema.NumberTypeMinFieldName:
+		return UInt32Value(0)
+
+	case sema.NumberTypeMaxFieldName:
+		return UInt32Value(math.MaxUint32)
+
 	case sema.ToStringFunctionName:
 		return NewHostFunctionValue(
 			func(invocation Invocation) trampoline.Trampoline {
@@ -3281,7 +4155,7 @@ func (v UInt32Value) GetMember(_ *Interpreter, _ LocationRange, name string) Val
 		)
 	}
 
-	return nil
+	return getNumberValueMember(inter, v, name)
 }
 
 func (UInt32Value) SetMember(_ *Interpreter, _ LocationRange, _ string, _ Value) {
@@ -3464,9 +4338,15 @@ func (v UInt64Value) BitwiseRightShift(other IntegerValue) IntegerValue {
 	return v >> o
 }
 
-func (v UInt64Value) GetMember(_ *Interpreter, _ LocationRange, name string) Value {
+func (v UInt64Value) GetMember(inter *Interpreter, _ LocationRange, name string) Value {
 	switch name {
 
+	case sema.NumberTypeMinFieldName:
+		return UInt64Value(0)
+
+	case sema.NumberTypeMaxFieldName:
+		return UInt64Value(math.MaxUint64)
+
 	case sema.ToStringFunctionName:
 		return NewHostFunctionValue(
 			func(invocation Invocation) trampoline.Trampoline {
@@ -3484,7 +4364,7 @@ func (v UInt64Value) GetMember(_ *Interpreter, _ LocationRange, name string) Val
 		)
 	}
 
-	return nil
+	return getNumberValueMember(inter, v, name)
 }
 
 func (UInt64Value) SetMember(_ *Interpreter, _ LocationRange, _ string, _ Value) {
@@ -3726,9 +4606,15 @@ func (v UInt128Value) BitwiseRightShift(other IntegerValue) IntegerValue {
 	return UInt128Value{res}
 }
 
-func (v UInt128Value) GetMember(_ *Interpreter, _ LocationRange, name string) Value {
+func (v UInt128Value) GetMember(inter *Interpreter, _ LocationRange, name string) Value {
 	switch name {
 
+	case sema.NumberTypeMinFieldName:
+		return NewUInt128ValueFromUint64(0)
+
+	case sema.NumberTypeMaxFieldName:
+		return NewUInt128ValueFromBigInt(new(big.Int).Set(sema.UInt128TypeMaxIntBig))
+
 	case sema.ToStringFunctionName:
 		return NewHostFunctionValue(
 			func(invocation Invocation) trampoline.Trampoline {
@@ -3745,7 +4631,7 @@ func (v UInt128Value) GetMember(_ *Interpreter, _ LocationRange, name string) Va
 		)
 	}
 
-	return nil
+	return getNumberValueMember(inter, v, name)
 }
 
 func (UInt128Value) SetMember(_ *Interpreter, _ LocationRange, _ string, _ Value) {
@@ -3985,9 +4871,15 @@ func (v UInt256Value) BitwiseRightShift(other IntegerValue) IntegerValue {
 	return UInt256Value{res}
 }
 
-func (v UInt256Value) GetMember(_ *Interpreter, _ LocationRange, name string) Value {
+func (v UInt256Value) GetMember(inter *Interpreter, _ LocationRange, name string) Value {
 	switch name {
 
+	case sema.NumberTypeMinFieldName:
+		return NewUInt256ValueFromUint64(0)
+
+	case sema.NumberTypeMaxFieldName:
+		return NewUInt256ValueFromBigInt(new(big.Int).Set(sema.UInt256TypeMaxIntBig))
+
 	case sema.ToStringFunctionName:
 		return NewHostFunctionValue(
 			func(invocation Invocation) trampoline.Trampoline {
@@ -4005,7 +4897,7 @@ func (v UInt256Value) GetMember(_ *Interpreter, _ LocationRange, name string) Va
 		)
 	}
 
-	return nil
+	return getNumberValueMember(inter, v, name)
 }
 
 func (UInt256Value) SetMember(_ *Interpreter, _ LocationRange, _ string, _ Value) {
@@ -4144,9 +5036,15 @@ func (v Word8Value) BitwiseRightShift(other IntegerValue) IntegerValue {
 	return v >> o
 }
 
-func (v Word8Value) GetMember(_ *Interpreter, _ LocationRange, name string) Value {
+func (v Word8Value) GetMember(inter *Interpreter, _ LocationRange, name string) Value {
 	switch name {
 
+	case sema.NumberTypeMinFieldName:
+		return Word8Value(0)
+
+	case sema.NumberTypeMaxFieldName:
+		return Word8Value(math.MaxUint8)
+
 	case sema.ToStringFunctionName:
 		return NewHostFunctionValue(
 			func(invocation Invocation) trampoline.Trampoline {
@@ -4164,7 +5062,7 @@ func (v Word8Value) GetMember(_ *Interpreter, _ LocationRange, name string) Valu
 		)
 	}
 
-	return nil
+	return getNumberValueMember(inter, v, name)
 }
 
 func (Word8Value) SetMember(_ *Interpreter, _ LocationRange, _ string, _ Value) {
@@ -4301,9 +5199,15 @@ func (v Word16Value) BitwiseRightShift(other IntegerValue) IntegerValue {
 	return v >> o
 }
 
-func (v Word16Value) GetMember(_ *Interpreter, _ LocationRange, name string) Value {
+func (v Word16Value) GetMember(inter *Interpreter, _ LocationRange, name string) Value {
 	switch name {
 
+	case sema.NumberTypeMinFieldName:
+		return Word16Value(0)
+
+	case sema.NumberTypeMaxFieldName:
+		return Word16Value(math.MaxUint16)
+
 	case sema.ToStringFunctionName:
 		return NewHostFunctionValue(
 			func(invocation Invocation) trampoline.Trampoline {
@@ -4321,7 +5225,7 @@ func (v Word16Value) GetMember(_ *Interpreter, _ LocationRange, name string) Val
 		)
 	}
 
-	return nil
+	return getNumberValueMember(inter, v, name)
 }
 
 func (Word16Value) SetMember(_ *Interpreter, _ LocationRange, _ string, _ Value) {
@@ -4462,9 +5366,15 @@ func (v Word32Value) BitwiseRightShift(other IntegerValue) IntegerValue {
 	return v >> o
 }
 
-func (v Word32Value) GetMember(_ *Interpreter, _ LocationRange, name string) Value {
+func (v Word32Value) GetMember(inter *Interpreter, _ LocationRange, name string) Value {
 	switch name {
 
+	case sema.NumberTypeMinFieldName:
+		return Word32Value(0)
+
+	case sema.NumberTypeMaxFieldName:
+		return Word32Value(math.MaxUint32)
+
 	case sema.ToStringFunctionName:
 		return NewHostFunctionValue(
 			func(invocation Invocation) trampoline.Trampoline {
@@ -4482,7 +5392,7 @@ func (v Word32Value) GetMember(_ *Interpreter, _ LocationRange, name string) Val
 		)
 	}
 
-	return nil
+	return getNumberValueMember(inter, v, name)
 }
 
 func (Word32Value) SetMember(_ *Interpreter, _ LocationRange, _ string, _ Value) {
@@ -4623,9 +5533,15 @@ func (v Word64Value) BitwiseRightShift(other IntegerValue) IntegerValue {
 	return v >> o
 }
 
-func (v Word64Value) GetMember(_ *Interpreter, _ LocationRange, name string) Value {
+func (v Word64Value) GetMember(inter *Interpreter, _ LocationRange, name string) Value {
 	switch name {
 
+	case sema.NumberTypeMinFieldName:
+		return Word64Value(0)
+
+	case sema.NumberTypeMaxFieldName:
+		return Word64Value(math.MaxUint64)
+
 	case sema.ToStringFunctionName:
 		return NewHostFunctionValue(
 			func(invocation Invocation) trampoline.Trampoline {
@@ -4643,7 +5559,7 @@ func (v Word64Value) GetMember(_ *Interpreter, _ LocationRange, name string) Val
 		)
 	}
 
-	return nil
+	return getNumberValueMember(inter, v, name)
 }
 
 func (Word64Value) SetMember(_ *Interpreter, _ LocationRange, _ string, _ Value) {
@@ -4885,9 +5801,15 @@ func ConvertFix64(value Value, interpreter *Interpreter) Value {
 	}
 }
 
-func (v Fix64Value) GetMember(_ *Interpreter, _ LocationRange, name string) Value {
+func (v Fix64Value) GetMember(inter *Interpreter, _ LocationRange, name string) Value {
 	switch name {
 
+	case sema.NumberTypeMinFieldName:
+		return Fix64Value(math.MinInt64)
+
+	case sema.NumberTypeMaxFieldName:
+		return Fix64Value(math.MaxInt64)
+
 	case sema.ToStringFunctionName:
 		return NewHostFunctionValue(
 			func(invocation Invocation) trampoline.Trampoline {
@@ -4905,7 +5827,7 @@ func (v Fix64Value) GetMember(_ *Interpreter, _ LocationRange, name string) Valu
 		)
 	}
 
-	return nil
+	return getNumberValueMember(inter, v, name)
 }
 
 func (Fix64Value) SetMember(_ *Interpreter, _ LocationRange, _ string, _ Value) {
@@ -5139,9 +6061,15 @@ func ConvertUFix64(value Value, interpreter *Interpreter) Value {
 	}
 }
 
-func (v UFix64Value) GetMember(_ *Interpreter, _ LocationRange, name string) Value {
+func (v UFix64Value) GetMember(inter *Interpreter, _ LocationRange, name string) Value {
 	switch name {
 
+	case sema.NumberTypeMinFieldName:
+		return UFix64Value(0)
+
+	case sema.NumberTypeMaxFieldName:
+		return UFix64Value(math.MaxUint64)
+
 	case sema.ToStringFunctionName:
 		return NewHostFunctionValue(
 			func(invocation Invocation) trampoline.Trampoline {
@@ -5159,7 +6087,7 @@ func (v UFix64Value) GetMember(_ *Interpreter, _ LocationRange, name string) Val
 		)
 	}
 
-	return nil
+	return getNumberValueMember(inter, v, name)
 }
 
 func (UFix64Value) SetMember(_ *Interpreter, _ LocationRange, _ string, _ Value) {
@@ -5451,9 +6379,35 @@ func (v *CompositeValue) GetField(name string) Value {
 	return v.Fields[name]
 }
 
+// Equal returns true if the given value is a composite value
+// which is equal to this composite value.
+//
+// Only enum cases are equatable:
+// they are equal if they have the same type and raw value
+//
+func (v *CompositeValue) Equal(interpreter *Interpreter, other Value) BoolValue {
+	// TODO: call `equals` if composite is not an enum
+	if v.Kind != common.CompositeKindEnum {
+		return false
+	}
+
+	otherComposite, ok := other.(*CompositeValue)
+	if !ok || v.TypeID != otherComposite.TypeID {
+		return false
+	}
+
+	rawValue := v.Fields[sema.EnumRawValueFieldName].(EquatableValue)
+	otherRawValue := otherComposite.Fields[sema.EnumRawValueFieldName]
+
+	return rawValue.Equal(interpreter, otherRawValue)
+}
+
 // DictionaryValue
 
 type DictionaryValue struct {
+	// Keys contains the keys of the dictionary in insertion order.
+	// Updating the value of an existing key keeps the key's position,
+	// removing a key and inserting it again moves it to the end
 	Keys     *ArrayValue
 	Entries  map[string]Value
 	Owner    *common.Address
@@ -5702,6 +6656,15 @@ func (v *DictionaryValue) GetMember(_ *Interpreter, _ LocationRange, name string
 	case "keys":
 		return v.Keys.Copy()
 
+	case "sortedKeys":
+		return NewHostFunctionValue(
+			func(invocation Invocation) trampoline.Trampoline {
+				result := v.Keys.Copy().(*ArrayValue)
+				result.Sort()
+				return trampoline.Done{Result: result}
+			},
+		)
+
 	// TODO: is returning copies correct?
 	case "values":
 		dictionaryValues := make([]Value, v.Count())
@@ -5728,6 +6691,18 @@ func (v *DictionaryValue) GetMember(_ *Interpreter, _ LocationRange, name string
 			},
 		)
 
+	case "removeAll":
+		return NewHostFunctionValue(
+			func(invocation Invocation) trampoline.Trampoline {
+				v.RemoveAll(
+					invocation.Interpreter,
+					invocation.LocationRange,
+				)
+
+				return trampoline.Done{Result: VoidValue{}}
+			},
+		)
+
 	case "insert":
 		return NewHostFunctionValue(
 			func(invocation Invocation) trampoline.Trampoline {
@@ -5744,6 +6719,160 @@ func (v *DictionaryValue) GetMember(_ *Interpreter, _ LocationRange, name string
 				return trampoline.Done{Result: existingValue}
 			},
 		)
+
+	case "forEachValue":
+		return NewHostFunctionValue(
+			func(invocation Invocation) trampoline.Trampoline {
+				function := invocation.Arguments[0].(FunctionValue)
+				functionType := invocation.ArgumentTypes[0].(*sema.FunctionType)
+				referenceType := functionType.Parameters[0].TypeAnnotation.Type
+
+				// NOTE: iterate over a copy of the keys,
+				// as the function might modify the dictionary
+
+				keys := make([]Value, v.Count())
+				copy(keys, v.Keys.Values)
+
+				var iterate func(index int) trampoline.Trampoline
+				iterate = func(index int) trampoline.Trampoline {
+					if index >= len(keys) {
+						return trampoline.Done{Result: VoidValue{}}
+					}
+
+					// NOTE: use `Get`, as the value might be deferred and needs to be loaded
+
+					someValue, ok := v.Get(invocation.Interpreter, invocation.LocationRange, keys[index]).(*SomeValue)
+					if !ok {
+						// The entry was removed by the function
+						return iterate(index + 1)
+					}
+
+					reference := &EphemeralReferenceValue{
+						Value: someValue.Value,
+					}
+
+					return function.
+						Invoke(Invocation{
+							Arguments:     []Value{reference},
+							ArgumentTypes: []sema.Type{referenceType},
+							LocationRange: invocation.LocationRange,
+							Interpreter:   invocation.Interpreter,
+						}).
+						FlatMap(func(result interface{}) trampoline.Trampoline {
+							if !result.(BoolValue) {
+								return trampoline.Done{Result: VoidValue{}}
+							}
+
+							return iterate(index + 1)
+						})
+				}
+
+				return iterate(0)
+			},
+		)
+
+	case "filter":
+		return NewHostFunctionValue(
+			func(invocation Invocation) trampoline.Trampoline {
+				predicate := invocation.Arguments[0].(FunctionValue)
+				predicateType := invocation.ArgumentTypes[0].(*sema.FunctionType)
+				keyType := predicateType.Parameters[0].TypeAnnotation.Type
+				valueType := predicateType.Parameters[1].TypeAnnotation.Type
+
+				// NOTE: iterate over a copy of the keys,
+				// as the predicate might modify the dictionary
+
+				keys := make([]Value, v.Count())
+				copy(keys, v.Keys.Values)
+
+				result := NewDictionaryValueUnownedNonCopying()
+
+				var iterate func(index int) trampoline.Trampoline
+				iterate = func(index int) trampoline.Trampoline {
+					if index >= len(keys) {
+						return trampoline.Done{Result: result}
+					}
+
+					key := keys[index]
+
+					someValue, ok := v.Get(invocation.Interpreter, invocation.LocationRange, key).(*SomeValue)
+					if !ok {
+						// The entry was removed by the predicate
+						return iterate(index + 1)
+					}
+
+					value := someValue.Value
+
+					return predicate.
+						Invoke(Invocation{
+							Arguments:     []Value{key.Copy(), value.Copy()},
+							ArgumentTypes: []sema.Type{keyType, valueType},
+							LocationRange: invocation.LocationRange,
+							Interpreter:   invocation.Interpreter,
+						}).
+						FlatMap(func(include interface{}) trampoline.Trampoline {
+							if include.(BoolValue) {
+								_ = result.Insert(
+									invocation.Interpreter,
+									invocation.LocationRange,
+									key.Copy(),
+									value.Copy(),
+								)
+							}
+
+							return iterate(index + 1)
+						})
+				}
+
+				return iterate(0)
+			},
+		)
+
+	case "reduce":
+		return NewHostFunctionValue(
+			func(invocation Invocation) trampoline.Trampoline {
+				initial := invocation.Arguments[0]
+				combineFunction := invocation.Arguments[1].(FunctionValue)
+				combineFunctionType := invocation.ArgumentTypes[1].(*sema.FunctionType)
+				accumulatorType := combineFunctionType.Parameters[0].TypeAnnotation.Type
+				keyType := combineFunctionType.Parameters[1].TypeAnnotation.Type
+				valueType := combineFunctionType.Parameters[2].TypeAnnotation.Type
+
+				// NOTE: iterate over a copy of the keys,
+				// as the combine function might modify the dictionary
+
+				keys := make([]Value, v.Count())
+				copy(keys, v.Keys.Values)
+
+				var fold func(index int, accumulator Value) trampoline.Trampoline
+				fold = func(index int, accumulator Value) trampoline.Trampoline {
+					if index >= len(keys) {
+						return trampoline.Done{Result: accumulator}
+					}
+
+					key := keys[index]
+
+					someValue, ok := v.Get(invocation.Interpreter, invocation.LocationRange, key).(*SomeValue)
+					if !ok {
+						// The entry was removed by the combine function
+						return fold(index+1, accumulator)
+					}
+
+					return combineFunction.
+						Invoke(Invocation{
+							Arguments:     []Value{accumulator, key.Copy(), someValue.Value.Copy()},
+							ArgumentTypes: []sema.Type{accumulatorType, keyType, valueType},
+							LocationRange: invocation.LocationRange,
+							Interpreter:   invocation.Interpreter,
+						}).
+						FlatMap(func(result interface{}) trampoline.Trampoline {
+							return fold(index+1, result.(Value))
+						})
+				}
+
+				return fold(0, initial)
+			},
+		)
 	}
 
 	return nil
@@ -5801,6 +6930,21 @@ func (v *DictionaryValue) Remove(inter *Interpreter, locationRange LocationRange
 	}
 }
 
+// RemoveAll removes all entries from the dictionary
+//
+func (v *DictionaryValue) RemoveAll(inter *Interpreter, locationRange LocationRange) {
+	v.modified = true
+
+	// Remove the entries one by one, so deferred values are handled properly
+
+	keys := make([]Value, len(v.Keys.Values))
+	copy(keys, v.Keys.Values)
+
+	for _, keyValue := range keys {
+		v.Remove(inter, locationRange, keyValue)
+	}
+}
+
 func (v *DictionaryValue) Insert(inter *Interpreter, locationRange LocationRange, keyValue, value Value) OptionalValue {
 	v.modified = true
 
@@ -5892,10 +7036,19 @@ var nilValueMapFunction = NewHostFunctionValue(
 	},
 )
 
+var nilValueOrElseFunction = NewHostFunctionValue(
+	func(invocation Invocation) trampoline.Trampoline {
+		return trampoline.Done{Result: invocation.Arguments[0]}
+	},
+)
+
 func (v NilValue) GetMember(_ *Interpreter, _ LocationRange, name string) Value {
 	switch name {
-	case "map":
+	case "map", "mapRef":
 		return nilValueMapFunction
+
+	case "orElse":
+		return nilValueOrElseFunction
 	}
 
 	return nil
@@ -5989,6 +7142,39 @@ func (v *SomeValue) GetMember(_ *Interpreter, _ LocationRange, name string) Valu
 					})
 			},
 		)
+
+	case "mapRef":
+		return NewHostFunctionValue(
+			func(invocation Invocation) trampoline.Trampoline {
+
+				transformFunction := invocation.Arguments[0].(FunctionValue)
+				transformFunctionType := invocation.ArgumentTypes[0].(*sema.FunctionType)
+				referenceType := transformFunctionType.Parameters[0].TypeAnnotation.Type
+
+				reference := &EphemeralReferenceValue{
+					Value: v.Value,
+				}
+
+				return transformFunction.
+					Invoke(Invocation{
+						Arguments:     []Value{reference},
+						ArgumentTypes: []sema.Type{referenceType},
+						LocationRange: invocation.LocationRange,
+						Interpreter:   invocation.Interpreter,
+					}).
+					Map(func(result interface{}) interface{} {
+						newValue := result.(Value)
+						return NewSomeValueOwningNonCopying(newValue)
+					})
+			},
+		)
+
+	case "orElse":
+		return NewHostFunctionValue(
+			func(invocation Invocation) trampoline.Trampoline {
+				return trampoline.Done{Result: v.Value}
+			},
+		)
 	}
 
 	return nil
@@ -6312,6 +7498,14 @@ func (v AddressValue) Equal(_ *Interpreter, other Value) BoolValue {
 	return v == otherAddress
 }
 
+// Compare compares this address to the given address numerically,
+// i.e. by their big-endian byte representations.
+// The result is -1, 0, or 1
+//
+func (v AddressValue) Compare(other AddressValue) int {
+	return bytes.Compare(v[:], other[:])
+}
+
 func (v AddressValue) Hex() string {
 	return v.ToAddress().Hex()
 }
@@ -6331,11 +7525,12 @@ func (v AddressValue) GetMember(_ *Interpreter, _ LocationRange, name string) Va
 			},
 		)
 
-	case sema.AddressTypeToBytesFunctionName:
+	case sema.AddressTypeToBytesFunctionName,
+		sema.ToBigEndianBytesFunctionName:
+
 		return NewHostFunctionValue(
 			func(invocation Invocation) trampoline.Trampoline {
-				bytes := common.Address(v)
-				result := ByteSliceToByteArrayValue(bytes[:])
+				result := ByteSliceToByteArrayValue(v.ToBigEndianBytes())
 				return trampoline.Done{Result: result}
 			},
 		)
@@ -6344,6 +7539,14 @@ func (v AddressValue) GetMember(_ *Interpreter, _ LocationRange, name string) Va
 	return nil
 }
 
+// ToBigEndianBytes returns the big-endian byte representation of the address,
+// which is the same as its byte representation
+//
+func (v AddressValue) ToBigEndianBytes() []byte {
+	bytes := common.Address(v)
+	return bytes[:]
+}
+
 func (AddressValue) SetMember(_ *Interpreter, _ LocationRange, _ string, _ Value) {
 	panic(errors.NewUnreachableError())
 }
@@ -6422,9 +7625,18 @@ func (v AuthAccountValue) String() string {
 	return fmt.Sprintf("AuthAccount(%s)", v.Address)
 }
 
+// accountGetCapabilityFunction returns a function which returns the capability
+// at a given path of the account with the given address.
+//
+// If optional is true, the function returns an optional capability
+// and nil if the path has an invalid domain (the function `getCapability`).
+// Otherwise, the function returns a non-optional capability
+// and aborts if the path has an invalid domain (the function `capability`).
+//
 func accountGetCapabilityFunction(
 	addressValue AddressValue,
 	authorized bool,
+	optional bool,
 ) HostFunctionValue {
 
 	return NewHostFunctionValue(
@@ -6443,29 +7655,33 @@ func accountGetCapabilityFunction(
 				break
 			}
 
-			if authorized {
+			// If the account is an authorized account (`AuthAccount`),
+			// ensure the path has a `private` or `public` domain.
+			//
+			// If the account is a public account (`PublicAccount`),
+			// ensure the path has a `public` domain.
 
-				// If the account is an authorized account (`AuthAccount`),
-				// ensure the path has a `private` or `public` domain.
+			expectedDomains := []common.PathDomain{
+				common.PathDomainPublic,
+			}
 
-				if !checkPathDomain(
-					path,
+			if authorized {
+				expectedDomains = append(
+					expectedDomains,
 					common.PathDomainPrivate,
-					common.PathDomainPublic,
-				) {
+				)
+			}
+
+			if optional {
+				if !checkPathDomain(path, expectedDomains...) {
 					return trampoline.Done{Result: NilValue{}}
 				}
 			} else {
-
-				// If the account is a public account (`PublicAccount`),
-				// ensure the path has a `public` domain.
-
-				if !checkPathDomain(
+				mustPathDomain(
 					path,
-					common.PathDomainPublic,
-				) {
-					return trampoline.Done{Result: NilValue{}}
-				}
+					invocation.LocationRange,
+					expectedDomains...,
+				)
 			}
 
 			var borrowStaticType StaticType
@@ -6473,13 +7689,15 @@ func accountGetCapabilityFunction(
 				borrowStaticType = ConvertSemaToStaticType(borrowType)
 			}
 
-			capability := CapabilityValue{
+			var result Value = CapabilityValue{
 				Address:    addressValue,
 				Path:       path,
 				BorrowType: borrowStaticType,
 			}
 
-			result := NewSomeValueOwningNonCopying(capability)
+			if optional {
+				result = NewSomeValueOwningNonCopying(result)
+			}
 
 			return trampoline.Done{Result: result}
 		},
@@ -6506,12 +7724,24 @@ func (v AuthAccountValue) GetMember(inter *Interpreter, _ LocationRange, name st
 	case "load":
 		return inter.authAccountLoadFunction(v.Address)
 
+	case "loadStrict":
+		return inter.authAccountLoadStrictFunction(v.Address)
+
 	case "copy":
 		return inter.authAccountCopyFunction(v.Address)
 
 	case "save":
 		return inter.authAccountSaveFunction(v.Address)
 
+	case "trySave":
+		return inter.authAccountTrySaveFunction(v.Address)
+
+	case "type":
+		return inter.authAccountTypeFunction(v.Address)
+
+	case sema.AuthAccountForEachStoredFunctionName:
+		return inter.authAccountForEachStoredFunction(v.Address)
+
 	case "borrow":
 		return inter.authAccountBorrowFunction(v.Address)
 
@@ -6525,7 +7755,10 @@ func (v AuthAccountValue) GetMember(inter *Interpreter, _ LocationRange, name st
 		return inter.accountGetLinkTargetFunction(v.Address)
 
 	case "getCapability":
-		return accountGetCapabilityFunction(v.Address, true)
+		return accountGetCapabilityFunction(v.Address, true, true)
+
+	case "capability":
+		return accountGetCapabilityFunction(v.Address, true, false)
 
 	}
 
@@ -6596,7 +7829,10 @@ func (v PublicAccountValue) GetMember(inter *Interpreter, _ LocationRange, name
 		return v.Address
 
 	case "getCapability":
-		return accountGetCapabilityFunction(v.Address, false)
+		return accountGetCapabilityFunction(v.Address, false, true)
+
+	case "capability":
+		return accountGetCapabilityFunction(v.Address, false, false)
 
 	case "getLinkTarget":
 		return inter.accountGetLinkTargetFunction(v.Address)
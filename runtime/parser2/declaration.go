@@ -82,7 +82,7 @@ func parseDeclaration(p *parser, docString string) ast.Declaration {
 			case keywordEvent:
 				return parseEventDeclaration(p, access, accessPos, docString)
 
-			case keywordStruct, keywordResource, keywordContract:
+			case keywordStruct, keywordResource, keywordContract, keywordEnum:
 				return parseCompositeOrInterfaceDeclaration(p, access, accessPos, docString)
 
 			case keywordTransaction:
@@ -642,7 +642,7 @@ func parseEventDeclaration(
 
 // parseCompositeKind parses a composite kind.
 //
-//     compositeKind : 'struct' | 'resource' | 'contract'
+//     compositeKind : 'struct' | 'resource' | 'contract' | 'enum'
 //
 func parseCompositeKind(p *parser) common.CompositeKind {
 
@@ -656,6 +656,9 @@ func parseCompositeKind(p *parser) common.CompositeKind {
 
 		case keywordContract:
 			return common.CompositeKindContract
+
+		case keywordEnum:
+			return common.CompositeKindEnum
 		}
 	}
 
@@ -785,6 +788,13 @@ func parseCompositeOrInterfaceDeclaration(
 		}
 	}
 
+	if isInterface && !compositeKind.SupportsInterfaces() {
+		panic(fmt.Errorf(
+			"invalid interface for composite kind %s",
+			compositeKind.Keyword(),
+		))
+	}
+
 	p.skipSpaceAndComments(true)
 
 	var conformances []*ast.NominalType
@@ -895,6 +905,7 @@ func parseMembersAndNestedDeclarations(
 //                               | interfaceDeclaration
 //                               | compositeDeclaration
 //                               | eventDeclaration
+//                               | enumCase
 //
 func parseMemberOrNestedDeclaration(p *parser, docString string) ast.Declaration {
 
@@ -920,9 +931,12 @@ func parseMemberOrNestedDeclaration(p *parser, docString string) ast.Declaration
 			case keywordEvent:
 				return parseEventDeclaration(p, access, accessPos, docString)
 
-			case keywordStruct, keywordResource, keywordContract:
+			case keywordStruct, keywordResource, keywordContract, keywordEnum:
 				return parseCompositeOrInterfaceDeclaration(p, access, accessPos, docString)
 
+			case keywordCase:
+				return parseEnumCase(p, access, accessPos, docString)
+
 			case keywordPriv, keywordPub, keywordAccess:
 				if access != ast.AccessNotSpecified {
 					panic(fmt.Errorf("unexpected access modifier"))
@@ -965,6 +979,45 @@ func parseMemberOrNestedDeclaration(p *parser, docString string) ast.Declaration
 	}
 }
 
+// parseEnumCase parses a case of an enum.
+//
+//     enumCase : 'case' identifier
+//
+func parseEnumCase(
+	p *parser,
+	access ast.Access,
+	accessPos *ast.Position,
+	docString string,
+) *ast.EnumCaseDeclaration {
+
+	startPos := p.current.StartPos
+	if accessPos != nil {
+		startPos = *accessPos
+	}
+
+	// Skip the `case` keyword
+	p.next()
+
+	p.skipSpaceAndComments(true)
+	if !p.current.Is(lexer.TokenIdentifier) {
+		panic(fmt.Errorf(
+			"expected identifier after start of enum case declaration, got %s",
+			p.current.Type,
+		))
+	}
+
+	identifier := tokenToIdentifier(p.current)
+	// Skip the identifier
+	p.next()
+
+	return &ast.EnumCaseDeclaration{
+		Access:     access,
+		Identifier: identifier,
+		DocString:  docString,
+		StartPos:   startPos,
+	}
+}
+
 func parseFieldDeclarationWithoutVariableKind(
 	p *parser,
 	access ast.Access,
@@ -1871,6 +1871,77 @@ func TestParseCompositeDeclaration(t *testing.T) {
 	})
 }
 
+func TestParseEnumDeclaration(t *testing.T) {
+
+	t.Parallel()
+
+	result, errs := ParseDeclarations(" pub enum E : UInt8 { case a ; pub case b }")
+	require.Empty(t, errs)
+
+	utils.AssertEqualWithDiff(t,
+		[]ast.Declaration{
+			&ast.CompositeDeclaration{
+				Access:        ast.AccessPublic,
+				CompositeKind: common.CompositeKindEnum,
+				Identifier: ast.Identifier{
+					Identifier: "E",
+					Pos:        ast.Position{Line: 1, Column: 10, Offset: 10},
+				},
+				Conformances: []*ast.NominalType{
+					{
+						Identifier: ast.Identifier{
+							Identifier: "UInt8",
+							Pos:        ast.Position{Line: 1, Column: 14, Offset: 14},
+						},
+					},
+				},
+				Members: &ast.Members{
+					Declarations: []ast.Declaration{
+						&ast.EnumCaseDeclaration{
+							Access: ast.AccessNotSpecified,
+							Identifier: ast.Identifier{
+								Identifier: "a",
+								Pos:        ast.Position{Line: 1, Column: 27, Offset: 27},
+							},
+							StartPos: ast.Position{Line: 1, Column: 22, Offset: 22},
+						},
+						&ast.EnumCaseDeclaration{
+							Access: ast.AccessPublic,
+							Identifier: ast.Identifier{
+								Identifier: "b",
+								Pos:        ast.Position{Line: 1, Column: 40, Offset: 40},
+							},
+							StartPos: ast.Position{Line: 1, Column: 31, Offset: 31},
+						},
+					},
+				},
+				Range: ast.Range{
+					StartPos: ast.Position{Line: 1, Column: 1, Offset: 1},
+					EndPos:   ast.Position{Line: 1, Column: 42, Offset: 42},
+				},
+			},
+		},
+		result,
+	)
+}
+
+func TestParseInvalidEnumInterfaceDeclaration(t *testing.T) {
+
+	t.Parallel()
+
+	_, errs := ParseDeclarations(" pub enum interface E { }")
+
+	utils.AssertEqualWithDiff(t,
+		[]error{
+			&SyntaxError{
+				Message: "invalid interface for composite kind enum",
+				Pos:     ast.Position{Offset: 21, Line: 1, Column: 21},
+			},
+		},
+		errs,
+	)
+}
+
 func TestParseInterfaceDeclaration(t *testing.T) {
 
 	t.Parallel()
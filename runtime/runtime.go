@@ -530,6 +530,14 @@ func (r *interpreterRuntime) parseAndCheckProgram(
 	importResolver := r.importResolver(runtimeInterface)
 	valueDeclarations := functions.ToValueDeclarations()
 
+	// Storage iteration is only available if the runtime interface can enumerate storage keys
+
+	storageIteration := false
+	storageKeysEnumerator, ok := runtimeInterface.(StorageKeysEnumerator)
+	if ok {
+		storageIteration = storageKeysEnumerator.StorageKeysEnumerationEnabled()
+	}
+
 	checker, err := sema.NewChecker(
 		program,
 		location,
@@ -539,6 +547,7 @@ func (r *interpreterRuntime) parseAndCheckProgram(
 				sema.WithPredeclaredTypes(typeDeclarations),
 				sema.WithValidTopLevelDeclarationsHandler(validTopLevelDeclarations),
 				sema.WithLocationHandler(runtimeInterface.ResolveLocation),
+				sema.WithStorageIteration(storageIteration),
 				sema.WithImportHandler(func(checker *sema.Checker, location ast.Location) (sema.Import, *sema.CheckerError) {
 					switch location {
 					case stdlib.CryptoChecker.Location:
@@ -735,7 +744,7 @@ func (r *interpreterRuntime) injectedCompositeFieldsHandler(
 }
 
 func (r *interpreterRuntime) storageInterpreterOptions(runtimeStorage *interpreterRuntimeStorage) []interpreter.Option {
-	return []interpreter.Option{
+	options := []interpreter.Option{
 		interpreter.WithStorageExistenceHandler(
 			func(_ *interpreter.Interpreter, address common.Address, key string) bool {
 				return runtimeStorage.valueExists(address, key)
@@ -752,6 +761,18 @@ func (r *interpreterRuntime) storageInterpreterOptions(runtimeStorage *interpret
 			},
 		),
 	}
+
+	if runtimeStorage.storageKeysEnabled {
+		options = append(options,
+			interpreter.WithStorageKeysHandler(
+				func(_ *interpreter.Interpreter, address common.Address) []string {
+					return runtimeStorage.storageKeys(address)
+				},
+			),
+		)
+	}
+
+	return options
 }
 
 func (r *interpreterRuntime) meteringInterpreterOptions(runtimeInterface Interface) []interpreter.Option {
@@ -19,6 +19,7 @@
 package runtime
 
 import (
+	"sort"
 	"time"
 
 	"github.com/onflow/cadence"
@@ -43,6 +44,8 @@ type interpreterRuntimeStorage struct {
 	runtimeInterface        Interface
 	highLevelStorageEnabled bool
 	highLevelStorage        HighLevelStorage
+	storageKeysEnabled      bool
+	storageKeysEnumerator   StorageKeysEnumerator
 	cache                   map[storageKey]cacheEntry
 }
 
@@ -53,11 +56,19 @@ func newInterpreterRuntimeStorage(runtimeInterface Interface) *interpreterRuntim
 		highLevelStorageEnabled = highLevelStorage.HighLevelStorageEnabled()
 	}
 
+	storageKeysEnabled := false
+	storageKeysEnumerator, ok := runtimeInterface.(StorageKeysEnumerator)
+	if ok {
+		storageKeysEnabled = storageKeysEnumerator.StorageKeysEnumerationEnabled()
+	}
+
 	return &interpreterRuntimeStorage{
 		runtimeInterface:        runtimeInterface,
 		cache:                   map[storageKey]cacheEntry{},
 		highLevelStorage:        highLevelStorage,
 		highLevelStorageEnabled: highLevelStorageEnabled,
+		storageKeysEnumerator:   storageKeysEnumerator,
+		storageKeysEnabled:      storageKeysEnabled,
 	}
 }
 
@@ -182,6 +193,55 @@ func (s *interpreterRuntimeStorage) readValue(
 	return interpreter.NewSomeValueOwningNonCopying(storedValue)
 }
 
+// storageKeys is the StorageKeysHandlerFunc for the interpreter.
+//
+// It enumerates the keys of the values stored in the given account (through the runtime interface),
+// and combines them with the values in the cache, which are not yet saved in storage.
+//
+// The keys are returned in sorted order, so the result is deterministic.
+//
+func (s *interpreterRuntimeStorage) storageKeys(address common.Address) []string {
+
+	var storedKeys []string
+	var err error
+	wrapPanic(func() {
+		storedKeys, err = s.storageKeysEnumerator.GetStorageKeys(address)
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	keys := make(map[string]struct{}, len(storedKeys))
+
+	for _, key := range storedKeys {
+		keys[key] = struct{}{}
+	}
+
+	// Values in the cache take precedence over the values in storage:
+	// They may have been written or removed, but not saved yet
+
+	for fullKey, entry := range s.cache {
+		if fullKey.address != address {
+			continue
+		}
+
+		if entry.value == nil {
+			delete(keys, fullKey.key)
+		} else {
+			keys[fullKey.key] = struct{}{}
+		}
+	}
+
+	result := make([]string, 0, len(keys))
+	for key := range keys {
+		result = append(result, key)
+	}
+
+	sort.Strings(result)
+
+	return result
+}
+
 // writeValue is the StorageWriteHandlerFunc for the interpreter.
 //
 // It only places the written value in the cache.
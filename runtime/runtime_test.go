@@ -118,6 +118,7 @@ type testRuntimeInterface struct {
 	) bool
 	hash            func(data []byte, hashAlgorithm string) []byte
 	setCadenceValue func(owner Address, key string, value cadence.Value) (err error)
+	getStorageKeys  func(owner Address) (keys []string, err error)
 }
 
 var _ Interface = &testRuntimeInterface{}
@@ -316,6 +317,14 @@ func (i *testRuntimeInterface) SetCadenceValue(owner common.Address, key string,
 	return i.setCadenceValue(owner, key, value)
 }
 
+func (i *testRuntimeInterface) StorageKeysEnumerationEnabled() bool {
+	return i.getStorageKeys != nil
+}
+
+func (i *testRuntimeInterface) GetStorageKeys(owner common.Address) (keys []string, err error) {
+	return i.getStorageKeys(owner)
+}
+
 func TestRuntimeImport(t *testing.T) {
 
 	t.Parallel()
@@ -1080,6 +1089,38 @@ func TestRuntimeScriptArguments(t *testing.T) {
 			},
 			expectedLogs: []string{`"bar"`},
 		},
+		{
+			label: "Enum",
+			script: `
+				pub enum Foo: UInt8 {
+					pub case a
+					pub case b
+				}
+
+				pub fun main(x: Foo) {
+					log(x.rawValue)
+					log(x == Foo.b)
+				}
+			`,
+			args: [][]byte{
+				jsoncdc.MustEncode(
+					cadence.
+						NewEnum([]cadence.Value{cadence.NewUInt8(1)}).
+						WithType(&cadence.EnumType{
+							TypeID:     "S.test.Foo",
+							Identifier: "Foo",
+							RawType:    cadence.UInt8Type{},
+							Fields: []cadence.Field{
+								{
+									Identifier: "rawValue",
+									Type:       cadence.UInt8Type{},
+								},
+							},
+						}),
+				),
+			},
+			expectedLogs: []string{"1", "true"},
+		},
 	}
 
 	for _, tt := range tests {
@@ -2056,6 +2097,120 @@ func TestRuntimeAccountAddress(t *testing.T) {
 	assert.Equal(t, []string{"0x2a"}, loggedMessages)
 }
 
+func TestRuntimeAccountForEachStored(t *testing.T) {
+
+	t.Parallel()
+
+	runtime := NewInterpreterRuntime()
+
+	setupTx := []byte(`
+      transaction {
+        prepare(signer: AuthAccount) {
+          signer.save(1, to: /storage/a)
+          signer.save("2", to: /storage/b)
+          signer.link<&Int>(/public/a, target: /storage/a)
+        }
+      }
+    `)
+
+	iterateTx := []byte(`
+      transaction {
+        prepare(signer: AuthAccount) {
+          signer.save(true, to: /storage/c)
+          signer.load<Int>(from: /storage/a)
+
+          signer.forEachStored(fun (path: Path, type: Type): Bool {
+              log(path)
+              log(type)
+              return true
+          })
+        }
+      }
+    `)
+
+	address := common.BytesToAddress([]byte{42})
+
+	storage := newTestStorage(nil, nil)
+
+	var loggedMessages []string
+
+	runtimeInterface := &testRuntimeInterface{
+		storage: storage,
+		getSigningAccounts: func() []Address {
+			return []Address{address}
+		},
+		log: func(message string) {
+			loggedMessages = append(loggedMessages, message)
+		},
+		getStorageKeys: func(owner Address) (keys []string, err error) {
+			prefix := string(owner[:]) + "|"
+			for storageKey, value := range storage.storedValues {
+				if len(value) == 0 || !strings.HasPrefix(storageKey, prefix) {
+					continue
+				}
+				keys = append(keys, storageKey[len(prefix):])
+			}
+			return keys, nil
+		},
+	}
+
+	nextTransactionLocation := newTransactionLocationGenerator()
+
+	err := runtime.ExecuteTransaction(setupTx, nil, runtimeInterface, nextTransactionLocation())
+	require.NoError(t, err)
+
+	err = runtime.ExecuteTransaction(iterateTx, nil, runtimeInterface, nextTransactionLocation())
+	require.NoError(t, err)
+
+	// The removed value is not iterated over, but the newly saved one is.
+	// Values outside of the storage domain, e.g. links, are not iterated over
+
+	assert.Equal(t,
+		[]string{
+			"/storage/b",
+			"Type<String>",
+			"/storage/c",
+			"Type<Bool>",
+		},
+		loggedMessages,
+	)
+}
+
+func TestRuntimeAccountForEachStoredUnavailable(t *testing.T) {
+
+	t.Parallel()
+
+	runtime := NewInterpreterRuntime()
+
+	script := []byte(`
+      transaction {
+        prepare(signer: AuthAccount) {
+          signer.forEachStored(fun (path: Path, type: Type): Bool {
+              return true
+          })
+        }
+      }
+    `)
+
+	runtimeInterface := &testRuntimeInterface{
+		getSigningAccounts: func() []Address {
+			return []Address{common.BytesToAddress([]byte{42})}
+		},
+	}
+
+	nextTransactionLocation := newTransactionLocationGenerator()
+
+	err := runtime.ExecuteTransaction(script, nil, runtimeInterface, nextTransactionLocation())
+	require.Error(t, err)
+
+	require.IsType(t, Error{}, err)
+	err = err.(Error).Unwrap()
+
+	errs := checker.ExpectCheckerErrors(t, err, 1)
+
+	assert.IsType(t, &sema.NotDeclaredMemberError{}, errs[0])
+}
+
 func TestRuntimePublicAccountAddress(t *testing.T) {
 
 	t.Parallel()
@@ -146,8 +146,23 @@ func (checker *Checker) checkBinaryExpressionArithmeticOrNonEqualityComparisonOr
 		panic(errors.NewUnreachableError())
 	}
 
-	leftIsNumber := IsSubType(leftType, expectedSuperType)
-	rightIsNumber := IsSubType(rightType, expectedSuperType)
+	isValidOperandType := func(ty Type) bool {
+		if IsSubType(ty, expectedSuperType) {
+			return true
+		}
+
+		// Addresses are not numbers, but can be ordered
+
+		if operationKind == BinaryOperationKindNonEqualityComparison {
+			_, ok := ty.(*AddressType)
+			return ok
+		}
+
+		return false
+	}
+
+	leftIsNumber := isValidOperandType(leftType)
+	rightIsNumber := isValidOperandType(rightType)
 
 	reportedInvalidOperands := false
 
@@ -330,6 +345,16 @@ func (checker *Checker) checkBinaryExpressionNilCoalescing(
 					Range:        ast.NewRangeFromPositioned(expression.Left),
 				},
 			)
+
+			// The left-hand side is never nil,
+			// so the nil-coalescing is redundant
+
+			checker.hint(
+				&ReplacementHint{
+					Expression: expression.Left,
+					Range:      ast.NewRangeFromPositioned(expression),
+				},
+			)
 		}
 	}
 
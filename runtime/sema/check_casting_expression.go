@@ -115,9 +115,10 @@ func (checker *Checker) VisitCastingExpression(expression *ast.CastingExpression
 
 			checker.report(
 				&TypeMismatchError{
-					ActualType:   leftHandType,
-					ExpectedType: rightHandType,
-					Range:        ast.NewRangeFromPositioned(leftHandExpression),
+					ActualType:          leftHandType,
+					ExpectedType:        rightHandType,
+					MissingRestrictions: MissingRestrictions(leftHandType, rightHandType),
+					Range:               ast.NewRangeFromPositioned(leftHandExpression),
 				},
 			)
 		}
@@ -150,6 +151,20 @@ func FailableCastCanSucceed(subType, superType Type) bool {
 			// if `T` is a subtype of `U`
 
 			if typedSubType.Authorized {
+
+				// Casting a reference to a composite type `&T`
+				// to a reference to another composite type `&U`
+				// can only succeed if the types are related,
+				// i.e. `T` is `U`, or one is a type requirement the other conforms to.
+				// Composite types are nominal, so the referenced value can't have both types
+
+				if innerSubType, ok := typedSubType.Type.(*CompositeType); ok {
+					if innerSuperType, ok := typedSuperType.Type.(*CompositeType); ok {
+						return IsSubType(innerSubType, innerSuperType) ||
+							IsSubType(innerSuperType, innerSubType)
+					}
+				}
+
 				return FailableCastCanSucceed(typedSubType.Type, typedSuperType.Type)
 			}
 
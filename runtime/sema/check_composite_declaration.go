@@ -19,6 +19,10 @@
 package sema
 
 import (
+	"math/big"
+	"sort"
+	"strings"
+
 	"github.com/onflow/cadence/runtime/ast"
 	"github.com/onflow/cadence/runtime/common"
 	"github.com/onflow/cadence/runtime/errors"
@@ -64,6 +68,12 @@ func (checker *Checker) visitCompositeDeclaration(declaration *ast.CompositeDecl
 
 	checker.checkNestedIdentifiers(declaration.Members)
 
+	if declaration.CompositeKind == common.CompositeKindEnum {
+		checker.checkEnumMembers(declaration.Members, compositeType.EnumRawType)
+	} else {
+		checker.checkNoEnumCases(declaration.Members, declaration.DeclarationKind())
+	}
+
 	// Activate new scopes for nested types
 
 	checker.typeActivations.Enter()
@@ -98,7 +108,7 @@ func (checker *Checker) visitCompositeDeclaration(declaration *ast.CompositeDecl
 		declaration.Members.Fields(),
 		compositeType,
 		declaration.DeclarationKind(),
-		compositeType.ConstructorParameters,
+		compositeType.ConstructorParameterLists,
 		kind,
 		initializationInfo,
 	)
@@ -179,6 +189,13 @@ func (checker *Checker) visitCompositeDeclaration(declaration *ast.CompositeDecl
 		)
 	})
 
+	if kind == ContainerKindComposite &&
+		compositeType.Kind == common.CompositeKindContract &&
+		checker.publicMutableContractFieldHints {
+
+		checker.hintPublicMutableContractFields(declaration, compositeType)
+	}
+
 	// NOTE: visit interfaces first
 	// DON'T use `nestedDeclarations`, because of non-deterministic order
 
@@ -191,6 +208,39 @@ func (checker *Checker) visitCompositeDeclaration(declaration *ast.CompositeDecl
 	}
 }
 
+// hintPublicMutableContractFields hints about the public, mutable fields of a contract.
+//
+// Contracts are singletons, so such a field is global state,
+// which might be surprising to users of the contract
+//
+func (checker *Checker) hintPublicMutableContractFields(
+	declaration *ast.CompositeDeclaration,
+	compositeType *CompositeType,
+) {
+	for _, field := range declaration.Members.Fields() {
+		fieldName := field.Identifier.Identifier
+
+		member, ok := compositeType.Members[fieldName]
+		if !ok {
+			continue
+		}
+
+		if member.VariableKind != ast.VariableKindVariable {
+			continue
+		}
+
+		switch member.Access {
+		case ast.AccessPublic, ast.AccessPublicSettable:
+			checker.hint(
+				&PublicMutableContractFieldHint{
+					FieldName: fieldName,
+					Range:     ast.NewRangeFromPositioned(field.Identifier),
+				},
+			)
+		}
+	}
+}
+
 // declareCompositeNestedTypes declares the types nested in a composite,
 // and the constructors for them if `declareConstructors` is true
 // and `kind` is `ContainerKindComposite`.
@@ -325,6 +375,22 @@ func (checker *Checker) declareNestedDeclarations(
 				common.CompositeKindEvent:
 				break
 
+			case common.CompositeKindEnum:
+				// Enums cannot be type requirements,
+				// so they may only be nested in contracts
+
+				if containerDeclarationKind == common.DeclarationKindContract {
+					break
+				}
+
+				checker.report(
+					&InvalidNestedDeclarationError{
+						NestedDeclarationKind:    nestedDeclarationKind,
+						ContainerDeclarationKind: containerDeclarationKind,
+						Range:                    ast.NewRangeFromPositioned(identifier),
+					},
+				)
+
 			default:
 				checker.report(
 					&InvalidNestedDeclarationError{
@@ -477,16 +543,34 @@ func (checker *Checker) declareCompositeMembersAndValue(
 
 		checker.declareCompositeNestedTypes(declaration, kind, false)
 
-		// Resolve conformances
+		// Resolve conformances.
+		// Enums do not conform to interfaces,
+		// the conformance of an enum declares its raw type instead
 
-		conformances := checker.explicitInterfaceConformances(declaration, compositeType)
-		compositeType.ExplicitInterfaceConformances = conformances
+		if declaration.CompositeKind == common.CompositeKindEnum {
+			compositeType.EnumRawType = checker.enumRawType(declaration)
+		} else {
+			conformances := checker.explicitInterfaceConformances(declaration, compositeType)
+			compositeType.ExplicitInterfaceConformances = conformances
+		}
 
 		// NOTE: determine initializer parameter types while nested types are in scope,
 		// and after declaring nested types as the initializer may use nested type in parameters
 
+		// NOTE: only structures and resources may declare multiple initializers:
+		// The initializer of a contract is invoked with the deployment arguments,
+		// and the initializer of an event is synthesized
+
+		allowOverloading := kind == ContainerKindComposite &&
+			(compositeType.Kind == common.CompositeKindStructure ||
+				compositeType.Kind == common.CompositeKindResource)
+
 		initializers := declaration.Members.Initializers()
-		compositeType.ConstructorParameters = checker.initializerParameters(initializers)
+		parameterLists := checker.initializerParameterLists(initializers, allowOverloading)
+		compositeType.ConstructorParameterLists = parameterLists
+		if len(parameterLists) > 0 {
+			compositeType.ConstructorParameters = parameterLists[0]
+		}
 
 		// Declare nested declarations' members
 
@@ -530,6 +614,11 @@ func (checker *Checker) declareCompositeMembersAndValue(
 			}
 		}
 
+		// Check that the conformances do not have conflicting type requirements,
+		// i.e. nested composite types with the same identifier, but different kinds
+
+		checker.checkConflictingTypeRequirements(compositeType, declaration.Identifier)
+
 		// Declare implicit type requirement conformances, if any,
 		// after nested types are declared, and
 		// after explicit conformances are declared.
@@ -586,7 +675,13 @@ func (checker *Checker) declareCompositeMembersAndValue(
 	// Always determine composite constructor type
 
 	constructorType, constructorArgumentLabels := checker.compositeConstructorType(declaration, compositeType)
-	constructorType.Members = declarationMembers
+
+	// NOTE: the members of an enum's constructor are the enum cases,
+	// which are already declared by `compositeConstructorType`
+
+	if compositeType.Kind != common.CompositeKindEnum {
+		constructorType.Members = declarationMembers
+	}
 
 	// If the composite is a contract, declare a value – the contract is a singleton.
 	// For all other kinds, declare constructor.
@@ -645,45 +740,125 @@ func (checker *Checker) declareCompositeMembersAndValue(
 //
 func (checker *Checker) checkMemberStorability(members map[string]*Member) {
 
-	storableResults := map[*Member]bool{}
-
 	for _, member := range members {
 
+		// Report reference fields with a dedicated error,
+		// as references are transient and can never be stored
+
+		if member.DeclarationKind == common.DeclarationKindField &&
+			!member.Predeclared &&
+			isReferenceFieldType(member.TypeAnnotation.Type) {
+
+			checker.report(
+				&ReferenceFieldError{
+					Name: member.Identifier.Identifier,
+					Type: member.TypeAnnotation.Type,
+					Pos:  member.Identifier.Pos,
+				},
+			)
+
+			continue
+		}
+
+		// NOTE: use new results for each member:
+		// The result for a member which was determined while another member
+		// was still being checked may be based on the temporary assumption
+		// that the other member is storable, so it must not be reused
+
+		storableResults := map[*Member]bool{}
+
 		if member.IsStorable(storableResults) {
 			continue
 		}
 
+		fieldType := member.TypeAnnotation.Type
+
+		var nonStorableType Type
+		nonStorablePath := NonStorablePath(fieldType)
+		if len(nonStorablePath) > 0 {
+			nonStorableType = nonStorablePath[len(nonStorablePath)-1]
+		}
+
 		checker.report(
 			&FieldTypeNotStorableError{
-				Name: member.Identifier.Identifier,
-				Type: member.TypeAnnotation.Type,
-				Pos:  member.Identifier.Pos,
+				Name:            member.Identifier.Identifier,
+				Type:            fieldType,
+				NonStorableType: nonStorableType,
+				NonStorablePath: nonStorablePath,
+				Pos:             member.Identifier.Pos,
 			},
 		)
 	}
 }
 
+// isReferenceFieldType returns true if the given field type is a reference type,
+// or an optional reference type
+//
+func isReferenceFieldType(ty Type) bool {
+	for {
+		switch innerType := ty.(type) {
+		case *OptionalType:
+			ty = innerType.Type
+		case *ReferenceType:
+			return true
+		default:
+			return false
+		}
+	}
+}
+
 func (checker *Checker) initializerParameters(initializers []*ast.SpecialFunctionDeclaration) []*Parameter {
-	// TODO: support multiple overloaded initializers
-	var parameters []*Parameter
+	parameterLists := checker.initializerParameterLists(initializers, false)
+	if len(parameterLists) == 0 {
+		return nil
+	}
+	return parameterLists[0]
+}
 
-	initializerCount := len(initializers)
-	if initializerCount > 0 {
-		firstInitializer := initializers[0]
-		parameters = checker.parameters(firstInitializer.FunctionDeclaration.ParameterList)
+// initializerParameterLists returns the parameters of the given initializers.
+//
+// If overloading is not allowed, only the parameters of the first initializer are returned,
+// and a further initializer is reported as unsupported.
+//
+// Overloaded initializers are resolved by the argument labels of an invocation,
+// so initializers with the same argument labels are reported as ambiguous.
+//
+func (checker *Checker) initializerParameterLists(
+	initializers []*ast.SpecialFunctionDeclaration,
+	allowOverloading bool,
+) [][]*Parameter {
 
-		if initializerCount > 1 {
-			secondInitializer := initializers[1]
+	var parameterLists [][]*Parameter
 
+	for i, initializer := range initializers {
+		if i > 0 && !allowOverloading {
 			checker.report(
 				&UnsupportedOverloadingError{
 					DeclarationKind: common.DeclarationKindInitializer,
-					Range:           ast.NewRangeFromPositioned(secondInitializer),
+					Range:           ast.NewRangeFromPositioned(initializer),
 				},
 			)
+			break
+		}
+
+		parameters := checker.parameters(initializer.FunctionDeclaration.ParameterList)
+
+		for _, previousParameters := range parameterLists {
+			if haveSameArgumentLabels(parameters, previousParameters) {
+				checker.report(
+					&AmbiguousOverloadingError{
+						DeclarationKind: common.DeclarationKindInitializer,
+						Range:           ast.NewRangeFromPositioned(initializer),
+					},
+				)
+				break
+			}
 		}
+
+		parameterLists = append(parameterLists, parameters)
 	}
-	return parameters
+
+	return parameterLists
 }
 
 func (checker *Checker) explicitInterfaceConformances(
@@ -759,10 +934,23 @@ func (checker *Checker) checkCompositeConformance(
 
 	// Check initializer requirement
 
-	// TODO: add support for overloaded initializers
+	// NOTE: The conditions of the initializer requirement
+	// refer to the parameters of the requirement, so they cannot be applied
+	// to overloaded initializers, which must differ in their argument labels.
+	// Reject overloaded initializers, so that the requirement is always enforced
 
 	if interfaceType.InitializerParameters != nil {
 
+		initializers := compositeDeclaration.Members.Initializers()
+		if len(compositeType.ConstructorParameterLists) > 1 && len(initializers) > 1 {
+			checker.report(
+				&OverloadedInitializerConformanceError{
+					InterfaceType: interfaceType,
+					Range:         ast.NewRangeFromPositioned(initializers[1]),
+				},
+			)
+		}
+
 		initializerType := &FunctionType{
 			Parameters:           compositeType.ConstructorParameters,
 			ReturnTypeAnnotation: NewTypeAnnotation(&VoidType{}),
@@ -800,11 +988,12 @@ func (checker *Checker) checkCompositeConformance(
 			continue
 		}
 
-		if !checker.memberSatisfied(compositeMember, interfaceMember) {
+		if satisfied, reason := checker.memberSatisfied(compositeMember, interfaceMember); !satisfied {
 			memberMismatches = append(memberMismatches,
 				MemberMismatch{
 					CompositeMember: compositeMember,
 					InterfaceMember: interfaceMember,
+					Reason:          reason,
 				},
 			)
 		}
@@ -850,13 +1039,88 @@ func (checker *Checker) checkCompositeConformance(
 	}
 }
 
-// TODO: return proper error
-func (checker *Checker) memberSatisfied(compositeMember, interfaceMember *Member) bool {
+// checkConflictingTypeRequirements checks that the explicit conformances of the given composite type
+// do not declare type requirements with the same identifier, but different kinds,
+// as the composite type could never declare a nested type that satisfies all of them
+//
+func (checker *Checker) checkConflictingTypeRequirements(
+	compositeType *CompositeType,
+	identifier ast.Identifier,
+) {
+	type typeRequirementOrigin struct {
+		interfaceType   *InterfaceType
+		typeRequirement *CompositeType
+	}
+
+	origins := map[string]typeRequirementOrigin{}
+	reported := map[string]bool{}
+
+	for _, conformance := range compositeType.ExplicitInterfaceConformances {
+
+		// NOTE: iterate over the type requirements in a deterministic order,
+		// so errors are reported deterministically
+
+		nestedTypes := conformance.NestedTypes()
+
+		names := make([]string, 0, len(nestedTypes))
+		for name := range nestedTypes {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+
+		for _, name := range names {
+
+			// Only nested composite declarations are type requirements of the interface
+
+			typeRequirement, ok := nestedTypes[name].(*CompositeType)
+			if !ok {
+				continue
+			}
+
+			origin, ok := origins[name]
+			if !ok {
+				origins[name] = typeRequirementOrigin{
+					interfaceType:   conformance,
+					typeRequirement: typeRequirement,
+				}
+				continue
+			}
+
+			if origin.typeRequirement.Kind == typeRequirement.Kind || reported[name] {
+				continue
+			}
+
+			reported[name] = true
+
+			checker.report(
+				&ConflictingTypeRequirementError{
+					CompositeType:        compositeType,
+					Name:                 name,
+					InterfaceType:        origin.interfaceType,
+					TypeRequirement:      origin.typeRequirement,
+					OtherInterfaceType:   conformance,
+					OtherTypeRequirement: typeRequirement,
+					Range:                ast.NewRangeFromPositioned(identifier),
+				},
+			)
+		}
+	}
+}
+
+// memberSatisfied returns true if the given composite member satisfies the given interface member.
+// If it does not, the reason for the mismatch is returned
+//
+func (checker *Checker) memberSatisfied(
+	compositeMember, interfaceMember *Member,
+) (
+	satisfied bool,
+	reason MemberMismatchReason,
+) {
 
 	// Check declaration kind
 
 	if compositeMember.DeclarationKind != interfaceMember.DeclarationKind {
-		return false
+		return false, MemberMismatchReasonDeclarationKind
 	}
 
 	// Check type
@@ -869,11 +1133,20 @@ func (checker *Checker) memberSatisfied(compositeMember, interfaceMember *Member
 
 		switch interfaceMember.DeclarationKind {
 		case common.DeclarationKindField:
-			// If the member is just a field, check the types are equal
+			// If the member is just a field, check the types are compatible.
+			//
+			// Constant fields can only be read, so they are covariant,
+			// i.e. the type of the composite's field must be a subtype
+			// of the type of the interface's field.
+			//
+			// Variable fields can also be set, so they are invariant
 
-			// TODO: subtype?
-			if !compositeMemberType.Equal(interfaceMemberType) {
-				return false
+			if compositeMember.VariableKind == ast.VariableKindConstant {
+				if !IsSubType(compositeMemberType, interfaceMemberType) {
+					return false, MemberMismatchReasonFieldType
+				}
+			} else if !compositeMemberType.Equal(interfaceMemberType) {
+				return false, MemberMismatchReasonFieldType
 			}
 
 		case common.DeclarationKindFunction:
@@ -889,7 +1162,7 @@ func (checker *Checker) memberSatisfied(compositeMember, interfaceMember *Member
 			compositeMemberFunctionType := compositeMemberType.(*FunctionType)
 
 			if !interfaceMemberFunctionType.HasSameArgumentLabels(compositeMemberFunctionType) {
-				return false
+				return false, MemberMismatchReasonArgumentLabels
 			}
 
 			// Functions are invariant in their parameter types
@@ -899,7 +1172,7 @@ func (checker *Checker) memberSatisfied(compositeMember, interfaceMember *Member
 				if !subParameter.TypeAnnotation.Type.
 					Equal(superParameter.TypeAnnotation.Type) {
 
-					return false
+					return false, MemberMismatchReasonParameterTypes
 				}
 			}
 
@@ -912,7 +1185,7 @@ func (checker *Checker) memberSatisfied(compositeMember, interfaceMember *Member
 					compositeMemberFunctionType.ReturnTypeAnnotation.Type,
 					interfaceMemberFunctionType.ReturnTypeAnnotation.Type,
 				) {
-					return false
+					return false, MemberMismatchReasonReturnType
 				}
 			}
 
@@ -921,7 +1194,7 @@ func (checker *Checker) memberSatisfied(compositeMember, interfaceMember *Member
 				(compositeMemberFunctionType.ReturnTypeAnnotation == nil &&
 					interfaceMemberFunctionType.ReturnTypeAnnotation != nil) {
 
-				return false
+				return false, MemberMismatchReasonReturnType
 			}
 		}
 	}
@@ -931,7 +1204,7 @@ func (checker *Checker) memberSatisfied(compositeMember, interfaceMember *Member
 	if interfaceMember.VariableKind != ast.VariableKindNotSpecified &&
 		compositeMember.VariableKind != interfaceMember.VariableKind {
 
-		return false
+		return false, MemberMismatchReasonVariableKind
 	}
 
 	// Check access
@@ -939,7 +1212,11 @@ func (checker *Checker) memberSatisfied(compositeMember, interfaceMember *Member
 	effectiveInterfaceMemberAccess := checker.effectiveInterfaceMemberAccess(interfaceMember.Access)
 	effectiveCompositeMemberAccess := checker.effectiveCompositeMemberAccess(compositeMember.Access)
 
-	return !effectiveCompositeMemberAccess.IsLessPermissiveThan(effectiveInterfaceMemberAccess)
+	if effectiveCompositeMemberAccess.IsLessPermissiveThan(effectiveInterfaceMemberAccess) {
+		return false, MemberMismatchReasonAccess
+	}
+
+	return true, MemberMismatchReasonUnknown
 }
 
 // checkTypeRequirement checks conformance of a nested type declaration
@@ -1048,6 +1325,50 @@ func (checker *Checker) checkTypeRequirement(
 	)
 }
 
+// typeRequirementDeclarationSuggestion returns a skeleton declaration
+// which satisfies the given type requirement, i.e. which has the same name and kind,
+// and declares the conformances required by checkTypeRequirement.
+// For example, `pub resource Vault: Provider, Receiver {}`.
+//
+// Events have no body, but must have the same parameters as the event type requirement.
+// For example, `pub event Deposit(amount: UFix64)`
+//
+func typeRequirementDeclarationSuggestion(requiredCompositeType *CompositeType) string {
+	var builder strings.Builder
+
+	builder.WriteString(ast.AccessPublic.Keyword())
+	builder.WriteRune(' ')
+	builder.WriteString(requiredCompositeType.Kind.Keyword())
+	builder.WriteRune(' ')
+	builder.WriteString(requiredCompositeType.Identifier)
+
+	if requiredCompositeType.Kind == common.CompositeKindEvent {
+		builder.WriteRune('(')
+		for i, parameter := range requiredCompositeType.ConstructorParameters {
+			if i > 0 {
+				builder.WriteString(", ")
+			}
+			builder.WriteString(parameter.QualifiedString())
+		}
+		builder.WriteRune(')')
+
+		return builder.String()
+	}
+
+	for i, conformance := range requiredCompositeType.ExplicitInterfaceConformances {
+		if i == 0 {
+			builder.WriteString(": ")
+		} else {
+			builder.WriteString(", ")
+		}
+		builder.WriteString(conformance.QualifiedString())
+	}
+
+	builder.WriteString(" {}")
+
+	return builder.String()
+}
+
 func (checker *Checker) compositeConstructorType(
 	compositeDeclaration *ast.CompositeDeclaration,
 	compositeType *CompositeType,
@@ -1062,34 +1383,80 @@ func (checker *Checker) compositeConstructorType(
 		},
 	}
 
-	// TODO: support multiple overloaded initializers
+	// The constructor of an enum looks up the case with the given raw value,
+	// and returns nil if there is no such case.
+	// The enum cases are members of the constructor, e.g. `E.a`,
+	// and so is the array of all cases, `E.values`
 
-	initializers := compositeDeclaration.Members.Initializers()
-	if len(initializers) > 0 {
-		firstInitializer := initializers[0]
+	if compositeType.Kind == common.CompositeKindEnum {
+		members := checker.enumCaseMembers(compositeDeclaration, compositeType)
+		members[EnumValuesFieldName] = enumValuesMember(compositeType)
 
-		argumentLabels = firstInitializer.
-			FunctionDeclaration.
-			ParameterList.
-			EffectiveArgumentLabels()
+		constructorFunctionType.Members = members
+		constructorFunctionType.Parameters = []*Parameter{
+			{
+				Identifier:     EnumRawValueFieldName,
+				TypeAnnotation: NewTypeAnnotation(compositeType.EnumRawType),
+			},
+		}
+		constructorFunctionType.ReturnTypeAnnotation = NewTypeAnnotation(
+			&OptionalType{
+				Type: compositeType,
+			},
+		)
+
+		return constructorFunctionType, []string{EnumRawValueFieldName}
+	}
 
-		constructorFunctionType.Parameters = compositeType.ConstructorParameters
+	initializers := compositeDeclaration.Members.Initializers()
+	parameterLists := compositeType.ConstructorParameterLists
+
+	for i, parameters := range parameterLists {
+		initializer := initializers[i]
 
 		// NOTE: Don't use `constructorFunctionType`, as it has a return type.
 		//   The initializer itself has a `Void` return type.
 
-		checker.Elaboration.SpecialFunctionTypes[firstInitializer] =
+		checker.Elaboration.SpecialFunctionTypes[initializer] =
 			&SpecialFunctionType{
 				FunctionType: &FunctionType{
-					Parameters:           constructorFunctionType.Parameters,
+					Parameters:           parameters,
 					ReturnTypeAnnotation: NewTypeAnnotation(&VoidType{}),
 				},
 			}
 	}
 
+	constructorFunctionType.Parameters = compositeType.ConstructorParameters
+
+	if len(parameterLists) == 1 {
+		argumentLabels = initializers[0].
+			FunctionDeclaration.
+			ParameterList.
+			EffectiveArgumentLabels()
+	} else if len(parameterLists) > 1 {
+		// The constructor is overloaded.
+		// The argument labels of an invocation are checked
+		// when the overload is resolved, so none are returned
+
+		overloads := make([]*FunctionType, len(parameterLists))
+		for i, parameters := range parameterLists {
+			overloads[i] = &FunctionType{
+				Parameters:           parameters,
+				ReturnTypeAnnotation: NewTypeAnnotation(compositeType),
+			}
+		}
+		constructorFunctionType.Overloads = overloads
+	}
+
 	return constructorFunctionType, argumentLabels
 }
 
+// nonEventMembersAndOrigins declares the members of a composite, interface, or transaction.
+//
+// The returned field names are in a stable order, independent of the map iteration order
+// of the members: the predeclared fields come first, in the order of `predeclaredMembers`,
+// followed by the declared fields in source order
+//
 func (checker *Checker) nonEventMembersAndOrigins(
 	containerType Type,
 	fields []*ast.FieldDeclaration,
@@ -1293,7 +1660,7 @@ func (checker *Checker) checkInitializers(
 	fields []*ast.FieldDeclaration,
 	containerType Type,
 	containerDeclarationKind common.DeclarationKind,
-	initializerParameters []*Parameter,
+	initializerParameterLists [][]*Parameter,
 	containerKind ContainerKind,
 	initializationInfo *InitializationInfo,
 ) {
@@ -1304,29 +1671,41 @@ func (checker *Checker) checkInitializers(
 		return
 	}
 
-	// TODO: check all initializers:
-	//  parameter initializerParameterTypeAnnotations needs to be a slice
+	// NOTE: unsupported overloaded initializers were already reported
+	// when the parameter lists were determined, and are not checked
 
-	initializer := initializers[0]
-	checker.checkSpecialFunction(
-		initializer,
-		containerType,
-		containerDeclarationKind,
-		initializerParameters,
-		containerKind,
-		initializationInfo,
-	)
+	for i, initializerParameters := range initializerParameterLists {
+		initializer := initializers[i]
 
-	// If the initializer is for an event,
-	// ensure all parameters are valid
+		// Each initializer must initialize all fields on its own
 
-	if compositeType, ok := containerType.(*CompositeType); ok &&
-		compositeType.Kind == common.CompositeKindEvent {
+		if i > 0 && initializationInfo != nil {
+			initializationInfo = NewInitializationInfo(
+				initializationInfo.ContainerType,
+				initializationInfo.FieldMembers,
+			)
+		}
 
-		checker.checkEventParameters(
-			initializer.FunctionDeclaration.ParameterList,
+		checker.checkSpecialFunction(
+			initializer,
+			containerType,
+			containerDeclarationKind,
 			initializerParameters,
+			containerKind,
+			initializationInfo,
 		)
+
+		// If the initializer is for an event,
+		// ensure all parameters are valid
+
+		if compositeType, ok := containerType.(*CompositeType); ok &&
+			compositeType.Kind == common.CompositeKindEvent {
+
+			checker.checkEventParameters(
+				initializer.FunctionDeclaration.ParameterList,
+				initializerParameters,
+			)
+		}
 	}
 }
 
@@ -1513,6 +1892,14 @@ func (checker *Checker) checkNestedIdentifiers(members *ast.Members) {
 			positions,
 		)
 	}
+
+	for _, enumCase := range members.EnumCases() {
+		checker.checkNestedIdentifier(
+			enumCase.Identifier,
+			common.DeclarationKindEnumCase,
+			positions,
+		)
+	}
 }
 
 // checkNestedIdentifier checks that the nested identifier is unique
@@ -1560,6 +1947,220 @@ func (checker *Checker) VisitFieldDeclaration(_ *ast.FieldDeclaration) ast.Repr
 	panic(errors.NewUnreachableError())
 }
 
+func (checker *Checker) VisitEnumCaseDeclaration(_ *ast.EnumCaseDeclaration) ast.Repr {
+	// NOTE: enum cases are declared as members of the enum's constructor in `enumCaseMembers`
+
+	panic(errors.NewUnreachableError())
+}
+
+// enumRawType determines the raw type of the given enum declaration,
+// which is declared as the single conformance of the enum, and must be an integer type.
+//
+// If the raw type is missing or invalid, the invalid type is returned
+//
+func (checker *Checker) enumRawType(declaration *ast.CompositeDeclaration) Type {
+
+	conformances := declaration.Conformances
+
+	if len(conformances) == 0 {
+		checker.report(
+			&MissingEnumRawTypeError{
+				Pos: declaration.Identifier.EndPosition().Shifted(1),
+			},
+		)
+
+		return &InvalidType{}
+	}
+
+	if len(conformances) > 1 {
+		checker.report(
+			&InvalidEnumConformancesError{
+				Range: ast.Range{
+					StartPos: conformances[1].StartPosition(),
+					EndPos:   conformances[len(conformances)-1].EndPosition(),
+				},
+			},
+		)
+	}
+
+	rawTypeConformance := conformances[0]
+	rawType := checker.ConvertType(rawTypeConformance)
+
+	if rawType.IsInvalidType() {
+		return rawType
+	}
+
+	if !isValidEnumRawType(rawType) {
+		checker.report(
+			&InvalidEnumRawTypeError{
+				Type:  rawType,
+				Range: ast.NewRangeFromPositioned(rawTypeConformance),
+			},
+		)
+
+		return &InvalidType{}
+	}
+
+	return rawType
+}
+
+// isValidEnumRawType returns true if the given type is a concrete integer type,
+// i.e. a subtype of `Integer`, but not one of the abstract integer types
+//
+func isValidEnumRawType(ty Type) bool {
+	switch ty.(type) {
+	case *NeverType, *IntegerType, *SignedIntegerType:
+		return false
+
+	default:
+		return IsSubType(ty, &IntegerType{})
+	}
+}
+
+// enumCaseMembers returns the members for the cases of the given enum declaration,
+// which are the constant members of the enum's constructor
+//
+func (checker *Checker) enumCaseMembers(
+	declaration *ast.CompositeDeclaration,
+	compositeType *CompositeType,
+) map[string]*Member {
+
+	enumCases := declaration.Members.EnumCases()
+	members := make(map[string]*Member, len(enumCases))
+
+	for _, enumCase := range enumCases {
+		identifier := enumCase.Identifier
+
+		if _, ok := members[identifier.Identifier]; ok {
+			// NOTE: redeclarations are reported in `checkNestedIdentifiers`
+			continue
+		}
+
+		members[identifier.Identifier] = &Member{
+			Identifier:            identifier,
+			Access:                enumCase.Access,
+			ContainerType:         compositeType,
+			TypeAnnotation:        NewTypeAnnotation(compositeType),
+			DeclarationKind:       common.DeclarationKindEnumCase,
+			VariableKind:          ast.VariableKindConstant,
+			IgnoreInSerialization: true,
+			DocString:             enumCase.DocString,
+		}
+	}
+
+	return members
+}
+
+// enumValuesMember returns the predeclared member `values` of an enum's constructor,
+// i.e. `pub let values: [E]`, the array of all cases of the enum
+//
+func enumValuesMember(compositeType *CompositeType) *Member {
+	return &Member{
+		ContainerType:   compositeType,
+		Access:          ast.AccessPublic,
+		Identifier:      ast.Identifier{Identifier: EnumValuesFieldName},
+		DeclarationKind: common.DeclarationKindField,
+		VariableKind:    ast.VariableKindConstant,
+		TypeAnnotation: NewTypeAnnotation(
+			&VariableSizedType{
+				Type: compositeType,
+			},
+		),
+		Predeclared:           true,
+		IgnoreInSerialization: true,
+		DocString:             enumValuesFieldDocString,
+	}
+}
+
+// checkEnumMembers checks that the members of an enum declaration are only enum cases,
+// that no case is named like the predeclared member `values`,
+// and that the raw value of each case, its index, is in the range of the raw type.
+//
+// NOTE: Nested declarations are already reported in `declareNestedDeclarations`
+//
+func (checker *Checker) checkEnumMembers(members *ast.Members, rawType Type) {
+
+	reportInvalidNonEnumCase := func(declarationKind common.DeclarationKind, identifier ast.Identifier) {
+		checker.report(
+			&InvalidNonEnumCaseError{
+				DeclarationKind: declarationKind,
+				Range:           ast.NewRangeFromPositioned(identifier),
+			},
+		)
+	}
+
+	for _, field := range members.Fields() {
+		reportInvalidNonEnumCase(field.DeclarationKind(), field.Identifier)
+	}
+
+	for _, function := range members.Functions() {
+		reportInvalidNonEnumCase(function.DeclarationKind(), function.Identifier)
+	}
+
+	for _, specialFunction := range members.SpecialFunctions() {
+		reportInvalidNonEnumCase(
+			specialFunction.DeclarationKind(),
+			specialFunction.FunctionDeclaration.Identifier,
+		)
+	}
+
+	var maxRawValue *big.Int
+	if rangedType, ok := rawType.(IntegerRangedType); ok {
+		maxRawValue = rangedType.MaxInt()
+	}
+
+	for i, enumCase := range members.EnumCases() {
+		checker.checkDeclarationAccessModifier(
+			enumCase.Access,
+			enumCase.DeclarationKind(),
+			enumCase.StartPos,
+			true,
+		)
+
+		// The raw value of the case is its index
+
+		if maxRawValue != nil &&
+			big.NewInt(int64(i)).Cmp(maxRawValue) > 0 {
+
+			checker.report(
+				&InvalidEnumCaseCountError{
+					RawType: rawType,
+					Range:   ast.NewRangeFromPositioned(enumCase.Identifier),
+				},
+			)
+
+			// Only report the first case which exceeds the range
+			maxRawValue = nil
+		}
+
+		if enumCase.Identifier.Identifier == EnumValuesFieldName {
+			checker.report(
+				&InvalidDeclarationError{
+					Identifier: enumCase.Identifier.Identifier,
+					Kind:       enumCase.DeclarationKind(),
+					Range:      ast.NewRangeFromPositioned(enumCase.Identifier),
+				},
+			)
+		}
+	}
+}
+
+// checkNoEnumCases checks that the members of a non-enum declaration contain no enum cases
+//
+func (checker *Checker) checkNoEnumCases(
+	members *ast.Members,
+	containerDeclarationKind common.DeclarationKind,
+) {
+	for _, enumCase := range members.EnumCases() {
+		checker.report(
+			&InvalidEnumCaseError{
+				ContainerDeclarationKind: containerDeclarationKind,
+				Range:                    ast.NewRangeFromPositioned(enumCase.Identifier),
+			},
+		)
+	}
+}
+
 // checkUnknownSpecialFunctions checks that the special function declarations
 // are supported, i.e., they are either initializers or destructors
 //
@@ -1621,13 +2222,13 @@ func (checker *Checker) checkDestructors(
 
 	// destructor overloading is not supported
 
-	if count > 1 {
-		secondDestructor := destructors[1]
+	previousPos := firstDestructor.FunctionDeclaration.Identifier.Pos
 
+	for _, destructor := range destructors[1:] {
 		checker.report(
-			&UnsupportedOverloadingError{
-				DeclarationKind: common.DeclarationKindDestructor,
-				Range:           ast.NewRangeFromPositioned(secondDestructor),
+			&MultipleDestructorsError{
+				PreviousPos: previousPos,
+				Range:       ast.NewRangeFromPositioned(destructor.FunctionDeclaration.Identifier),
 			},
 		)
 	}
@@ -1705,14 +2306,37 @@ func (checker *Checker) checkCompositeResourceInvalidated(containerType Type) {
 		return
 	}
 
-	checker.checkResourceFieldsInvalidated(containerType, compositeType.Members)
+	checker.checkResourceFieldsInvalidated(
+		containerType,
+		compositeType.Members,
+		compositeType.Fields,
+	)
 }
 
 // checkResourceFieldsInvalidated checks that all resource fields for a container
 // type are invalidated.
 //
-func (checker *Checker) checkResourceFieldsInvalidated(containerType Type, members map[string]*Member) {
-	for _, member := range members {
+// An error is reported for each resource field that is not invalidated,
+// in the order the fields are declared
+//
+func (checker *Checker) checkResourceFieldsInvalidated(
+	containerType Type,
+	members map[string]*Member,
+	fields []string,
+) {
+	checked := make(map[string]bool, len(fields))
+
+	for _, field := range fields {
+
+		// NOTE: a field might be declared multiple times (invalid redeclaration),
+		// only check it once
+
+		if checked[field] {
+			continue
+		}
+		checked[field] = true
+
+		member := members[field]
 
 		// NOTE: check the of the type annotation, not the type annotation's
 		// resource marker: the field could have an incorrect type annotation
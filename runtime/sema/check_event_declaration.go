@@ -24,7 +24,9 @@ import (
 )
 
 // checkEventParameters checks that the event initializer's parameters are valid,
-// as determined by `isValidEventParameterType`.
+// as determined by `IsValidEventParameterType`.
+//
+// All invalid parameters are reported, each with the range of the parameter.
 //
 func (checker *Checker) checkEventParameters(
 	parameterList *ast.ParameterList,
@@ -34,58 +36,96 @@ func (checker *Checker) checkEventParameters(
 	for i, parameter := range parameterList.Parameters {
 		parameterType := parameters[i].TypeAnnotation.Type
 
-		if !parameterType.IsInvalidType() &&
-			!IsValidEventParameterType(parameterType) {
+		if parameterType.IsInvalidType() {
+			continue
+		}
 
-			checker.report(
-				&InvalidEventParameterTypeError{
-					Type: parameterType,
-					Range: ast.Range{
-						StartPos: parameter.StartPos,
-						EndPos:   parameter.TypeAnnotation.EndPosition(),
-					},
-				},
-			)
+		unsupportedType := unsupportedEventParameterType(
+			parameterType,
+			map[*CompositeType]struct{}{},
+		)
+		if unsupportedType == nil {
+			continue
 		}
+
+		checker.report(
+			&InvalidEventParameterTypeError{
+				Type:            parameterType,
+				UnsupportedType: unsupportedType,
+				Range: ast.Range{
+					StartPos: parameter.StartPos,
+					EndPos:   parameter.TypeAnnotation.EndPosition(),
+				},
+			},
+		)
 	}
 }
 
-// isValidEventParameterType returns true if the given type is a valid event parameter type.
+// IsValidEventParameterType returns true if the given type is a valid event parameter type.
 //
-// Events currently only support a few simple Cadence types.
+// Events currently only support a few simple Cadence types:
+// Numbers, booleans, strings, characters, addresses,
+// optionals, arrays, and dictionaries of them,
+// and structures which only have fields of them.
 //
 func IsValidEventParameterType(t Type) bool {
+	return unsupportedEventParameterType(t, map[*CompositeType]struct{}{}) == nil
+}
+
+// unsupportedEventParameterType returns the type which makes the given type
+// an invalid event parameter type, which is the given type itself or a nested type,
+// or nil if the given type is a valid event parameter type.
+//
+// Composite types which are already being checked are assumed to be valid,
+// so that recursive structures are supported.
+//
+func unsupportedEventParameterType(t Type, checkedCompositeTypes map[*CompositeType]struct{}) Type {
 	switch t := t.(type) {
 	case *BoolType, *StringType, *CharacterType, *AddressType:
-		return true
+		return nil
 
 	case *OptionalType:
-		return IsValidEventParameterType(t.Type)
+		return unsupportedEventParameterType(t.Type, checkedCompositeTypes)
 
 	case *VariableSizedType:
-		return IsValidEventParameterType(t.ElementType(false))
+		return unsupportedEventParameterType(t.ElementType(false), checkedCompositeTypes)
 
 	case *ConstantSizedType:
-		return IsValidEventParameterType(t.ElementType(false))
+		return unsupportedEventParameterType(t.ElementType(false), checkedCompositeTypes)
 
 	case *DictionaryType:
-		return IsValidEventParameterType(t.KeyType) &&
-			IsValidEventParameterType(t.ValueType)
+		unsupportedType := unsupportedEventParameterType(t.KeyType, checkedCompositeTypes)
+		if unsupportedType != nil {
+			return unsupportedType
+		}
+		return unsupportedEventParameterType(t.ValueType, checkedCompositeTypes)
 
 	case *CompositeType:
 		if t.Kind != common.CompositeKindStructure {
-			return false
+			return t
+		}
+
+		if _, ok := checkedCompositeTypes[t]; ok {
+			return nil
 		}
-		for _, member := range t.Members {
-			if member.DeclarationKind == common.DeclarationKindField {
-				if !IsValidEventParameterType(member.TypeAnnotation.Type) {
-					return false
-				}
+		checkedCompositeTypes[t] = struct{}{}
+
+		for _, fieldName := range t.Fields {
+			member := t.Members[fieldName]
+			unsupportedType := unsupportedEventParameterType(
+				member.TypeAnnotation.Type,
+				checkedCompositeTypes,
+			)
+			if unsupportedType != nil {
+				return unsupportedType
 			}
 		}
-		return true
+		return nil
 
 	default:
-		return IsSubType(t, &NumberType{})
+		if IsSubType(t, &NumberType{}) {
+			return nil
+		}
+		return t
 	}
 }
@@ -61,13 +61,8 @@ func (checker *Checker) checkSelfVariableUseInInitializer(variable *Variable, po
 	// Is this use of `self` in an initializer?
 
 	initializationInfo := checker.functionActivations.Current().InitializationInfo
-	if initializationInfo == nil {
-		return
-	}
-
-	// The use of `self` is inside the initializer
 
-	checkInitializationComplete := func() {
+	checkInitializationComplete := func(initializationInfo *InitializationInfo) {
 		if initializationInfo.InitializationComplete() {
 			return
 		}
@@ -80,6 +75,24 @@ func (checker *Checker) checkSelfVariableUseInInitializer(variable *Variable, po
 		)
 	}
 
+	if initializationInfo == nil {
+
+		// Is this use of `self` in a function nested inside an initializer?
+		//
+		// The nested function might be called at any point,
+		// so the initialization state of individual fields is unknown.
+		// Ensure that *all* fields were initialized
+
+		enclosingInitializationInfo := checker.functionActivations.EnclosingInitializationInfo()
+		if enclosingInitializationInfo != nil {
+			checkInitializationComplete(enclosingInitializationInfo)
+		}
+
+		return
+	}
+
+	// The use of `self` is inside the initializer
+
 	if checker.currentMemberExpression != nil {
 
 		// The use of `self` is inside a member access
@@ -99,7 +112,7 @@ func (checker *Checker) checkSelfVariableUseInInitializer(variable *Variable, po
 
 			field := initializationInfo.FieldMembers[accessedSelfMember]
 			if field == nil {
-				checkInitializationComplete()
+				checkInitializationComplete(initializationInfo)
 			}
 		}
 
@@ -108,7 +121,7 @@ func (checker *Checker) checkSelfVariableUseInInitializer(variable *Variable, po
 		// as a standalone expression, e.g. to pass it as an argument to a function.
 		// Ensure that *all* fields were initialized
 
-		checkInitializationComplete()
+		checkInitializationComplete(initializationInfo)
 	}
 }
 
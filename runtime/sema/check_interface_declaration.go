@@ -57,6 +57,8 @@ func (checker *Checker) VisitInterfaceDeclaration(declaration *ast.InterfaceDecl
 
 	checker.checkNestedIdentifiers(declaration.Members)
 
+	checker.checkNoEnumCases(declaration.Members, declaration.DeclarationKind())
+
 	// Activate new scope for nested types
 
 	checker.typeActivations.Enter()
@@ -71,7 +73,7 @@ func (checker *Checker) VisitInterfaceDeclaration(declaration *ast.InterfaceDecl
 		declaration.Members.Fields(),
 		interfaceType,
 		declaration.DeclarationKind(),
-		interfaceType.InitializerParameters,
+		[][]*Parameter{interfaceType.InitializerParameters},
 		kind,
 		nil,
 	)
@@ -61,8 +61,8 @@ func (checker *Checker) checkInvocationExpression(invocationExpression *ast.Invo
 	expressionType := invokedExpression.Accept(checker).(Type)
 
 	isOptionalChainingResult := false
+	var member *Member
 	if memberExpression, ok := invokedExpression.(*ast.MemberExpression); ok {
-		var member *Member
 		_, member, isOptionalChainingResult = checker.visitMember(memberExpression)
 		if member != nil {
 			expressionType = member.TypeAnnotation.Type
@@ -82,6 +82,18 @@ func (checker *Checker) checkInvocationExpression(invocationExpression *ast.Invo
 		return &InvalidType{}
 	}
 
+	// If the invoked function is overloaded, e.g. it is the constructor
+	// of a composite which declares multiple initializers,
+	// resolve the overload using the argument labels of the invocation
+
+	isOverloaded := false
+	if specialFunctionType, ok := invokableType.(*SpecialFunctionType); ok &&
+		len(specialFunctionType.Overloads) > 0 {
+
+		isOverloaded = true
+		invokableType = checker.resolveOverload(invocationExpression, specialFunctionType)
+	}
+
 	// The invoked expression has a function type,
 	// check the invocation including all arguments.
 	//
@@ -109,6 +121,8 @@ func (checker *Checker) checkInvocationExpression(invocationExpression *ast.Invo
 
 	checker.Elaboration.InvocationExpressionArgumentTypes[invocationExpression] = argumentTypes
 
+	checker.recordPathUsage(invocationExpression, member)
+
 	// If the invocation refers directly to the name of the function as stated in the declaration,
 	// or the invocation refers to a function of a composite (member),
 	// check that the correct argument labels are supplied in the invocation
@@ -127,6 +141,16 @@ func (checker *Checker) checkInvocationExpression(invocationExpression *ast.Invo
 		)
 	}
 
+	// If the invoked function is overloaded,
+	// check that the argument labels of the resolved overload are supplied
+
+	if isOverloaded {
+		checker.checkInvocationArgumentLabels(
+			invocationExpression.Arguments,
+			invokableType.InvocationFunctionType().ArgumentLabels(),
+		)
+	}
+
 	checker.checkConstructorInvocationWithResourceResult(
 		invocationExpression,
 		invokableType,
@@ -136,11 +160,16 @@ func (checker *Checker) checkInvocationExpression(invocationExpression *ast.Invo
 
 	checker.checkMemberInvocationResourceInvalidation(invokedExpression)
 
-	// Update the return info for invocations that do not return (i.e. have a `Never` return type)
+	// Update the return info for invocations that do not return (i.e. have a `Never` return type).
+	//
+	// Like a return, the invocation is a definite exit:
+	// Resource uses and invalidations in a halting branch
+	// do not occur in the outer scope
 
 	if _, ok = returnType.(*NeverType); ok {
 		functionActivation := checker.functionActivations.Current()
 		functionActivation.ReturnInfo.DefinitelyHalted = true
+		checker.resources.Returns = true
 	}
 
 	if isOptionalChainingResult {
@@ -149,6 +178,71 @@ func (checker *Checker) checkInvocationExpression(invocationExpression *ast.Invo
 	return returnType
 }
 
+// resolveOverload resolves the overload of the given overloaded special function type
+// which is invoked by the given invocation expression, and records it in the elaboration.
+//
+// The first overload whose argument labels match the arguments of the invocation is selected.
+// If no overload matches, the first overload with the same number of parameters is selected,
+// or the first overload if there is none, so that the mismatch is reported
+// when the invocation is checked.
+//
+func (checker *Checker) resolveOverload(
+	invocationExpression *ast.InvocationExpression,
+	specialFunctionType *SpecialFunctionType,
+) *SpecialFunctionType {
+
+	arguments := invocationExpression.Arguments
+
+	index := -1
+
+	for i, overload := range specialFunctionType.Overloads {
+		if argumentsMatchLabels(arguments, overload.ArgumentLabels()) {
+			index = i
+			break
+		}
+	}
+
+	if index < 0 {
+		index = 0
+
+		for i, overload := range specialFunctionType.Overloads {
+			if len(overload.Parameters) == len(arguments) {
+				index = i
+				break
+			}
+		}
+	}
+
+	checker.Elaboration.InvocationExpressionOverloadIndices[invocationExpression] = index
+
+	return &SpecialFunctionType{
+		FunctionType: specialFunctionType.Overloads[index],
+		Members:      specialFunctionType.Members,
+	}
+}
+
+// argumentsMatchLabels returns true if the given arguments are labeled
+// with exactly the given argument labels
+//
+func argumentsMatchLabels(arguments []*ast.Argument, argumentLabels []string) bool {
+	if len(arguments) != len(argumentLabels) {
+		return false
+	}
+
+	for i, argumentLabel := range argumentLabels {
+		providedLabel := arguments[i].Label
+		if argumentLabel == ArgumentLabelNotRequired {
+			if providedLabel != "" {
+				return false
+			}
+		} else if providedLabel != argumentLabel {
+			return false
+		}
+	}
+
+	return true
+}
+
 func (checker *Checker) checkMemberInvocationResourceInvalidation(invokedExpression ast.Expression) {
 	// If the invocation is on a resource, i.e., a member expression where the accessed expression
 	// is an identifier which refers to a resource, then the resource is temporarily "moved into"
@@ -423,6 +517,9 @@ func (checker *Checker) checkInvocation(
 	)
 
 	returnType = functionType.ReturnTypeAnnotation.Type.Resolve(typeArguments)
+	if returnType == nil {
+		returnType = resolveReturnTypeWithTypeBounds(functionType, typeArguments)
+	}
 	if returnType == nil {
 		// TODO: report error? does `checkTypeParameterInference` below already do that?
 		returnType = &InvalidType{}
@@ -445,6 +542,38 @@ func (checker *Checker) checkInvocation(
 	return argumentTypes, returnType
 }
 
+// resolveReturnTypeWithTypeBounds resolves the return type of the given generic function type
+// in case it could not be resolved because an optional type parameter was not bound:
+// Unbound optional type parameters default to their type bound, if any.
+//
+// The default type arguments are not bound, i.e. they are not recorded in the elaboration
+//
+func resolveReturnTypeWithTypeBounds(
+	functionType *FunctionType,
+	typeArguments map[*TypeParameter]Type,
+) Type {
+	defaultTypeArguments := make(map[*TypeParameter]Type, len(functionType.TypeParameters))
+
+	for _, typeParameter := range functionType.TypeParameters {
+		typeArgument := typeArguments[typeParameter]
+
+		if typeArgument == nil &&
+			typeParameter.Optional &&
+			typeParameter.TypeBound != nil {
+
+			typeArgument = typeParameter.TypeBound
+		}
+
+		if typeArgument == nil {
+			continue
+		}
+
+		defaultTypeArguments[typeParameter] = typeArgument
+	}
+
+	return functionType.ReturnTypeAnnotation.Type.Resolve(defaultTypeArguments)
+}
+
 // checkTypeParameterInference checks that all type parameters
 // of the given generic function type have been assigned a type.
 //
@@ -613,9 +742,10 @@ func (checker *Checker) checkInvocationArgumentParameterTypeCompatibility(
 
 		checker.report(
 			&TypeMismatchError{
-				ExpectedType: parameterType,
-				ActualType:   argumentType,
-				Range:        ast.NewRangeFromPositioned(argument),
+				ExpectedType:        parameterType,
+				ActualType:          argumentType,
+				MissingRestrictions: MissingRestrictions(argumentType, parameterType),
+				Range:               ast.NewRangeFromPositioned(argument),
 			},
 		)
 	}
@@ -160,6 +160,9 @@ func (checker *Checker) visitMember(expression *ast.MemberExpression) (accessedT
 		if !ok {
 			return
 		}
+		if resolver.Available != nil && !resolver.Available(checker) {
+			return
+		}
 		targetRange := ast.NewRangeFromPositioned(expression.Expression)
 		member = resolver.Resolve(identifier, targetRange, checker.report)
 	}
@@ -241,6 +244,9 @@ func (checker *Checker) visitMember(expression *ast.MemberExpression) (accessedT
 		// outside of an invocation of it.
 		//
 		// This would result in a bound method for a resource, which is invalid.
+		//
+		// NOTE: Binding a function of a reference to a resource is valid,
+		// as the reference, not the resource, is bound
 
 		if !checker.inAssignment &&
 			!checker.inInvocation &&
@@ -40,3 +40,33 @@ func (checker *Checker) VisitPathExpression(expression *ast.PathExpression) ast.
 
 	return &PathType{}
 }
+
+// IsValidPathIdentifier returns true if the given string is a valid identifier of a path,
+// i.e. it is a non-empty identifier which only consists of letters, digits, and underscores,
+// and does not start with a digit
+//
+func IsValidPathIdentifier(identifier string) bool {
+	if identifier == "" {
+		return false
+	}
+
+	for i, r := range identifier {
+		switch {
+		case r >= 'a' && r <= 'z',
+			r >= 'A' && r <= 'Z',
+			r == '_':
+
+			continue
+
+		case r >= '0' && r <= '9':
+			if i == 0 {
+				return false
+			}
+
+		default:
+			return false
+		}
+	}
+
+	return true
+}
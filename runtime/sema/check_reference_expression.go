@@ -94,7 +94,7 @@ func (checker *Checker) VisitReferenceExpression(referenceExpression *ast.Refere
 	if !referencedType.IsInvalidType() &&
 		referenceType != nil &&
 		!referenceType.Type.IsInvalidType() &&
-		!IsSubType(referencedType, referenceType.Type) {
+		!IsReferencedSubType(referencedType, referenceType.Type) {
 
 		checker.report(
 			&TypeMismatchError{
@@ -72,7 +72,11 @@ func (checker *Checker) VisitTransactionDeclaration(declaration *ast.Transaction
 		},
 	)
 
-	checker.checkResourceFieldsInvalidated(transactionType, transactionType.Members)
+	checker.checkResourceFieldsInvalidated(
+		transactionType,
+		transactionType.Members,
+		transactionType.Fields,
+	)
 
 	return nil
 }
@@ -21,6 +21,7 @@ package sema
 import (
 	"math"
 	"math/big"
+	"sort"
 
 	"github.com/rivo/uniseg"
 
@@ -87,6 +88,9 @@ type Checker struct {
 	accessCheckMode                    AccessCheckMode
 	errors                             []error
 	hints                              []Hint
+	pathUsage                          *PathUsage
+	publicMutableContractFieldHints    bool
+	storageIteration                   bool
 	valueActivations                   *VariableActivations
 	resources                          *Resources
 	typeActivations                    *VariableActivations
@@ -204,6 +208,29 @@ func WithImportHandler(handler ImportHandlerFunc) Option {
 	}
 }
 
+// WithPublicMutableContractFieldHints returns a checker option which enables
+// or disables hints for public mutable fields of contracts.
+//
+func WithPublicMutableContractFieldHints(enabled bool) Option {
+	return func(checker *Checker) error {
+		checker.publicMutableContractFieldHints = enabled
+		return nil
+	}
+}
+
+// WithStorageIteration returns a checker option which enables or disables
+// the function `AuthAccount.forEachStored`.
+//
+// Storage iteration should only be enabled if the interpreter
+// is configured with a storage keys handler (see `interpreter.WithStorageKeysHandler`).
+//
+func WithStorageIteration(enabled bool) Option {
+	return func(checker *Checker) error {
+		checker.storageIteration = enabled
+		return nil
+	}
+}
+
 func NewChecker(program *ast.Program, location ast.Location, options ...Option) (*Checker, error) {
 
 	if location == nil {
@@ -245,6 +272,7 @@ func NewChecker(program *ast.Program, location ast.Location, options ...Option)
 		variableOrigins:     map[*Variable]*Origin{},
 		memberOrigins:       map[Type]map[string]*Origin{},
 		Elaboration:         NewElaboration(),
+		pathUsage:           NewPathUsage(),
 	}
 
 	checker.beforeExtractor = NewBeforeExtractor(checker.report)
@@ -400,6 +428,48 @@ func (checker *Checker) UserDefinedValues() map[string]*Variable {
 	return variables
 }
 
+// AllCompositeTypes returns the types of all composite declarations in the checked program,
+// including nested ones, in the order in which they are declared
+//
+func (checker *Checker) AllCompositeTypes() []*CompositeType {
+	declarations := make([]*ast.CompositeDeclaration, 0, len(checker.Elaboration.CompositeDeclarationTypes))
+	for declaration := range checker.Elaboration.CompositeDeclarationTypes {
+		declarations = append(declarations, declaration)
+	}
+
+	sort.Slice(declarations, func(i, j int) bool {
+		return declarations[i].StartPos.Compare(declarations[j].StartPos) < 0
+	})
+
+	compositeTypes := make([]*CompositeType, len(declarations))
+	for i, declaration := range declarations {
+		compositeTypes[i] = checker.Elaboration.CompositeDeclarationTypes[declaration]
+	}
+
+	return compositeTypes
+}
+
+// AllInterfaceTypes returns the types of all interface declarations in the checked program,
+// including nested ones, in the order in which they are declared
+//
+func (checker *Checker) AllInterfaceTypes() []*InterfaceType {
+	declarations := make([]*ast.InterfaceDeclaration, 0, len(checker.Elaboration.InterfaceDeclarationTypes))
+	for declaration := range checker.Elaboration.InterfaceDeclarationTypes {
+		declarations = append(declarations, declaration)
+	}
+
+	sort.Slice(declarations, func(i, j int) bool {
+		return declarations[i].StartPos.Compare(declarations[j].StartPos) < 0
+	})
+
+	interfaceTypes := make([]*InterfaceType, len(declarations))
+	for i, declaration := range declarations {
+		interfaceTypes[i] = checker.Elaboration.InterfaceDeclarationTypes[declaration]
+	}
+
+	return interfaceTypes
+}
+
 func (checker *Checker) VisitProgram(program *ast.Program) ast.Repr {
 
 	for _, declaration := range program.ImportDeclarations() {
@@ -1929,6 +1999,18 @@ const resourceUUIDFieldDocString = `
 The automatically generated, unique ID of the resource
 `
 
+const EnumRawValueFieldName = "rawValue"
+
+const enumRawValueFieldDocString = `
+The raw value of the enum case
+`
+
+const EnumValuesFieldName = "values"
+
+const enumValuesFieldDocString = `
+All cases of the enum, in declaration order
+`
+
 func (checker *Checker) predeclaredMembers(containerType Type) []*Member {
 	var predeclaredMembers []*Member
 
@@ -2011,6 +2093,26 @@ func (checker *Checker) predeclaredMembers(containerType Type) []*Member {
 				false,
 				resourceUUIDFieldDocString,
 			)
+
+		case common.CompositeKindEnum:
+
+			// All enums have a predeclared field
+			// `pub let rawValue: T`, where `T` is the raw type of the enum,
+			// included in serialization
+
+			compositeType, ok := containerType.(*CompositeType)
+			if !ok || compositeType.EnumRawType == nil {
+				break
+			}
+
+			addPredeclaredMember(
+				EnumRawValueFieldName,
+				compositeType.EnumRawType,
+				common.DeclarationKindField,
+				ast.AccessPublic,
+				false,
+				enumRawValueFieldDocString,
+			)
 		}
 	}
 
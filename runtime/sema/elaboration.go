@@ -63,6 +63,7 @@ type Elaboration struct {
 	CompositeTypes                      map[TypeID]*CompositeType
 	InterfaceTypes                      map[TypeID]*InterfaceType
 	InvocationExpressionTypeArguments   map[*ast.InvocationExpression]map[*TypeParameter]Type
+	InvocationExpressionOverloadIndices map[*ast.InvocationExpression]int
 	IdentifierInInvocationTypes         map[*ast.IdentifierExpression]Type
 	ImportDeclarationsResolvedLocations map[*ast.ImportDeclaration][]ResolvedLocation
 }
@@ -104,6 +105,7 @@ func NewElaboration() *Elaboration {
 		CompositeTypes:                         map[TypeID]*CompositeType{},
 		InterfaceTypes:                         map[TypeID]*InterfaceType{},
 		InvocationExpressionTypeArguments:      map[*ast.InvocationExpression]map[*TypeParameter]Type{},
+		InvocationExpressionOverloadIndices:    map[*ast.InvocationExpression]int{},
 		IdentifierInInvocationTypes:            map[*ast.IdentifierExpression]Type{},
 		ImportDeclarationsResolvedLocations:    map[*ast.ImportDeclaration][]ResolvedLocation{},
 	}
@@ -203,6 +203,9 @@ func (*AssignmentToConstantError) isSemanticError() {}
 type TypeMismatchError struct {
 	ExpectedType Type
 	ActualType   Type
+	// MissingRestrictions are the restrictions of the expected type
+	// which are not satisfied by the actual type, if any (see `MissingRestrictions`)
+	MissingRestrictions []*InterfaceType
 	ast.Range
 }
 
@@ -213,11 +216,149 @@ func (e *TypeMismatchError) Error() string {
 func (*TypeMismatchError) isSemanticError() {}
 
 func (e *TypeMismatchError) SecondaryError() string {
-	return fmt.Sprintf(
+	message := fmt.Sprintf(
 		"expected `%s`, got `%s`",
 		e.ExpectedType.QualifiedString(),
 		e.ActualType.QualifiedString(),
 	)
+
+	if len(e.MissingRestrictions) > 0 {
+		var builder strings.Builder
+		builder.WriteString(message)
+		builder.WriteString(", missing restrictions: ")
+		for i, restriction := range e.MissingRestrictions {
+			if i > 0 {
+				builder.WriteString(", ")
+			}
+			builder.WriteString("`")
+			builder.WriteString(restriction.QualifiedString())
+			builder.WriteString("`")
+		}
+		message = builder.String()
+	}
+
+	return message
+}
+
+// InvalidBorrowTypeError
+
+type InvalidBorrowTypeError struct {
+	Type Type
+	ast.Range
+}
+
+func (e *InvalidBorrowTypeError) Error() string {
+	return fmt.Sprintf(
+		"cannot borrow type: `%s`",
+		e.Type.QualifiedString(),
+	)
+}
+
+func (*InvalidBorrowTypeError) isSemanticError() {}
+
+func (e *InvalidBorrowTypeError) SecondaryError() string {
+	return "a value of the referenced type can never be stored"
+}
+
+// InvalidCapabilityLinkTypeError
+
+type InvalidCapabilityLinkTypeError struct {
+	Type Type
+	ast.Range
+}
+
+func (e *InvalidCapabilityLinkTypeError) Error() string {
+	return fmt.Sprintf(
+		"invalid type argument: expected reference type, got `%s`",
+		e.Type.QualifiedString(),
+	)
+}
+
+func (*InvalidCapabilityLinkTypeError) isSemanticError() {}
+
+func (e *InvalidCapabilityLinkTypeError) SecondaryError() string {
+	return fmt.Sprintf(
+		"capabilities and stored objects are linked and borrowed as references, consider using `&%s`",
+		e.Type.QualifiedString(),
+	)
+}
+
+// InvalidCapabilityBorrowTypeError
+
+type InvalidCapabilityBorrowTypeError struct {
+	ExpectedBorrowType Type
+	ActualBorrowType   Type
+	ast.Range
+}
+
+func (e *InvalidCapabilityBorrowTypeError) Error() string {
+	format := "invalid borrow type: expected `%s`, got `%s`"
+	if e.allowsSubtypes() {
+		format = "invalid borrow type: expected subtype of `%s`, got `%s`"
+	}
+
+	return fmt.Sprintf(
+		format,
+		e.ExpectedBorrowType.QualifiedString(),
+		e.ActualBorrowType.QualifiedString(),
+	)
+}
+
+func (*InvalidCapabilityBorrowTypeError) isSemanticError() {}
+
+func (e *InvalidCapabilityBorrowTypeError) SecondaryError() string {
+	if e.allowsSubtypes() {
+		return "the capability can only be borrowed as its borrow type or a subtype of it"
+	}
+	return "the capability can only be borrowed as its borrow type"
+}
+
+// allowsSubtypes returns true if the capability may also be borrowed
+// as a subtype of its borrow type, i.e. the borrow type is an authorized reference
+//
+func (e *InvalidCapabilityBorrowTypeError) allowsSubtypes() bool {
+	referenceType, ok := e.ExpectedBorrowType.(*ReferenceType)
+	return ok && referenceType.Authorized
+}
+
+// InvalidResourceCopyError
+
+type InvalidResourceCopyError struct {
+	Type Type
+	ast.Range
+}
+
+func (e *InvalidResourceCopyError) Error() string {
+	return fmt.Sprintf(
+		"cannot copy resource type: `%s`",
+		e.Type.QualifiedString(),
+	)
+}
+
+func (*InvalidResourceCopyError) isSemanticError() {}
+
+func (e *InvalidResourceCopyError) SecondaryError() string {
+	return "resources cannot be copied, consider using `load` to move the resource out of storage, or `borrow` to get a reference to it"
+}
+
+// InvalidResourceTrySaveError
+
+type InvalidResourceTrySaveError struct {
+	Type Type
+	ast.Range
+}
+
+func (e *InvalidResourceTrySaveError) Error() string {
+	return fmt.Sprintf(
+		"cannot try to save resource type: `%s`",
+		e.Type.QualifiedString(),
+	)
+}
+
+func (*InvalidResourceTrySaveError) isSemanticError() {}
+
+func (e *InvalidResourceTrySaveError) SecondaryError() string {
+	return "the resource would be lost if it cannot be saved, consider using `save`"
 }
 
 // TypeMismatchWithDescriptionError
@@ -306,6 +447,22 @@ func (e *NotEquatableTypeError) Error() string {
 
 func (*NotEquatableTypeError) isSemanticError() {}
 
+// NotComparableTypeError
+
+type NotComparableTypeError struct {
+	Type Type
+	ast.Range
+}
+
+func (e *NotComparableTypeError) Error() string {
+	return fmt.Sprintf(
+		"cannot order value which has type: `%s`",
+		e.Type.QualifiedString(),
+	)
+}
+
+func (*NotComparableTypeError) isSemanticError() {}
+
 // NotCallableError
 
 type NotCallableError struct {
@@ -752,6 +909,12 @@ type FieldTypeNotStorableError struct {
 	Name string
 	// Field's type
 	Type Type
+	// The type which causes the field's type to be non-storable,
+	// e.g. the element type of an array
+	NonStorableType Type
+	// The chain of types from the field's type to the non-storable type,
+	// see NonStorablePath
+	NonStorablePath []Type
 	// StartPosition of the error
 	Pos ast.Position
 }
@@ -764,6 +927,96 @@ func (e *FieldTypeNotStorableError) Error() string {
 	)
 }
 
+func (e *FieldTypeNotStorableError) SecondaryError() string {
+	nonStorableType := e.NonStorableType
+	if nonStorableType == nil {
+		nonStorableType = e.Type
+	}
+
+	var description string
+	switch nonStorableType.(type) {
+	case *ReferenceType:
+		description = "reference type"
+	case *FunctionType:
+		description = "function type"
+	default:
+		description = "non-storable type"
+	}
+
+	if nonStorableType.Equal(e.Type) {
+		return fmt.Sprintf(
+			"`%s` is a %s",
+			nonStorableType.QualifiedString(),
+			description,
+		)
+	}
+
+	// Mention the nested composite and interface types
+	// which contain the non-storable type, if any
+
+	var nestedTypes []string
+	if len(e.NonStorablePath) > 2 {
+		for _, pathType := range e.NonStorablePath[1 : len(e.NonStorablePath)-1] {
+			switch pathType.(type) {
+			case *CompositeType, *InterfaceType:
+				nestedTypes = append(
+					nestedTypes,
+					fmt.Sprintf("`%s`", pathType.QualifiedString()),
+				)
+			}
+		}
+	}
+
+	if len(nestedTypes) > 0 {
+		return fmt.Sprintf(
+			"contains %s `%s` (through %s)",
+			description,
+			nonStorableType.QualifiedString(),
+			strings.Join(nestedTypes, ", "),
+		)
+	}
+
+	return fmt.Sprintf(
+		"contains %s `%s`",
+		description,
+		nonStorableType.QualifiedString(),
+	)
+}
+
+// ReferenceFieldError
+
+type ReferenceFieldError struct {
+	// Field's name
+	Name string
+	// Field's type
+	Type Type
+	// StartPosition of the error
+	Pos ast.Position
+}
+
+func (e *ReferenceFieldError) Error() string {
+	return fmt.Sprintf(
+		"field `%s` has reference type: `%s`",
+		e.Name,
+		e.Type.QualifiedString(),
+	)
+}
+
+func (e *ReferenceFieldError) SecondaryError() string {
+	return "references are transient and cannot be stored in fields; consider storing a capability instead"
+}
+
+func (*ReferenceFieldError) isSemanticError() {}
+
+func (e *ReferenceFieldError) StartPosition() ast.Position {
+	return e.Pos
+}
+
+func (e *ReferenceFieldError) EndPosition() ast.Position {
+	length := len(e.Name)
+	return e.Pos.Shifted(length - 1)
+}
+
 // FunctionExpressionInConditionError
 
 type FunctionExpressionInConditionError struct {
@@ -871,6 +1124,74 @@ func (e *InvalidConformanceError) EndPosition() ast.Position {
 type MemberMismatch struct {
 	CompositeMember *Member
 	InterfaceMember *Member
+	Reason          MemberMismatchReason
+}
+
+// Description returns a description of the mismatch,
+// i.e. the expected and the actual member signature, and the reason for the mismatch
+//
+func (m MemberMismatch) Description() string {
+	return fmt.Sprintf(
+		"expected `%s` but got `%s` (%s)",
+		memberSignature(m.InterfaceMember),
+		memberSignature(m.CompositeMember),
+		m.Reason.Description(),
+	)
+}
+
+// memberSignature returns the declaration signature of the given member,
+// e.g. `pub let x: Int` or `pub fun f(a: Int): Int`
+//
+func memberSignature(member *Member) string {
+	var builder strings.Builder
+
+	if keyword := member.Access.Keyword(); keyword != "" {
+		builder.WriteString(keyword)
+		builder.WriteRune(' ')
+	}
+
+	identifier := member.Identifier.Identifier
+	memberType := member.TypeAnnotation.Type
+
+	switch member.DeclarationKind {
+	case common.DeclarationKindFunction:
+		builder.WriteString("fun ")
+		builder.WriteString(identifier)
+
+		functionType, ok := memberType.(*FunctionType)
+		if !ok {
+			break
+		}
+
+		builder.WriteRune('(')
+		for i, parameter := range functionType.Parameters {
+			if i > 0 {
+				builder.WriteString(", ")
+			}
+			builder.WriteString(parameter.QualifiedString())
+		}
+		builder.WriteRune(')')
+
+		if functionType.ReturnTypeAnnotation != nil {
+			returnType := functionType.ReturnTypeAnnotation.Type
+			if _, ok := returnType.(*VoidType); !ok {
+				builder.WriteString(": ")
+				builder.WriteString(functionType.ReturnTypeAnnotation.QualifiedString())
+			}
+		}
+
+	default:
+		if member.VariableKind != ast.VariableKindNotSpecified {
+			builder.WriteString(member.VariableKind.Keyword())
+			builder.WriteRune(' ')
+		}
+
+		builder.WriteString(identifier)
+		builder.WriteString(": ")
+		builder.WriteString(member.TypeAnnotation.QualifiedString())
+	}
+
+	return builder.String()
 }
 
 type InitializerMismatch struct {
@@ -912,6 +1233,64 @@ func (e *ConformanceError) Error() string {
 
 func (*ConformanceError) isSemanticError() {}
 
+func (e *ConformanceError) SecondaryError() string {
+	var details []string
+
+	if e.InitializerMismatch != nil {
+		details = append(details, "initializer does not match")
+	}
+
+	if len(e.MissingMembers) > 0 {
+		names := make([]string, len(e.MissingMembers))
+		for i, member := range e.MissingMembers {
+			names[i] = fmt.Sprintf("`%s`", member.Identifier.Identifier)
+		}
+		details = append(details,
+			fmt.Sprintf("missing members: %s", strings.Join(names, ", ")),
+		)
+	}
+
+	if len(e.MemberMismatches) > 0 {
+		names := make([]string, len(e.MemberMismatches))
+		for i, memberMismatch := range e.MemberMismatches {
+			names[i] = fmt.Sprintf(
+				"`%s` (%s)",
+				memberMismatch.CompositeMember.Identifier.Identifier,
+				memberMismatch.Reason.Description(),
+			)
+		}
+		details = append(details,
+			fmt.Sprintf("mismatched members: %s", strings.Join(names, ", ")),
+		)
+	}
+
+	suggestions := e.MissingNestedCompositeTypeSuggestions()
+	if len(suggestions) > 0 {
+		for i, suggestion := range suggestions {
+			suggestions[i] = fmt.Sprintf("`%s`", suggestion)
+		}
+		details = append(details,
+			fmt.Sprintf(
+				"missing nested type declarations, consider adding: %s",
+				strings.Join(suggestions, ", "),
+			),
+		)
+	}
+
+	return strings.Join(details, "; ")
+}
+
+// MissingNestedCompositeTypeSuggestions returns a suggested skeleton declaration
+// for each missing nested composite type, i.e. each unsatisfied type requirement
+//
+func (e *ConformanceError) MissingNestedCompositeTypeSuggestions() []string {
+	suggestions := make([]string, len(e.MissingNestedCompositeTypes))
+	for i, missingNestedCompositeType := range e.MissingNestedCompositeTypes {
+		suggestions[i] = typeRequirementDeclarationSuggestion(missingNestedCompositeType)
+	}
+	return suggestions
+}
+
 func (e *ConformanceError) StartPosition() ast.Position {
 	return e.Pos
 }
@@ -927,7 +1306,8 @@ func (e *ConformanceError) ErrorNotes() (notes []errors.ErrorNote) {
 			ast.NewRangeFromPositioned(memberMismatch.CompositeMember.Identifier)
 
 		notes = append(notes, &MemberMismatchNote{
-			Range: compositeMemberIdentifierRange,
+			Mismatch: memberMismatch,
+			Range:    compositeMemberIdentifierRange,
 		})
 	}
 
@@ -937,11 +1317,15 @@ func (e *ConformanceError) ErrorNotes() (notes []errors.ErrorNote) {
 // MemberMismatchNote
 
 type MemberMismatchNote struct {
+	Mismatch MemberMismatch
 	ast.Range
 }
 
 func (n MemberMismatchNote) Message() string {
-	return "mismatch here"
+	return fmt.Sprintf(
+		"mismatch here: %s",
+		n.Mismatch.Description(),
+	)
 }
 
 // DuplicateConformanceError
@@ -966,6 +1350,41 @@ func (e *DuplicateConformanceError) Error() string {
 
 func (*DuplicateConformanceError) isSemanticError() {}
 
+// ConflictingTypeRequirementError
+
+type ConflictingTypeRequirementError struct {
+	CompositeType        *CompositeType
+	Name                 string
+	InterfaceType        *InterfaceType
+	TypeRequirement      *CompositeType
+	OtherInterfaceType   *InterfaceType
+	OtherTypeRequirement *CompositeType
+	ast.Range
+}
+
+func (e *ConflictingTypeRequirementError) Error() string {
+	return fmt.Sprintf(
+		"%s `%s` has conflicting type requirements for `%s`",
+		e.CompositeType.Kind.Name(),
+		e.CompositeType.QualifiedString(),
+		e.Name,
+	)
+}
+
+func (*ConflictingTypeRequirementError) isSemanticError() {}
+
+func (e *ConflictingTypeRequirementError) SecondaryError() string {
+	return fmt.Sprintf(
+		"%s `%s` requires a %s, but %s `%s` requires a %s",
+		e.InterfaceType.CompositeKind.DeclarationKind(true).Name(),
+		e.InterfaceType.QualifiedString(),
+		e.TypeRequirement.Kind.Name(),
+		e.OtherInterfaceType.CompositeKind.DeclarationKind(true).Name(),
+		e.OtherInterfaceType.QualifiedString(),
+		e.OtherTypeRequirement.Kind.Name(),
+	)
+}
+
 // MissingConformanceError
 
 type MissingConformanceError struct {
@@ -1111,6 +1530,80 @@ func (e *UnsupportedOverloadingError) Error() string {
 
 func (*UnsupportedOverloadingError) isSemanticError() {}
 
+// MultipleDestructorsError
+
+type MultipleDestructorsError struct {
+	PreviousPos ast.Position
+	ast.Range
+}
+
+func (e *MultipleDestructorsError) Error() string {
+	return "cannot declare multiple destructors"
+}
+
+func (e *MultipleDestructorsError) SecondaryError() string {
+	return "destructors cannot be overloaded"
+}
+
+func (*MultipleDestructorsError) isSemanticError() {}
+
+func (e *MultipleDestructorsError) ErrorNotes() []errors.ErrorNote {
+	// NOTE: the previous destructor's identifier has the same length
+	length := e.EndPos.Offset - e.StartPos.Offset
+
+	return []errors.ErrorNote{
+		&RedeclarationNote{
+			Range: ast.Range{
+				StartPos: e.PreviousPos,
+				EndPos:   e.PreviousPos.Shifted(length),
+			},
+		},
+	}
+}
+
+// AmbiguousOverloadingError
+
+type AmbiguousOverloadingError struct {
+	DeclarationKind common.DeclarationKind
+	ast.Range
+}
+
+func (e *AmbiguousOverloadingError) Error() string {
+	return fmt.Sprintf(
+		"ambiguous %s overloading",
+		e.DeclarationKind.Name(),
+	)
+}
+
+func (e *AmbiguousOverloadingError) SecondaryError() string {
+	return fmt.Sprintf(
+		"another %s with the same argument labels is already declared",
+		e.DeclarationKind.Name(),
+	)
+}
+
+func (*AmbiguousOverloadingError) isSemanticError() {}
+
+// OverloadedInitializerConformanceError
+
+type OverloadedInitializerConformanceError struct {
+	InterfaceType *InterfaceType
+	ast.Range
+}
+
+func (e *OverloadedInitializerConformanceError) Error() string {
+	return fmt.Sprintf(
+		"cannot overload initializer: conformance to `%s` requires an initializer",
+		e.InterfaceType.QualifiedString(),
+	)
+}
+
+func (e *OverloadedInitializerConformanceError) SecondaryError() string {
+	return "the initializer requirement's conditions can only be applied to a single initializer"
+}
+
+func (*OverloadedInitializerConformanceError) isSemanticError() {}
+
 // CompositeKindMismatchError
 
 type CompositeKindMismatchError struct {
@@ -1657,6 +2150,9 @@ func (*InvalidSwapExpressionError) isSemanticError() {}
 
 type InvalidEventParameterTypeError struct {
 	Type Type
+	// UnsupportedType is the type which is not supported,
+	// which is either the parameter type itself or a nested type
+	UnsupportedType Type
 	ast.Range
 }
 
@@ -1667,6 +2163,18 @@ func (e *InvalidEventParameterTypeError) Error() string {
 	)
 }
 
+func (e *InvalidEventParameterTypeError) SecondaryError() string {
+	if e.UnsupportedType == nil || e.UnsupportedType.Equal(e.Type) {
+		return "events only support numbers, booleans, strings, characters, addresses, " +
+			"and optionals, arrays, dictionaries, and structures of them"
+	}
+
+	return fmt.Sprintf(
+		"contains unsupported type `%s`",
+		e.UnsupportedType.QualifiedString(),
+	)
+}
+
 func (*InvalidEventParameterTypeError) isSemanticError() {}
 
 // InvalidEventUsageError
@@ -2531,6 +3039,26 @@ func (e *InvalidRestrictedTypeMemberAccessError) Error() string {
 
 func (*InvalidRestrictedTypeMemberAccessError) isSemanticError() {}
 
+// RestrictionMemberKindConflictError
+
+type RestrictionMemberKindConflictError struct {
+	Name                 string
+	DeclarationKind      common.DeclarationKind
+	OtherDeclarationKind common.DeclarationKind
+	ast.Range
+}
+
+func (e *RestrictionMemberKindConflictError) Error() string {
+	return fmt.Sprintf(
+		"restrictions declare member `%s` with conflicting kinds: %s and %s",
+		e.Name,
+		e.DeclarationKind.Name(),
+		e.OtherDeclarationKind.Name(),
+	)
+}
+
+func (*RestrictionMemberKindConflictError) isSemanticError() {}
+
 // RestrictionMemberClashError
 
 type RestrictionMemberClashError struct {
@@ -2609,6 +3137,23 @@ func (e *InvalidPathDomainError) SecondaryError() string {
 	)
 }
 
+// InvalidPathIdentifierError
+
+type InvalidPathIdentifierError struct {
+	Identifier string
+	ast.Range
+}
+
+func (e *InvalidPathIdentifierError) Error() string {
+	return fmt.Sprintf("invalid path identifier: `%s`", e.Identifier)
+}
+
+func (*InvalidPathIdentifierError) isSemanticError() {}
+
+func (e *InvalidPathIdentifierError) SecondaryError() string {
+	return "path identifiers may only contain letters, digits, and underscores, and must not start with a digit"
+}
+
 // InvalidTypeArgumentCountError
 
 type InvalidTypeArgumentCountError struct {
@@ -2788,3 +3333,129 @@ func (e *CyclicImportsError) Error() string {
 }
 
 func (*CyclicImportsError) isSemanticError() {}
+
+// InvalidTypeIDError
+
+type InvalidTypeIDError struct {
+	TypeID TypeID
+}
+
+func (e *InvalidTypeIDError) Error() string {
+	return fmt.Sprintf("invalid type ID: `%s`", e.TypeID)
+}
+
+// MissingEnumRawTypeError
+
+type MissingEnumRawTypeError struct {
+	Pos ast.Position
+}
+
+func (e *MissingEnumRawTypeError) Error() string {
+	return "missing raw type for enum"
+}
+
+func (e *MissingEnumRawTypeError) SecondaryError() string {
+	return "declare an integer raw type, e.g. `enum E: UInt8 { ... }`"
+}
+
+func (*MissingEnumRawTypeError) isSemanticError() {}
+
+func (e *MissingEnumRawTypeError) StartPosition() ast.Position {
+	return e.Pos
+}
+
+func (e *MissingEnumRawTypeError) EndPosition() ast.Position {
+	return e.Pos
+}
+
+// InvalidEnumRawTypeError
+
+type InvalidEnumRawTypeError struct {
+	Type Type
+	ast.Range
+}
+
+func (e *InvalidEnumRawTypeError) Error() string {
+	return fmt.Sprintf(
+		"invalid raw type for enum: `%s`",
+		e.Type.QualifiedString(),
+	)
+}
+
+func (e *InvalidEnumRawTypeError) SecondaryError() string {
+	return "only integer types are supported"
+}
+
+func (*InvalidEnumRawTypeError) isSemanticError() {}
+
+// InvalidEnumCaseCountError
+
+type InvalidEnumCaseCountError struct {
+	RawType Type
+	ast.Range
+}
+
+func (e *InvalidEnumCaseCountError) Error() string {
+	return fmt.Sprintf(
+		"too many enum cases for raw type `%s`",
+		e.RawType.QualifiedString(),
+	)
+}
+
+func (e *InvalidEnumCaseCountError) SecondaryError() string {
+	return "the raw value of a case is its index, which must be in the range of the raw type"
+}
+
+func (*InvalidEnumCaseCountError) isSemanticError() {}
+
+// InvalidEnumConformancesError
+
+type InvalidEnumConformancesError struct {
+	ast.Range
+}
+
+func (e *InvalidEnumConformancesError) Error() string {
+	return "enums cannot conform to interfaces"
+}
+
+func (e *InvalidEnumConformancesError) SecondaryError() string {
+	return "an enum may only declare its raw type"
+}
+
+func (*InvalidEnumConformancesError) isSemanticError() {}
+
+// InvalidEnumCaseError
+
+type InvalidEnumCaseError struct {
+	ContainerDeclarationKind common.DeclarationKind
+	ast.Range
+}
+
+func (e *InvalidEnumCaseError) Error() string {
+	return fmt.Sprintf(
+		"%s declarations cannot contain enum cases",
+		e.ContainerDeclarationKind.Name(),
+	)
+}
+
+func (*InvalidEnumCaseError) isSemanticError() {}
+
+// InvalidNonEnumCaseError
+
+type InvalidNonEnumCaseError struct {
+	DeclarationKind common.DeclarationKind
+	ast.Range
+}
+
+func (e *InvalidNonEnumCaseError) Error() string {
+	return fmt.Sprintf(
+		"enum declarations cannot contain %s declarations",
+		e.DeclarationKind.Name(),
+	)
+}
+
+func (e *InvalidNonEnumCaseError) SecondaryError() string {
+	return "enums may only declare cases"
+}
+
+func (*InvalidNonEnumCaseError) isSemanticError() {}
@@ -75,6 +75,23 @@ func (a *FunctionActivations) Current() *FunctionActivation {
 	return a.activations[lastIndex]
 }
 
+// EnclosingInitializationInfo returns the initialization information
+// of the innermost enclosing initializer, if any.
+//
+// The current function itself is not considered,
+// i.e. the result is only non-nil if the current function
+// is a nested function inside of an initializer
+//
+func (a *FunctionActivations) EnclosingInitializationInfo() *InitializationInfo {
+	for i := len(a.activations) - 2; i >= 0; i-- {
+		initializationInfo := a.activations[i].InitializationInfo
+		if initializationInfo != nil {
+			return initializationInfo
+		}
+	}
+	return nil
+}
+
 func (a *FunctionActivations) EnterLoop() {
 	a.Current().Loops++
 }
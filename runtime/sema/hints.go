@@ -45,3 +45,21 @@ func (h *ReplacementHint) Hint() string {
 }
 
 func (*ReplacementHint) isHint() {}
+
+// PublicMutableContractFieldHint
+
+type PublicMutableContractFieldHint struct {
+	FieldName string
+	ast.Range
+}
+
+func (h *PublicMutableContractFieldHint) Hint() string {
+	return fmt.Sprintf(
+		"contract field `%s` is public and mutable, "+
+			"consider restricting its access, e.g. with `access(contract)`, "+
+			"and exposing its value through a getter function",
+		h.FieldName,
+	)
+}
+
+func (*PublicMutableContractFieldHint) isHint() {}
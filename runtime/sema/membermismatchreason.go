@@ -0,0 +1,58 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2020 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package sema
+
+//go:generate go run golang.org/x/tools/cmd/stringer -type=MemberMismatchReason
+
+// MemberMismatchReason is the reason why a member of a composite
+// does not satisfy the corresponding member of an interface
+//
+type MemberMismatchReason uint
+
+const (
+	MemberMismatchReasonUnknown MemberMismatchReason = iota
+	MemberMismatchReasonDeclarationKind
+	MemberMismatchReasonFieldType
+	MemberMismatchReasonArgumentLabels
+	MemberMismatchReasonParameterTypes
+	MemberMismatchReasonReturnType
+	MemberMismatchReasonVariableKind
+	MemberMismatchReasonAccess
+)
+
+func (r MemberMismatchReason) Description() string {
+	switch r {
+	case MemberMismatchReasonDeclarationKind:
+		return "declaration kind mismatch"
+	case MemberMismatchReasonFieldType:
+		return "type mismatch"
+	case MemberMismatchReasonArgumentLabels:
+		return "argument label mismatch"
+	case MemberMismatchReasonParameterTypes:
+		return "parameter type mismatch"
+	case MemberMismatchReasonReturnType:
+		return "return type mismatch"
+	case MemberMismatchReasonVariableKind:
+		return "variable kind mismatch"
+	case MemberMismatchReasonAccess:
+		return "access mismatch"
+	}
+
+	return "mismatch"
+}
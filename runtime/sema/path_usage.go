@@ -0,0 +1,125 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2020 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package sema
+
+import (
+	"github.com/onflow/cadence/runtime/ast"
+	"github.com/onflow/cadence/runtime/common"
+)
+
+// UsedPath is a literal path that is used in a storage operation
+//
+type UsedPath struct {
+	Domain     common.PathDomain
+	Identifier string
+}
+
+// PathUsage records the literal paths which are read from and written to
+// by invocations of the storage functions of authorized accounts
+//
+type PathUsage struct {
+	Reads  map[UsedPath]bool
+	Writes map[UsedPath]bool
+}
+
+func NewPathUsage() *PathUsage {
+	return &PathUsage{
+		Reads:  map[UsedPath]bool{},
+		Writes: map[UsedPath]bool{},
+	}
+}
+
+// pathUsageKind describes how a storage function of an authorized account
+// uses the path passed as the argument at the given index
+//
+type pathUsageKind struct {
+	argumentIndex int
+	read          bool
+	write         bool
+}
+
+var authAccountPathUsageKinds = map[string]pathUsageKind{
+	"save":       {argumentIndex: 1, write: true},
+	"trySave":    {argumentIndex: 1, read: true, write: true},
+	"load":       {read: true, write: true},
+	"loadStrict": {read: true, write: true},
+	"copy":       {read: true},
+	"type":       {read: true},
+	"borrow":     {read: true},
+	"link":       {write: true},
+	"unlink":     {write: true},
+}
+
+// recordPathUsage records the path usage of the given invocation,
+// if it is an invocation of a storage function of an authorized account
+// and the path argument is a literal
+//
+func (checker *Checker) recordPathUsage(
+	invocationExpression *ast.InvocationExpression,
+	member *Member,
+) {
+	if member == nil {
+		return
+	}
+
+	if _, ok := member.ContainerType.(*AuthAccountType); !ok {
+		return
+	}
+
+	kind, ok := authAccountPathUsageKinds[member.Identifier.Identifier]
+	if !ok {
+		return
+	}
+
+	arguments := invocationExpression.Arguments
+	if len(arguments) <= kind.argumentIndex {
+		return
+	}
+
+	pathExpression, ok := arguments[kind.argumentIndex].Expression.(*ast.PathExpression)
+	if !ok {
+		return
+	}
+
+	domain := common.PathDomainFromIdentifier(pathExpression.Domain.Identifier)
+	if domain == common.PathDomainUnknown {
+		return
+	}
+
+	path := UsedPath{
+		Domain:     domain,
+		Identifier: pathExpression.Identifier.Identifier,
+	}
+
+	if kind.read {
+		checker.pathUsage.Reads[path] = true
+	}
+
+	if kind.write {
+		checker.pathUsage.Writes[path] = true
+	}
+}
+
+// PathUsage returns the literal paths which are read from and written to
+// by invocations of the storage functions of authorized accounts,
+// i.e. `save`, `load`, `copy`, `type`, `borrow`, `link`, and `unlink`
+//
+func (checker *Checker) PathUsage() *PathUsage {
+	return checker.pathUsage
+}
@@ -29,8 +29,13 @@ func (ri *ReturnInfo) MergeBranches(thenReturnInfo *ReturnInfo, elseReturnInfo *
 		thenReturnInfo.MaybeReturned ||
 		elseReturnInfo.MaybeReturned
 
+	// If one branch definitely returned and the other definitely halted,
+	// the branches together definitely returned
+
 	ri.DefinitelyReturned = ri.DefinitelyReturned ||
 		(thenReturnInfo.DefinitelyReturned &&
+			(elseReturnInfo.DefinitelyReturned || elseReturnInfo.DefinitelyHalted)) ||
+		(thenReturnInfo.DefinitelyHalted &&
 			elseReturnInfo.DefinitelyReturned)
 
 	ri.DefinitelyHalted = ri.DefinitelyHalted ||
@@ -22,6 +22,7 @@ import (
 	"fmt"
 	"math"
 	"math/big"
+	"sort"
 	"strings"
 
 	"github.com/onflow/cadence/fixedpoint"
@@ -67,6 +68,8 @@ type TypeID string
 
 type Type interface {
 	IsType()
+	// Tag returns the tag of the type, see `TypeTag`
+	Tag() TypeTag
 	ID() TypeID
 	String() string
 	QualifiedString() string
@@ -87,6 +90,10 @@ type Type interface {
 	// IsEquatable returns true if values of the type can be equated
 	IsEquatable() bool
 
+	// IsComparable returns true if values of the type can be ordered,
+	// i.e. compared using the non-equality comparison operators, like `<`
+	IsComparable() bool
+
 	TypeAnnotationState() TypeAnnotationState
 	RewriteWithRestrictedTypes() (result Type, rewritten bool)
 
@@ -140,6 +147,10 @@ type ValueIndexableType interface {
 type MemberResolver struct {
 	Kind    common.DeclarationKind
 	Resolve func(identifier string, targetRange ast.Range, report func(error)) *Member
+	// Available optionally determines if the member is available
+	// with the configuration of the given checker.
+	// If it is nil, the member is always available
+	Available func(checker *Checker) bool
 }
 
 // ContainedType is a type which might have a container type
@@ -168,6 +179,41 @@ func VisitContainerAndNested(t ContainerType, visit func(ty Type)) {
 	}
 }
 
+// FlattenNestedTypes returns the given container type and all types nested in it,
+// transitively, in a deterministic order:
+// Each container type is followed by its nested types, sorted by their identifier,
+// and each nested type is followed by the types nested in it, if any
+//
+func FlattenNestedTypes(t ContainerType) []Type {
+	var result []Type
+
+	var visit func(ty Type)
+	visit = func(ty Type) {
+		result = append(result, ty)
+
+		containerType, ok := ty.(ContainerType)
+		if !ok {
+			return
+		}
+
+		nestedTypes := containerType.NestedTypes()
+
+		identifiers := make([]string, 0, len(nestedTypes))
+		for identifier := range nestedTypes {
+			identifiers = append(identifiers, identifier)
+		}
+		sort.Strings(identifiers)
+
+		for _, identifier := range identifiers {
+			visit(nestedTypes[identifier])
+		}
+	}
+
+	visit(t)
+
+	return result
+}
+
 // CompositeKindedType is a type which has a composite kind
 //
 type CompositeKindedType interface {
@@ -280,6 +326,101 @@ const isInstanceFunctionDocString = `
 Returns true if the object conforms to the given type at runtime
 `
 
+// getType
+
+const GetTypeFunctionName = "getType"
+
+var getTypeFunctionType = &FunctionType{
+	ReturnTypeAnnotation: NewTypeAnnotation(
+		&MetaType{},
+	),
+}
+
+const getTypeFunctionDocString = `
+Returns the type of the value at run-time
+`
+
+// withGetTypeFunction adds the function `fun getType(): Type` to the given members,
+// which allows inspecting the run-time type of values of the top types, e.g. `AnyStruct`
+//
+func withGetTypeFunction(ty Type, members map[string]MemberResolver) map[string]MemberResolver {
+	if members == nil {
+		members = map[string]MemberResolver{}
+	}
+
+	members[GetTypeFunctionName] = MemberResolver{
+		Kind: common.DeclarationKindFunction,
+		Resolve: func(identifier string, _ ast.Range, _ func(error)) *Member {
+			return NewPublicFunctionMember(
+				ty,
+				identifier,
+				getTypeFunctionType,
+				getTypeFunctionDocString,
+			)
+		},
+	}
+
+	return members
+}
+
+// downcast
+
+const DowncastFunctionName = "downcast"
+
+var downcastFunctionType = func() *FunctionType {
+
+	typeParameter := &TypeParameter{
+		Name:      "T",
+		TypeBound: &AnyStructType{},
+	}
+
+	return &FunctionType{
+		TypeParameters: []*TypeParameter{
+			typeParameter,
+		},
+		ReturnTypeAnnotation: NewTypeAnnotation(
+			&OptionalType{
+				Type: &GenericType{
+					TypeParameter: typeParameter,
+				},
+			},
+		),
+	}
+}()
+
+const downcastFunctionDocString = `
+Returns the value as an optional of the given type, if the value has the given type at run-time, or nil otherwise.
+
+This is equivalent to the failable cast ` + "`as?`" + `
+`
+
+// withDowncastFunction adds the function `fun downcast<T: AnyStruct>(): T?` to the given members,
+// which allows attempting a conversion of values of the top structure types, e.g. `AnyStruct`.
+//
+// NOTE: The function is not available for resources:
+// A function member cannot move the value it is accessed on,
+// so the resource would be duplicated
+//
+func withDowncastFunction(ty Type, members map[string]MemberResolver) map[string]MemberResolver {
+	if members == nil {
+		members = map[string]MemberResolver{}
+	}
+
+	members[DowncastFunctionName] = MemberResolver{
+		Kind: common.DeclarationKindFunction,
+		Resolve: func(identifier string, _ ast.Range, _ func(error)) *Member {
+			return NewPublicFunctionMember(
+				ty,
+				identifier,
+				downcastFunctionType,
+				downcastFunctionDocString,
+			)
+		},
+	}
+
+	return members
+}
+
 // toString
 
 const ToStringFunctionName = "toString"
@@ -310,6 +451,345 @@ const toBigEndianBytesFunctionDocString = `
 Returns an array containing the big-endian byte representation of the number
 `
 
+const addressTypeToBigEndianBytesFunctionDocString = `
+Returns an array containing the big-endian byte representation of the address.
+This is the same as the result of ` + "`toBytes`" + `
+`
+
+// fromBigEndianBytes
+
+const NumberTypeFromBigEndianBytesFunctionName = "fromBigEndianBytes"
+
+func numberTypeFromBigEndianBytesFunctionType(ty Type) *FunctionType {
+	return &FunctionType{
+		Parameters: []*Parameter{
+			{
+				Label:      ArgumentLabelNotRequired,
+				Identifier: "bytes",
+				TypeAnnotation: NewTypeAnnotation(
+					&VariableSizedType{
+						Type: &UInt8Type{},
+					},
+				),
+			},
+		},
+		ReturnTypeAnnotation: NewTypeAnnotation(
+			&OptionalType{
+				Type: ty,
+			},
+		),
+	}
+}
+
+const numberTypeFromBigEndianBytesFunctionDocString = `
+Returns the number represented by the given big-endian byte representation,
+as produced by ` + "`toBigEndianBytes`" + `.
+
+Returns nil if the number of bytes does not match the width of the type
+`
+
+// min and max
+
+const NumberTypeMinFieldName = "min"
+const NumberTypeMaxFieldName = "max"
+
+const numberTypeMinFieldDocString = `
+The minimum value of the number type
+`
+
+const numberTypeMaxFieldDocString = `
+The maximum value of the number type
+`
+
+// clamp
+
+const NumberTypeClampFunctionName = "clamp"
+
+func numberTypeClampFunctionType(ty Type) *FunctionType {
+	return &FunctionType{
+		Parameters: []*Parameter{
+			{
+				Identifier:     "min",
+				TypeAnnotation: NewTypeAnnotation(ty),
+			},
+			{
+				Identifier:     "max",
+				TypeAnnotation: NewTypeAnnotation(ty),
+			},
+		},
+		ReturnTypeAnnotation: NewTypeAnnotation(ty),
+	}
+}
+
+const numberTypeClampFunctionDocString = `
+Returns the number restricted to the given inclusive range.
+Aborts if the minimum is greater than the maximum
+`
+
+// pow
+
+const NumberTypePowFunctionName = "pow"
+
+func numberTypePowFunctionType(ty Type) *FunctionType {
+
+	// Integers can only be raised to small non-negative exponents.
+	// Fixed-point numbers can also be raised to negative exponents
+
+	var exponentType Type = &UInt8Type{}
+	if IsSubType(ty, &FixedPointType{}) {
+		exponentType = &IntType{}
+	}
+
+	return &FunctionType{
+		Parameters: []*Parameter{
+			{
+				Label:          ArgumentLabelNotRequired,
+				Identifier:     "exponent",
+				TypeAnnotation: NewTypeAnnotation(exponentType),
+			},
+		},
+		ReturnTypeAnnotation: NewTypeAnnotation(ty),
+	}
+}
+
+const numberTypePowFunctionDocString = `
+Returns the number raised to the power of the given exponent.
+Aborts on overflow, except for the Word types, which wrap around
+`
+
+// abs
+
+const SignedNumberTypeAbsFunctionName = "abs"
+
+const signedNumberTypeAbsFunctionDocString = `
+Returns the absolute value of the number.
+Aborts if the absolute value is not representable, e.g. for the minimum value of a signed integer type
+`
+
+// truncate / round
+
+const FixedPointTypeTruncateFunctionName = "truncate"
+
+const fixedPointTypeTruncateFunctionDocString = `
+Returns the integer part of the number, i.e. the number rounded toward zero
+`
+
+const FixedPointTypeRoundFunctionName = "round"
+
+const fixedPointTypeRoundFunctionDocString = `
+Returns the number rounded to the nearest integer.
+Halfway cases are rounded away from zero
+`
+
+var fixedPointTypeToIntFunctionType = &FunctionType{
+	ReturnTypeAnnotation: NewTypeAnnotation(&IntType{}),
+}
+
+// mulDiv
+
+const FixedPointTypeMulDivFunctionName = "mulDiv"
+
+func fixedPointTypeMulDivFunctionType(ty Type) *FunctionType {
+	return &FunctionType{
+		Parameters: []*Parameter{
+			{
+				Label:          ArgumentLabelNotRequired,
+				Identifier:     "a",
+				TypeAnnotation: NewTypeAnnotation(ty),
+			},
+			{
+				Label:          ArgumentLabelNotRequired,
+				Identifier:     "b",
+				TypeAnnotation: NewTypeAnnotation(ty),
+			},
+		},
+		ReturnTypeAnnotation: NewTypeAnnotation(ty),
+	}
+}
+
+const fixedPointTypeMulDivFunctionDocString = `
+Returns the number multiplied by a and divided by b.
+The intermediate product is not rounded, the result is rounded once, toward zero (truncated).
+Aborts if b is zero, or if the result is out of range
+`
+
+// gcd / lcm
+
+const IntegerTypeGCDFunctionName = "gcd"
+
+const integerTypeGCDFunctionDocString = `
+Returns the greatest common divisor of the number and the given number.
+The result is non-negative, and zero if both numbers are zero.
+Aborts if the result is not representable, e.g. for the minimum value of a signed integer type
+`
+
+const IntegerTypeLCMFunctionName = "lcm"
+
+const integerTypeLCMFunctionDocString = `
+Returns the least common multiple of the number and the given number.
+The result is non-negative, and zero if either number is zero.
+Aborts on overflow, except for the Word types, which wrap around
+`
+
+func integerTypeBinaryFunctionType(ty Type) *FunctionType {
+	return &FunctionType{
+		Parameters: []*Parameter{
+			{
+				Label:          ArgumentLabelNotRequired,
+				Identifier:     "other",
+				TypeAnnotation: NewTypeAnnotation(ty),
+			},
+		},
+		ReturnTypeAnnotation: NewTypeAnnotation(ty),
+	}
+}
+
+// wrappingAdd / wrappingSubtract / wrappingMultiply
+
+const UnsignedIntegerTypeWrappingAddFunctionName = "wrappingAdd"
+
+const unsignedIntegerTypeWrappingAddFunctionDocString = `
+Returns the sum of the number and the given number.
+Wraps around on overflow, i.e. the result is computed modulo 2^N, where N is the bit width of the type
+`
+
+const UnsignedIntegerTypeWrappingSubtractFunctionName = "wrappingSubtract"
+
+const unsignedIntegerTypeWrappingSubtractFunctionDocString = `
+Returns the difference of the number and the given number.
+Wraps around on underflow, i.e. the result is computed modulo 2^N, where N is the bit width of the type
+`
+
+const UnsignedIntegerTypeWrappingMultiplyFunctionName = "wrappingMultiply"
+
+const unsignedIntegerTypeWrappingMultiplyFunctionDocString = `
+Returns the product of the number and the given number.
+Wraps around on overflow, i.e. the result is computed modulo 2^N, where N is the bit width of the type
+`
+
+// bitwiseAnd / bitwiseOr / bitwiseXor / shiftLeft / shiftRight
+
+const IntegerTypeBitwiseAndFunctionName = "bitwiseAnd"
+
+const integerTypeBitwiseAndFunctionDocString = `
+Returns the bitwise AND of the number and the given number
+`
+
+const IntegerTypeBitwiseOrFunctionName = "bitwiseOr"
+
+const integerTypeBitwiseOrFunctionDocString = `
+Returns the bitwise OR of the number and the given number
+`
+
+const IntegerTypeBitwiseXorFunctionName = "bitwiseXor"
+
+const integerTypeBitwiseXorFunctionDocString = `
+Returns the bitwise XOR of the number and the given number
+`
+
+const IntegerTypeShiftLeftFunctionName = "shiftLeft"
+
+const integerTypeShiftLeftFunctionDocString = `
+Returns the number shifted left by the given number of bits.
+Aborts on overflow, except for the Word types, which discard the bits shifted out.
+Int and UInt never overflow
+`
+
+const IntegerTypeShiftRightFunctionName = "shiftRight"
+
+const integerTypeShiftRightFunctionDocString = `
+Returns the number shifted right by the given number of bits.
+Negative numbers are shifted arithmetically, i.e. the result is rounded toward negative infinity
+`
+
+func integerTypeShiftFunctionType(ty Type) *FunctionType {
+	return &FunctionType{
+		Parameters: []*Parameter{
+			{
+				Label:          ArgumentLabelNotRequired,
+				Identifier:     "bits",
+				TypeAnnotation: NewTypeAnnotation(&UInt8Type{}),
+			},
+		},
+		ReturnTypeAnnotation: NewTypeAnnotation(ty),
+	}
+}
+
+// toInt, toUInt8, etc.
+
+// NumberConversionFunctionName returns the name of the function
+// which converts a number to the given number type, e.g. `toUInt8`
+//
+func NumberConversionFunctionName(targetType Type) string {
+	return "to" + targetType.String()
+}
+
+// NumberConversionFunctionTargetTypes maps the names of the number conversion functions
+// to their target types, e.g. `toUInt8` to `UInt8`
+//
+var NumberConversionFunctionTargetTypes = func() map[string]Type {
+	targetTypes := map[string]Type{}
+
+	for _, numberType := range AllNumberTypes {
+		if !isLeafNumberType(numberType) {
+			continue
+		}
+
+		targetTypes[NumberConversionFunctionName(numberType)] = numberType
+	}
+
+	return targetTypes
+}()
+
+func numberConversionFunctionDocString(targetType Type) string {
+	return fmt.Sprintf(
+		`
+Returns the number converted to type %s.
+Aborts if the number is not in the range of the type
+`,
+		targetType,
+	)
+}
+
+// withNumberConversionFunctions adds a conversion function
+// to all other leaf number types to the given members, e.g. `toUInt8`
+//
+func withNumberConversionFunctions(ty Type, members map[string]MemberResolver) {
+	for name, targetType := range NumberConversionFunctionTargetTypes {
+
+		if targetType.Equal(ty) {
+			continue
+		}
+
+		targetType := targetType
+
+		members[name] = MemberResolver{
+			Kind: common.DeclarationKindFunction,
+			Resolve: func(identifier string, _ ast.Range, _ func(error)) *Member {
+				return NewPublicFunctionMember(
+					ty,
+					identifier,
+					&FunctionType{
+						ReturnTypeAnnotation: NewTypeAnnotation(targetType),
+					},
+					numberConversionFunctionDocString(targetType),
+				)
+			},
+		}
+	}
+}
+
+// isLeafNumberType returns true if the given type is a concrete number type,
+// i.e. not one of the abstract number super-types, like `Integer`
+//
+func isLeafNumberType(ty Type) bool {
+	if abstractNumberTypeTags.Contains(ty.Tag()) {
+		return false
+	}
+
+	return IsSubType(ty, &NumberType{})
+}
+
 func withBuiltinMembers(ty Type, members map[string]MemberResolver) map[string]MemberResolver {
 	if members == nil {
 		members = map[string]MemberResolver{}
@@ -346,9 +826,16 @@ func withBuiltinMembers(ty Type, members map[string]MemberResolver) map[string]M
 		}
 	}
 
-	// All number types have a `toBigEndianBytes` function
+	// All number types and addresses have a `toBigEndianBytes` function
 
-	if IsSubType(ty, &NumberType{}) {
+	isAddressType := IsSubType(ty, &AddressType{})
+
+	if IsSubType(ty, &NumberType{}) || isAddressType {
+
+		docString := toBigEndianBytesFunctionDocString
+		if isAddressType {
+			docString = addressTypeToBigEndianBytesFunctionDocString
+		}
 
 		members[ToBigEndianBytesFunctionName] = MemberResolver{
 			Kind: common.DeclarationKindFunction,
@@ -357,10 +844,241 @@ func withBuiltinMembers(ty Type, members map[string]MemberResolver) map[string]M
 					ty,
 					identifier,
 					toBigEndianBytesFunctionType,
-					toBigEndianBytesFunctionDocString,
+					docString,
+				)
+			},
+		}
+	}
+
+	// All leaf number types have `clamp` and `pow` functions,
+	// and functions to convert to all other leaf number types, e.g. `toUInt8`.
+	// The arguments and results have the same type as the number,
+	// so the abstract number super-types (e.g. `Integer`) do not have these functions
+
+	if isLeafNumberType(ty) {
+
+		members[NumberTypeClampFunctionName] = MemberResolver{
+			Kind: common.DeclarationKindFunction,
+			Resolve: func(identifier string, _ ast.Range, _ func(error)) *Member {
+				return NewPublicFunctionMember(
+					ty,
+					identifier,
+					numberTypeClampFunctionType(ty),
+					numberTypeClampFunctionDocString,
+				)
+			},
+		}
+
+		members[NumberTypePowFunctionName] = MemberResolver{
+			Kind: common.DeclarationKindFunction,
+			Resolve: func(identifier string, _ ast.Range, _ func(error)) *Member {
+				return NewPublicFunctionMember(
+					ty,
+					identifier,
+					numberTypePowFunctionType(ty),
+					numberTypePowFunctionDocString,
+				)
+			},
+		}
+
+		withNumberConversionFunctions(ty, members)
+	}
+
+	// All signed number types have an `abs` function
+
+	if IsSubType(ty, &SignedNumberType{}) {
+
+		members[SignedNumberTypeAbsFunctionName] = MemberResolver{
+			Kind: common.DeclarationKindFunction,
+			Resolve: func(identifier string, _ ast.Range, _ func(error)) *Member {
+				return NewPublicFunctionMember(
+					ty,
+					identifier,
+					&FunctionType{
+						ReturnTypeAnnotation: NewTypeAnnotation(ty),
+					},
+					signedNumberTypeAbsFunctionDocString,
+				)
+			},
+		}
+	}
+
+	// All leaf integer types have `gcd` and `lcm` functions,
+	// and bitwise and shift functions
+
+	if isLeafNumberType(ty) && IsSubType(ty, &IntegerType{}) {
+
+		members[IntegerTypeGCDFunctionName] = MemberResolver{
+			Kind: common.DeclarationKindFunction,
+			Resolve: func(identifier string, _ ast.Range, _ func(error)) *Member {
+				return NewPublicFunctionMember(
+					ty,
+					identifier,
+					integerTypeBinaryFunctionType(ty),
+					integerTypeGCDFunctionDocString,
 				)
 			},
 		}
+
+		members[IntegerTypeLCMFunctionName] = MemberResolver{
+			Kind: common.DeclarationKindFunction,
+			Resolve: func(identifier string, _ ast.Range, _ func(error)) *Member {
+				return NewPublicFunctionMember(
+					ty,
+					identifier,
+					integerTypeBinaryFunctionType(ty),
+					integerTypeLCMFunctionDocString,
+				)
+			},
+		}
+
+		// The bitwise functions take and return the integer type,
+		// the shift functions take the number of bits as an `UInt8`
+
+		for name, docString := range map[string]string{
+			IntegerTypeBitwiseAndFunctionName: integerTypeBitwiseAndFunctionDocString,
+			IntegerTypeBitwiseOrFunctionName:  integerTypeBitwiseOrFunctionDocString,
+			IntegerTypeBitwiseXorFunctionName: integerTypeBitwiseXorFunctionDocString,
+		} {
+			docString := docString
+
+			members[name] = MemberResolver{
+				Kind: common.DeclarationKindFunction,
+				Resolve: func(identifier string, _ ast.Range, _ func(error)) *Member {
+					return NewPublicFunctionMember(
+						ty,
+						identifier,
+						integerTypeBinaryFunctionType(ty),
+						docString,
+					)
+				},
+			}
+		}
+
+		for name, docString := range map[string]string{
+			IntegerTypeShiftLeftFunctionName:  integerTypeShiftLeftFunctionDocString,
+			IntegerTypeShiftRightFunctionName: integerTypeShiftRightFunctionDocString,
+		} {
+			docString := docString
+
+			members[name] = MemberResolver{
+				Kind: common.DeclarationKindFunction,
+				Resolve: func(identifier string, _ ast.Range, _ func(error)) *Member {
+					return NewPublicFunctionMember(
+						ty,
+						identifier,
+						integerTypeShiftFunctionType(ty),
+						docString,
+					)
+				},
+			}
+		}
+	}
+
+	// The fixed-size unsigned integer types `UInt8` to `UInt64` have wrapping arithmetic functions,
+	// which wrap around like the arithmetic operators of the `Word` types
+
+	if wrappingArithmeticTypeTags.Contains(ty.Tag()) {
+
+		for name, docString := range map[string]string{
+			UnsignedIntegerTypeWrappingAddFunctionName:      unsignedIntegerTypeWrappingAddFunctionDocString,
+			UnsignedIntegerTypeWrappingSubtractFunctionName: unsignedIntegerTypeWrappingSubtractFunctionDocString,
+			UnsignedIntegerTypeWrappingMultiplyFunctionName: unsignedIntegerTypeWrappingMultiplyFunctionDocString,
+		} {
+			docString := docString
+
+			members[name] = MemberResolver{
+				Kind: common.DeclarationKindFunction,
+				Resolve: func(identifier string, _ ast.Range, _ func(error)) *Member {
+					return NewPublicFunctionMember(
+						ty,
+						identifier,
+						integerTypeBinaryFunctionType(ty),
+						docString,
+					)
+				},
+			}
+		}
+	}
+
+	// All fixed-point types have `truncate` and `round` functions
+
+	if IsSubType(ty, &FixedPointType{}) {
+
+		members[FixedPointTypeTruncateFunctionName] = MemberResolver{
+			Kind: common.DeclarationKindFunction,
+			Resolve: func(identifier string, _ ast.Range, _ func(error)) *Member {
+				return NewPublicFunctionMember(
+					ty,
+					identifier,
+					fixedPointTypeToIntFunctionType,
+					fixedPointTypeTruncateFunctionDocString,
+				)
+			},
+		}
+
+		members[FixedPointTypeRoundFunctionName] = MemberResolver{
+			Kind: common.DeclarationKindFunction,
+			Resolve: func(identifier string, _ ast.Range, _ func(error)) *Member {
+				return NewPublicFunctionMember(
+					ty,
+					identifier,
+					fixedPointTypeToIntFunctionType,
+					fixedPointTypeRoundFunctionDocString,
+				)
+			},
+		}
+
+		// The arguments and the result of `mulDiv` have the same type as the number,
+		// so the abstract fixed-point super-types do not have it
+
+		if isLeafNumberType(ty) {
+			members[FixedPointTypeMulDivFunctionName] = MemberResolver{
+				Kind: common.DeclarationKindFunction,
+				Resolve: func(identifier string, _ ast.Range, _ func(error)) *Member {
+					return NewPublicFunctionMember(
+						ty,
+						identifier,
+						fixedPointTypeMulDivFunctionType(ty),
+						fixedPointTypeMulDivFunctionDocString,
+					)
+				},
+			}
+		}
+	}
+
+	// All bounded number types have `min` and `max` fields.
+	// Arbitrary-precision types omit the unbounded side, e.g. `UInt` only has `min`
+
+	if rangedType, ok := ty.(IntegerRangedType); ok && IsSubType(ty, &NumberType{}) {
+
+		if rangedType.MinInt() != nil {
+			members[NumberTypeMinFieldName] = MemberResolver{
+				Kind: common.DeclarationKindField,
+				Resolve: func(identifier string, _ ast.Range, _ func(error)) *Member {
+					return NewPublicConstantFieldMember(
+						ty,
+						identifier,
+						ty,
+						numberTypeMinFieldDocString,
+					)
+				},
+			}
+		}
+
+		if rangedType.MaxInt() != nil {
+			members[NumberTypeMaxFieldName] = MemberResolver{
+				Kind: common.DeclarationKindField,
+				Resolve: func(identifier string, _ ast.Range, _ func(error)) *Member {
+					return NewPublicConstantFieldMember(
+						ty,
+						identifier,
+						ty,
+						numberTypeMaxFieldDocString,
+					)
+				},
+			}
+		}
 	}
 
 	return members
@@ -371,6 +1089,10 @@ type MetaType struct{}
 
 func (*MetaType) IsType() {}
 
+func (*MetaType) Tag() TypeTag {
+	return TypeTagMeta
+}
+
 func (*MetaType) String() string {
 	return "Type"
 }
@@ -404,6 +1126,10 @@ func (*MetaType) IsEquatable() bool {
 	return true
 }
 
+func (*MetaType) IsComparable() bool {
+	return false
+}
+
 func (*MetaType) TypeAnnotationState() TypeAnnotationState {
 	return TypeAnnotationStateValid
 }
@@ -446,6 +1172,10 @@ type AnyType struct{}
 
 func (*AnyType) IsType() {}
 
+func (*AnyType) Tag() TypeTag {
+	return TypeTagAny
+}
+
 func (*AnyType) String() string {
 	return "Any"
 }
@@ -480,6 +1210,10 @@ func (*AnyType) IsEquatable() bool {
 	return false
 }
 
+func (*AnyType) IsComparable() bool {
+	return false
+}
+
 func (*AnyType) TypeAnnotationState() TypeAnnotationState {
 	return TypeAnnotationStateValid
 }
@@ -497,7 +1231,7 @@ func (t *AnyType) Resolve(_ map[*TypeParameter]Type) Type {
 }
 
 func (t *AnyType) GetMembers() map[string]MemberResolver {
-	return withBuiltinMembers(t, nil)
+	return withBuiltinMembers(t, withGetTypeFunction(t, nil))
 }
 
 // AnyStructType represents the top type of all non-resource types
@@ -505,6 +1239,10 @@ type AnyStructType struct{}
 
 func (*AnyStructType) IsType() {}
 
+func (*AnyStructType) Tag() TypeTag {
+	return TypeTagAnyStruct
+}
+
 func (*AnyStructType) String() string {
 	return "AnyStruct"
 }
@@ -539,6 +1277,10 @@ func (*AnyStructType) IsEquatable() bool {
 	return false
 }
 
+func (*AnyStructType) IsComparable() bool {
+	return false
+}
+
 func (*AnyStructType) TypeAnnotationState() TypeAnnotationState {
 	return TypeAnnotationStateValid
 }
@@ -556,7 +1298,7 @@ func (t *AnyStructType) Resolve(_ map[*TypeParameter]Type) Type {
 }
 
 func (t *AnyStructType) GetMembers() map[string]MemberResolver {
-	return withBuiltinMembers(t, nil)
+	return withBuiltinMembers(t, withDowncastFunction(t, withGetTypeFunction(t, nil)))
 }
 
 // AnyResourceType represents the top type of all resource types
@@ -564,6 +1306,10 @@ type AnyResourceType struct{}
 
 func (*AnyResourceType) IsType() {}
 
+func (*AnyResourceType) Tag() TypeTag {
+	return TypeTagAnyResource
+}
+
 func (*AnyResourceType) String() string {
 	return "AnyResource"
 }
@@ -598,6 +1344,10 @@ func (*AnyResourceType) IsEquatable() bool {
 	return false
 }
 
+func (*AnyResourceType) IsComparable() bool {
+	return false
+}
+
 func (*AnyResourceType) TypeAnnotationState() TypeAnnotationState {
 	return TypeAnnotationStateValid
 }
@@ -615,7 +1365,7 @@ func (t *AnyResourceType) Resolve(_ map[*TypeParameter]Type) Type {
 }
 
 func (t *AnyResourceType) GetMembers() map[string]MemberResolver {
-	return withBuiltinMembers(t, nil)
+	return withBuiltinMembers(t, withGetTypeFunction(t, nil))
 }
 
 // NeverType represents the bottom type
@@ -623,6 +1373,10 @@ type NeverType struct{}
 
 func (*NeverType) IsType() {}
 
+func (*NeverType) Tag() TypeTag {
+	return TypeTagNever
+}
+
 func (*NeverType) String() string {
 	return "Never"
 }
@@ -656,6 +1410,10 @@ func (*NeverType) IsEquatable() bool {
 	return false
 }
 
+func (*NeverType) IsComparable() bool {
+	return false
+}
+
 func (*NeverType) TypeAnnotationState() TypeAnnotationState {
 	return TypeAnnotationStateValid
 }
@@ -681,6 +1439,10 @@ type VoidType struct{}
 
 func (*VoidType) IsType() {}
 
+func (*VoidType) Tag() TypeTag {
+	return TypeTagVoid
+}
+
 func (*VoidType) String() string {
 	return "Void"
 }
@@ -714,6 +1476,10 @@ func (*VoidType) IsEquatable() bool {
 	return false
 }
 
+func (*VoidType) IsComparable() bool {
+	return false
+}
+
 func (*VoidType) TypeAnnotationState() TypeAnnotationState {
 	return TypeAnnotationStateValid
 }
@@ -742,6 +1508,10 @@ type InvalidType struct{}
 
 func (*InvalidType) IsType() {}
 
+func (*InvalidType) Tag() TypeTag {
+	return TypeTagInvalid
+}
+
 func (*InvalidType) String() string {
 	return "<<invalid>>"
 }
@@ -775,6 +1545,10 @@ func (*InvalidType) IsEquatable() bool {
 	return false
 }
 
+func (*InvalidType) IsComparable() bool {
+	return false
+}
+
 func (*InvalidType) TypeAnnotationState() TypeAnnotationState {
 	return TypeAnnotationStateValid
 }
@@ -802,6 +1576,10 @@ type OptionalType struct {
 
 func (*OptionalType) IsType() {}
 
+func (*OptionalType) Tag() TypeTag {
+	return TypeTagOptional
+}
+
 func (t *OptionalType) String() string {
 	if t.Type == nil {
 		return "optional"
@@ -848,6 +1626,10 @@ func (t *OptionalType) IsEquatable() bool {
 	return t.Type.IsEquatable()
 }
 
+func (*OptionalType) IsComparable() bool {
+	return false
+}
+
 func (t *OptionalType) TypeAnnotationState() TypeAnnotationState {
 	return t.Type.TypeAnnotationState()
 }
@@ -891,6 +1673,20 @@ with the value of this optional when it is not nil.
 Returns nil if this optional is nil
 `
 
+const optionalTypeMapRefFunctionDocString = `
+Returns an optional of the result of calling the given function
+with a reference to the value of this optional when it is not nil.
+The value is not moved, so this function is also available for optionals of resources.
+
+Returns nil if this optional is nil
+`
+
+const optionalTypeOrElseFunctionDocString = `
+Returns the value of this optional when it is not nil.
+
+Returns the given default value if this optional is nil
+`
+
 func (t *OptionalType) GetMembers() map[string]MemberResolver {
 
 	members := map[string]MemberResolver{
@@ -910,54 +1706,116 @@ func (t *OptionalType) GetMembers() map[string]MemberResolver {
 					)
 				}
 
-				typeParameter := &TypeParameter{
-					Name: "T",
+				return NewPublicFunctionMember(
+					t,
+					identifier,
+					optionalTypeMapFunctionType(t.Type),
+					optionalTypeMapFunctionDocString,
+				)
+			},
+		},
+		"mapRef": {
+			Kind: common.DeclarationKindFunction,
+			Resolve: func(identifier string, _ ast.Range, _ func(error)) *Member {
+				return NewPublicFunctionMember(
+					t,
+					identifier,
+					optionalTypeMapFunctionType(
+						&ReferenceType{
+							Type: t.Type,
+						},
+					),
+					optionalTypeMapRefFunctionDocString,
+				)
+			},
+		},
+		"orElse": {
+			Kind: common.DeclarationKindFunction,
+			Resolve: func(identifier string, targetRange ast.Range, report func(error)) *Member {
+
+				// It is invalid for an optional of a resource to have an `orElse` function
+
+				if t.Type.IsResourceType() {
+					report(
+						&InvalidResourceOptionalMemberError{
+							Name:            identifier,
+							DeclarationKind: common.DeclarationKindFunction,
+							Range:           targetRange,
+						},
+					)
 				}
 
-				resultType := &GenericType{
-					TypeParameter: typeParameter,
-				}
+				return NewPublicFunctionMember(
+					t,
+					identifier,
+					optionalTypeOrElseFunctionType(t.Type),
+					optionalTypeOrElseFunctionDocString,
+				)
+			},
+		},
+	}
+
+	return withBuiltinMembers(t, members)
+}
+
+// optionalTypeOrElseFunctionType returns the type of the function `orElse`
+// of an optional with the given value type
+//
+func optionalTypeOrElseFunctionType(valueType Type) *FunctionType {
+	return &FunctionType{
+		Parameters: []*Parameter{
+			{
+				Label:          ArgumentLabelNotRequired,
+				Identifier:     "default",
+				TypeAnnotation: NewTypeAnnotation(valueType),
+			},
+		},
+		ReturnTypeAnnotation: NewTypeAnnotation(valueType),
+	}
+}
+
+// optionalTypeMapFunctionType returns the type of the functions `map` and `mapRef`
+// of an optional, where the transform function is called with the given value type
+//
+func optionalTypeMapFunctionType(valueType Type) *FunctionType {
+	typeParameter := &TypeParameter{
+		Name: "T",
+	}
+
+	resultType := &GenericType{
+		TypeParameter: typeParameter,
+	}
 
-				return NewPublicFunctionMember(
-					t,
-					identifier,
+	return &FunctionType{
+		TypeParameters: []*TypeParameter{
+			typeParameter,
+		},
+		Parameters: []*Parameter{
+			{
+				Label:      ArgumentLabelNotRequired,
+				Identifier: "transform",
+				TypeAnnotation: NewTypeAnnotation(
 					&FunctionType{
-						TypeParameters: []*TypeParameter{
-							typeParameter,
-						},
 						Parameters: []*Parameter{
 							{
-								Label:      ArgumentLabelNotRequired,
-								Identifier: "transform",
-								TypeAnnotation: NewTypeAnnotation(
-									&FunctionType{
-										Parameters: []*Parameter{
-											{
-												Label:          ArgumentLabelNotRequired,
-												Identifier:     "value",
-												TypeAnnotation: NewTypeAnnotation(t.Type),
-											},
-										},
-										ReturnTypeAnnotation: NewTypeAnnotation(
-											resultType,
-										),
-									},
-								),
+								Label:          ArgumentLabelNotRequired,
+								Identifier:     "value",
+								TypeAnnotation: NewTypeAnnotation(valueType),
 							},
 						},
 						ReturnTypeAnnotation: NewTypeAnnotation(
-							&OptionalType{
-								Type: resultType,
-							},
+							resultType,
 						),
 					},
-					optionalTypeMapFunctionDocString,
-				)
+				),
 			},
 		},
+		ReturnTypeAnnotation: NewTypeAnnotation(
+			&OptionalType{
+				Type: resultType,
+			},
+		),
 	}
-
-	return withBuiltinMembers(t, members)
 }
 
 // GenericType
@@ -968,6 +1826,10 @@ type GenericType struct {
 
 func (*GenericType) IsType() {}
 
+func (*GenericType) Tag() TypeTag {
+	return TypeTagGeneric
+}
+
 func (t *GenericType) String() string {
 	return t.TypeParameter.Name
 }
@@ -1004,6 +1866,10 @@ func (*GenericType) IsEquatable() bool {
 	return false
 }
 
+func (*GenericType) IsComparable() bool {
+	return false
+}
+
 func (t *GenericType) TypeAnnotationState() TypeAnnotationState {
 	return TypeAnnotationStateValid
 }
@@ -1070,6 +1936,10 @@ type BoolType struct{}
 
 func (*BoolType) IsType() {}
 
+func (*BoolType) Tag() TypeTag {
+	return TypeTagBool
+}
+
 func (*BoolType) String() string {
 	return "Bool"
 }
@@ -1103,6 +1973,10 @@ func (*BoolType) IsEquatable() bool {
 	return true
 }
 
+func (*BoolType) IsComparable() bool {
+	return false
+}
+
 func (*BoolType) TypeAnnotationState() TypeAnnotationState {
 	return TypeAnnotationStateValid
 }
@@ -1129,6 +2003,10 @@ type CharacterType struct{}
 
 func (*CharacterType) IsType() {}
 
+func (*CharacterType) Tag() TypeTag {
+	return TypeTagCharacter
+}
+
 func (*CharacterType) String() string {
 	return "Character"
 }
@@ -1162,6 +2040,10 @@ func (*CharacterType) IsEquatable() bool {
 	return true
 }
 
+func (*CharacterType) IsComparable() bool {
+	return true
+}
+
 func (*CharacterType) TypeAnnotationState() TypeAnnotationState {
 	return TypeAnnotationStateValid
 }
@@ -1178,8 +2060,47 @@ func (t *CharacterType) Resolve(_ map[*TypeParameter]Type) Type {
 	return t
 }
 
+const characterTypeToStringFunctionDocString = `
+Returns the character as a string
+`
+
+var characterTypeToStringFunctionType = &FunctionType{
+	ReturnTypeAnnotation: NewTypeAnnotation(
+		&StringType{},
+	),
+}
+
+const characterTypeUTF8FieldDocString = `
+The byte array of the UTF-8 encoding of the character
+`
+
 func (t *CharacterType) GetMembers() map[string]MemberResolver {
-	return withBuiltinMembers(t, nil)
+	return withBuiltinMembers(t, map[string]MemberResolver{
+		ToStringFunctionName: {
+			Kind: common.DeclarationKindFunction,
+			Resolve: func(identifier string, _ ast.Range, _ func(error)) *Member {
+				return NewPublicFunctionMember(
+					t,
+					identifier,
+					characterTypeToStringFunctionType,
+					characterTypeToStringFunctionDocString,
+				)
+			},
+		},
+		"utf8": {
+			Kind: common.DeclarationKindField,
+			Resolve: func(identifier string, _ ast.Range, _ func(error)) *Member {
+				return NewPublicConstantFieldMember(
+					t,
+					identifier,
+					&VariableSizedType{
+						Type: &UInt8Type{},
+					},
+					characterTypeUTF8FieldDocString,
+				)
+			},
+		},
+	})
 }
 
 // StringType represents the string type
@@ -1187,6 +2108,10 @@ type StringType struct{}
 
 func (*StringType) IsType() {}
 
+func (*StringType) Tag() TypeTag {
+	return TypeTagString
+}
+
 func (*StringType) String() string {
 	return "String"
 }
@@ -1220,6 +2145,10 @@ func (*StringType) IsEquatable() bool {
 	return true
 }
 
+func (*StringType) IsComparable() bool {
+	return true
+}
+
 func (*StringType) TypeAnnotationState() TypeAnnotationState {
 	return TypeAnnotationStateValid
 }
@@ -1269,6 +2198,48 @@ It does not modify the original string.
 If either of the parameters are out of the bounds of the string, the function will fail
 `
 
+var stringTypeReplaceAllFunctionType = &FunctionType{
+	Parameters: []*Parameter{
+		{
+			Identifier:     "of",
+			TypeAnnotation: NewTypeAnnotation(&StringType{}),
+		},
+		{
+			Identifier:     "with",
+			TypeAnnotation: NewTypeAnnotation(&StringType{}),
+		},
+	},
+	ReturnTypeAnnotation: NewTypeAnnotation(
+		&StringType{},
+	),
+}
+
+const stringTypeReplaceAllFunctionDocString = `
+Returns a new string in which all non-overlapping occurrences of the substring ` + "`of`" + ` are replaced with the string ` + "`with`" + `.
+
+It does not modify the original string.
+If the substring ` + "`of`" + ` is empty, the function will fail
+`
+
+var stringTypeCountFunctionType = &FunctionType{
+	Parameters: []*Parameter{
+		{
+			Label:          ArgumentLabelNotRequired,
+			Identifier:     "substring",
+			TypeAnnotation: NewTypeAnnotation(&StringType{}),
+		},
+	},
+	ReturnTypeAnnotation: NewTypeAnnotation(
+		&IntType{},
+	),
+}
+
+const stringTypeCountFunctionDocString = `
+Returns the number of non-overlapping occurrences of the given substring in the string.
+
+If the substring is empty, the function will fail
+`
+
 var stringTypeDecodeHexFunctionType = &FunctionType{
 	ReturnTypeAnnotation: NewTypeAnnotation(
 		&VariableSizedType{
@@ -1284,6 +2255,27 @@ The given string must only contain hexadecimal characters and must have an even
 If the string is malformed, the program aborts
 `
 
+var stringTypeCompareFunctionType = &FunctionType{
+	Parameters: []*Parameter{
+		{
+			Label:          ArgumentLabelNotRequired,
+			Identifier:     "other",
+			TypeAnnotation: NewTypeAnnotation(&StringType{}),
+		},
+	},
+	ReturnTypeAnnotation: NewTypeAnnotation(
+		&IntType{},
+	),
+}
+
+const stringTypeCompareFunctionDocString = `
+Compares the string to the given string.
+
+Returns -1 if the string is ordered before the given string, 0 if the strings are equal, and 1 if the string is ordered after the given string.
+
+The strings are ordered lexicographically by their Unicode code points, after both strings have been normalized (NFC)
+`
+
 const stringTypeLengthFieldDocString = `
 The number of characters in the string
 `
@@ -1312,6 +2304,28 @@ func (t *StringType) GetMembers() map[string]MemberResolver {
 				)
 			},
 		},
+		"replaceAll": {
+			Kind: common.DeclarationKindFunction,
+			Resolve: func(identifier string, _ ast.Range, _ func(error)) *Member {
+				return NewPublicFunctionMember(
+					t,
+					identifier,
+					stringTypeReplaceAllFunctionType,
+					stringTypeReplaceAllFunctionDocString,
+				)
+			},
+		},
+		"count": {
+			Kind: common.DeclarationKindFunction,
+			Resolve: func(identifier string, _ ast.Range, _ func(error)) *Member {
+				return NewPublicFunctionMember(
+					t,
+					identifier,
+					stringTypeCountFunctionType,
+					stringTypeCountFunctionDocString,
+				)
+			},
+		},
 		"decodeHex": {
 			Kind: common.DeclarationKindFunction,
 			Resolve: func(identifier string, _ ast.Range, _ func(error)) *Member {
@@ -1323,6 +2337,17 @@ func (t *StringType) GetMembers() map[string]MemberResolver {
 				)
 			},
 		},
+		"compare": {
+			Kind: common.DeclarationKindFunction,
+			Resolve: func(identifier string, _ ast.Range, _ func(error)) *Member {
+				return NewPublicFunctionMember(
+					t,
+					identifier,
+					stringTypeCompareFunctionType,
+					stringTypeCompareFunctionDocString,
+				)
+			},
+		},
 		"length": {
 			Kind: common.DeclarationKindField,
 			Resolve: func(identifier string, _ ast.Range, _ func(error)) *Member {
@@ -1366,6 +2391,10 @@ type NumberType struct{}
 
 func (*NumberType) IsType() {}
 
+func (*NumberType) Tag() TypeTag {
+	return TypeTagNumber
+}
+
 func (*NumberType) String() string {
 	return "Number"
 }
@@ -1399,6 +2428,13 @@ func (*NumberType) IsEquatable() bool {
 	return true
 }
 
+// IsComparable returns false: values of the abstract number types
+// may have different concrete types, which cannot be ordered
+//
+func (*NumberType) IsComparable() bool {
+	return false
+}
+
 func (*NumberType) TypeAnnotationState() TypeAnnotationState {
 	return TypeAnnotationStateValid
 }
@@ -1432,6 +2468,10 @@ type SignedNumberType struct{}
 
 func (*SignedNumberType) IsType() {}
 
+func (*SignedNumberType) Tag() TypeTag {
+	return TypeTagSignedNumber
+}
+
 func (*SignedNumberType) String() string {
 	return "SignedNumber"
 }
@@ -1465,6 +2505,10 @@ func (*SignedNumberType) IsEquatable() bool {
 	return true
 }
 
+func (*SignedNumberType) IsComparable() bool {
+	return false
+}
+
 func (*SignedNumberType) TypeAnnotationState() TypeAnnotationState {
 	return TypeAnnotationStateValid
 }
@@ -1513,6 +2557,10 @@ type IntegerType struct{}
 
 func (*IntegerType) IsType() {}
 
+func (*IntegerType) Tag() TypeTag {
+	return TypeTagInteger
+}
+
 func (*IntegerType) String() string {
 	return "Integer"
 }
@@ -1546,6 +2594,10 @@ func (*IntegerType) IsEquatable() bool {
 	return true
 }
 
+func (*IntegerType) IsComparable() bool {
+	return false
+}
+
 func (*IntegerType) TypeAnnotationState() TypeAnnotationState {
 	return TypeAnnotationStateValid
 }
@@ -1579,6 +2631,10 @@ type SignedIntegerType struct{}
 
 func (*SignedIntegerType) IsType() {}
 
+func (*SignedIntegerType) Tag() TypeTag {
+	return TypeTagSignedInteger
+}
+
 func (*SignedIntegerType) String() string {
 	return "SignedInteger"
 }
@@ -1612,6 +2668,10 @@ func (*SignedIntegerType) IsEquatable() bool {
 	return true
 }
 
+func (*SignedIntegerType) IsComparable() bool {
+	return false
+}
+
 func (*SignedIntegerType) TypeAnnotationState() TypeAnnotationState {
 	return TypeAnnotationStateValid
 }
@@ -1645,6 +2705,10 @@ type IntType struct{}
 
 func (*IntType) IsType() {}
 
+func (*IntType) Tag() TypeTag {
+	return TypeTagInt
+}
+
 func (*IntType) String() string {
 	return "Int"
 }
@@ -1678,6 +2742,10 @@ func (*IntType) IsEquatable() bool {
 	return true
 }
 
+func (*IntType) IsComparable() bool {
+	return true
+}
+
 func (*IntType) TypeAnnotationState() TypeAnnotationState {
 	return TypeAnnotationStateValid
 }
@@ -1712,6 +2780,10 @@ type Int8Type struct{}
 
 func (*Int8Type) IsType() {}
 
+func (*Int8Type) Tag() TypeTag {
+	return TypeTagInt8
+}
+
 func (*Int8Type) String() string {
 	return "Int8"
 }
@@ -1745,6 +2817,10 @@ func (*Int8Type) IsEquatable() bool {
 	return true
 }
 
+func (*Int8Type) IsComparable() bool {
+	return true
+}
+
 func (*Int8Type) TypeAnnotationState() TypeAnnotationState {
 	return TypeAnnotationStateValid
 }
@@ -1781,6 +2857,10 @@ type Int16Type struct{}
 
 func (*Int16Type) IsType() {}
 
+func (*Int16Type) Tag() TypeTag {
+	return TypeTagInt16
+}
+
 func (*Int16Type) String() string {
 	return "Int16"
 }
@@ -1814,6 +2894,10 @@ func (*Int16Type) IsEquatable() bool {
 	return true
 }
 
+func (*Int16Type) IsComparable() bool {
+	return true
+}
+
 func (*Int16Type) TypeAnnotationState() TypeAnnotationState {
 	return TypeAnnotationStateValid
 }
@@ -1850,6 +2934,10 @@ type Int32Type struct{}
 
 func (*Int32Type) IsType() {}
 
+func (*Int32Type) Tag() TypeTag {
+	return TypeTagInt32
+}
+
 func (*Int32Type) String() string {
 	return "Int32"
 }
@@ -1883,6 +2971,10 @@ func (*Int32Type) IsEquatable() bool {
 	return true
 }
 
+func (*Int32Type) IsComparable() bool {
+	return true
+}
+
 func (*Int32Type) TypeAnnotationState() TypeAnnotationState {
 	return TypeAnnotationStateValid
 }
@@ -1919,6 +3011,10 @@ type Int64Type struct{}
 
 func (*Int64Type) IsType() {}
 
+func (*Int64Type) Tag() TypeTag {
+	return TypeTagInt64
+}
+
 func (*Int64Type) String() string {
 	return "Int64"
 }
@@ -1952,6 +3048,10 @@ func (*Int64Type) IsEquatable() bool {
 	return true
 }
 
+func (*Int64Type) IsComparable() bool {
+	return true
+}
+
 func (*Int64Type) TypeAnnotationState() TypeAnnotationState {
 	return TypeAnnotationStateValid
 }
@@ -1988,6 +3088,10 @@ type Int128Type struct{}
 
 func (*Int128Type) IsType() {}
 
+func (*Int128Type) Tag() TypeTag {
+	return TypeTagInt128
+}
+
 func (*Int128Type) String() string {
 	return "Int128"
 }
@@ -2021,6 +3125,10 @@ func (*Int128Type) IsEquatable() bool {
 	return true
 }
 
+func (*Int128Type) IsComparable() bool {
+	return true
+}
+
 func (*Int128Type) TypeAnnotationState() TypeAnnotationState {
 	return TypeAnnotationStateValid
 }
@@ -2069,6 +3177,10 @@ type Int256Type struct{}
 
 func (*Int256Type) IsType() {}
 
+func (*Int256Type) Tag() TypeTag {
+	return TypeTagInt256
+}
+
 func (*Int256Type) String() string {
 	return "Int256"
 }
@@ -2102,6 +3214,10 @@ func (*Int256Type) IsEquatable() bool {
 	return true
 }
 
+func (*Int256Type) IsComparable() bool {
+	return true
+}
+
 func (*Int256Type) TypeAnnotationState() TypeAnnotationState {
 	return TypeAnnotationStateValid
 }
@@ -2150,6 +3266,10 @@ type UIntType struct{}
 
 func (*UIntType) IsType() {}
 
+func (*UIntType) Tag() TypeTag {
+	return TypeTagUInt
+}
+
 func (*UIntType) String() string {
 	return "UInt"
 }
@@ -2183,6 +3303,10 @@ func (*UIntType) IsEquatable() bool {
 	return true
 }
 
+func (*UIntType) IsComparable() bool {
+	return true
+}
+
 func (*UIntType) TypeAnnotationState() TypeAnnotationState {
 	return TypeAnnotationStateValid
 }
@@ -2219,6 +3343,10 @@ type UInt8Type struct{}
 
 func (*UInt8Type) IsType() {}
 
+func (*UInt8Type) Tag() TypeTag {
+	return TypeTagUInt8
+}
+
 func (*UInt8Type) String() string {
 	return "UInt8"
 }
@@ -2252,6 +3380,10 @@ func (*UInt8Type) IsEquatable() bool {
 	return true
 }
 
+func (*UInt8Type) IsComparable() bool {
+	return true
+}
+
 func (*UInt8Type) TypeAnnotationState() TypeAnnotationState {
 	return TypeAnnotationStateValid
 }
@@ -2289,6 +3421,10 @@ type UInt16Type struct{}
 
 func (*UInt16Type) IsType() {}
 
+func (*UInt16Type) Tag() TypeTag {
+	return TypeTagUInt16
+}
+
 func (*UInt16Type) String() string {
 	return "UInt16"
 }
@@ -2322,6 +3458,10 @@ func (*UInt16Type) IsEquatable() bool {
 	return true
 }
 
+func (*UInt16Type) IsComparable() bool {
+	return true
+}
+
 func (*UInt16Type) TypeAnnotationState() TypeAnnotationState {
 	return TypeAnnotationStateValid
 }
@@ -2359,6 +3499,10 @@ type UInt32Type struct{}
 
 func (*UInt32Type) IsType() {}
 
+func (*UInt32Type) Tag() TypeTag {
+	return TypeTagUInt32
+}
+
 func (*UInt32Type) String() string {
 	return "UInt32"
 }
@@ -2392,6 +3536,10 @@ func (*UInt32Type) IsEquatable() bool {
 	return true
 }
 
+func (*UInt32Type) IsComparable() bool {
+	return true
+}
+
 func (*UInt32Type) TypeAnnotationState() TypeAnnotationState {
 	return TypeAnnotationStateValid
 }
@@ -2429,6 +3577,10 @@ type UInt64Type struct{}
 
 func (*UInt64Type) IsType() {}
 
+func (*UInt64Type) Tag() TypeTag {
+	return TypeTagUInt64
+}
+
 func (*UInt64Type) String() string {
 	return "UInt64"
 }
@@ -2462,6 +3614,10 @@ func (*UInt64Type) IsEquatable() bool {
 	return true
 }
 
+func (*UInt64Type) IsComparable() bool {
+	return true
+}
+
 func (*UInt64Type) TypeAnnotationState() TypeAnnotationState {
 	return TypeAnnotationStateValid
 }
@@ -2499,6 +3655,10 @@ type UInt128Type struct{}
 
 func (*UInt128Type) IsType() {}
 
+func (*UInt128Type) Tag() TypeTag {
+	return TypeTagUInt128
+}
+
 func (*UInt128Type) String() string {
 	return "UInt128"
 }
@@ -2532,6 +3692,10 @@ func (*UInt128Type) IsEquatable() bool {
 	return true
 }
 
+func (*UInt128Type) IsComparable() bool {
+	return true
+}
+
 func (*UInt128Type) TypeAnnotationState() TypeAnnotationState {
 	return TypeAnnotationStateValid
 }
@@ -2575,6 +3739,10 @@ type UInt256Type struct{}
 
 func (*UInt256Type) IsType() {}
 
+func (*UInt256Type) Tag() TypeTag {
+	return TypeTagUInt256
+}
+
 func (*UInt256Type) String() string {
 	return "UInt256"
 }
@@ -2604,7 +3772,11 @@ func (*UInt256Type) IsStorable(_ map[*Member]bool) bool {
 	return true
 }
 
-func (*UInt256Type) IsEquatable() bool {
+func (*UInt256Type) IsEquatable() bool {
+	return true
+}
+
+func (*UInt256Type) IsComparable() bool {
 	return true
 }
 
@@ -2651,6 +3823,10 @@ type Word8Type struct{}
 
 func (*Word8Type) IsType() {}
 
+func (*Word8Type) Tag() TypeTag {
+	return TypeTagWord8
+}
+
 func (*Word8Type) String() string {
 	return "Word8"
 }
@@ -2684,6 +3860,10 @@ func (*Word8Type) IsEquatable() bool {
 	return true
 }
 
+func (*Word8Type) IsComparable() bool {
+	return true
+}
+
 func (*Word8Type) TypeAnnotationState() TypeAnnotationState {
 	return TypeAnnotationStateValid
 }
@@ -2721,6 +3901,10 @@ type Word16Type struct{}
 
 func (*Word16Type) IsType() {}
 
+func (*Word16Type) Tag() TypeTag {
+	return TypeTagWord16
+}
+
 func (*Word16Type) String() string {
 	return "Word16"
 }
@@ -2754,6 +3938,10 @@ func (*Word16Type) IsEquatable() bool {
 	return true
 }
 
+func (*Word16Type) IsComparable() bool {
+	return true
+}
+
 func (*Word16Type) TypeAnnotationState() TypeAnnotationState {
 	return TypeAnnotationStateValid
 }
@@ -2791,6 +3979,10 @@ type Word32Type struct{}
 
 func (*Word32Type) IsType() {}
 
+func (*Word32Type) Tag() TypeTag {
+	return TypeTagWord32
+}
+
 func (*Word32Type) String() string {
 	return "Word32"
 }
@@ -2824,6 +4016,10 @@ func (*Word32Type) IsEquatable() bool {
 	return true
 }
 
+func (*Word32Type) IsComparable() bool {
+	return true
+}
+
 func (*Word32Type) TypeAnnotationState() TypeAnnotationState {
 	return TypeAnnotationStateValid
 }
@@ -2861,6 +4057,10 @@ type Word64Type struct{}
 
 func (*Word64Type) IsType() {}
 
+func (*Word64Type) Tag() TypeTag {
+	return TypeTagWord64
+}
+
 func (*Word64Type) String() string {
 	return "Word64"
 }
@@ -2894,6 +4094,10 @@ func (*Word64Type) IsEquatable() bool {
 	return true
 }
 
+func (*Word64Type) IsComparable() bool {
+	return true
+}
+
 func (*Word64Type) TypeAnnotationState() TypeAnnotationState {
 	return TypeAnnotationStateValid
 }
@@ -2930,6 +4134,10 @@ type FixedPointType struct{}
 
 func (*FixedPointType) IsType() {}
 
+func (*FixedPointType) Tag() TypeTag {
+	return TypeTagFixedPoint
+}
+
 func (*FixedPointType) String() string {
 	return "FixedPoint"
 }
@@ -2963,6 +4171,10 @@ func (*FixedPointType) IsEquatable() bool {
 	return true
 }
 
+func (*FixedPointType) IsComparable() bool {
+	return false
+}
+
 func (*FixedPointType) TypeAnnotationState() TypeAnnotationState {
 	return TypeAnnotationStateValid
 }
@@ -2996,6 +4208,10 @@ type SignedFixedPointType struct{}
 
 func (*SignedFixedPointType) IsType() {}
 
+func (*SignedFixedPointType) Tag() TypeTag {
+	return TypeTagSignedFixedPoint
+}
+
 func (*SignedFixedPointType) String() string {
 	return "SignedFixedPoint"
 }
@@ -3029,6 +4245,10 @@ func (*SignedFixedPointType) IsEquatable() bool {
 	return true
 }
 
+func (*SignedFixedPointType) IsComparable() bool {
+	return false
+}
+
 func (*SignedFixedPointType) TypeAnnotationState() TypeAnnotationState {
 	return TypeAnnotationStateValid
 }
@@ -3066,6 +4286,10 @@ type Fix64Type struct{}
 
 func (*Fix64Type) IsType() {}
 
+func (*Fix64Type) Tag() TypeTag {
+	return TypeTagFix64
+}
+
 func (*Fix64Type) String() string {
 	return "Fix64"
 }
@@ -3099,6 +4323,10 @@ func (*Fix64Type) IsEquatable() bool {
 	return true
 }
 
+func (*Fix64Type) IsComparable() bool {
+	return true
+}
+
 func (*Fix64Type) TypeAnnotationState() TypeAnnotationState {
 	return TypeAnnotationStateValid
 }
@@ -3157,6 +4385,10 @@ type UFix64Type struct{}
 
 func (*UFix64Type) IsType() {}
 
+func (*UFix64Type) Tag() TypeTag {
+	return TypeTagUFix64
+}
+
 func (*UFix64Type) String() string {
 	return "UFix64"
 }
@@ -3190,6 +4422,10 @@ func (*UFix64Type) IsEquatable() bool {
 	return true
 }
 
+func (*UFix64Type) IsComparable() bool {
+	return true
+}
+
 func (*UFix64Type) TypeAnnotationState() TypeAnnotationState {
 	return TypeAnnotationStateValid
 }
@@ -3253,6 +4489,11 @@ const arrayTypeContainsFunctionDocString = `
 Returns true if the given object is in the array
 `
 
+const arrayTypeLastIndexOfFunctionDocString = `
+Returns the index of the last element in the array which is equal to the given object,
+or nil if the object is not in the array
+`
+
 const arrayTypeLengthFieldDocString = `
 Returns the number of elements in the array
 `
@@ -3295,6 +4536,155 @@ Removes the last element from the array and returns it.
 The array must not be empty. If the array is empty, the program aborts
 `
 
+const arrayTypeRemoveAllFunctionDocString = `
+Removes all elements from the array
+`
+
+const arrayTypeEncodeHexFunctionDocString = `
+Returns the hexadecimal string representation of the bytes in the array
+`
+
+const arrayTypeSortFunctionDocString = `
+Sorts the elements of the array in ascending order, in place.
+
+The sort is stable, i.e. equal elements keep their relative order.
+The element type must be comparable, i.e. a number type, String, Character, Address, or Path
+`
+
+const arrayTypeSortedFunctionDocString = `
+Returns a new array containing the elements of the array sorted in ascending order, but does not modify the original array.
+
+The sort is stable, i.e. equal elements keep their relative order.
+The element type must be comparable, i.e. a number type, String, Character, Address, or Path
+`
+
+const arrayTypeWithExactLengthFunctionDocString = `
+Returns a copy of the array, asserting that it has exactly the given length.
+
+The result is still a variable-sized array.
+If the array does not have the given length, the program aborts
+`
+
+const arrayTypeToVariableSizedFunctionDocString = `
+Returns a new variable-sized array containing the elements of this constant-sized array.
+
+The elements are copied in order, the original array is not modified
+`
+
+const arrayTypeMinFunctionDocString = `
+Returns the smallest element of the array, or nil if the array is empty
+`
+
+const arrayTypeMaxFunctionDocString = `
+Returns the largest element of the array, or nil if the array is empty
+`
+
+const arrayTypeFilterFunctionDocString = `
+Returns a new variable-sized array containing the elements of the array for which the given predicate function returns true.
+
+The elements are copied in order, the original array is not modified
+`
+
+const arrayTypeReduceFunctionDocString = `
+Combines all elements of the array into a single value.
+
+The given combine function is called for each element of the array, in order,
+with the accumulated value and the element, and returns the new accumulated value.
+The accumulated value starts with the given initial value.
+Returns the final accumulated value, or the initial value if the array is empty
+`
+
+// arrayTypeSortMemberResolver returns the member resolver
+// for a function that sorts the elements of the array
+// and returns the given result type
+//
+func arrayTypeSortMemberResolver(arrayType ArrayType, resultType Type, docString string) MemberResolver {
+	return MemberResolver{
+		Kind: common.DeclarationKindFunction,
+		Resolve: func(identifier string, targetRange ast.Range, report func(error)) *Member {
+
+			elementType := arrayType.ElementType(false)
+
+			// Resources are not comparable,
+			// but still report the more specific error
+
+			if elementType.IsResourceType() {
+				report(
+					&InvalidResourceArrayMemberError{
+						Name:            identifier,
+						DeclarationKind: common.DeclarationKindFunction,
+						Range:           targetRange,
+					},
+				)
+			} else if !elementType.IsComparable() {
+				report(
+					&NotComparableTypeError{
+						Type:  elementType,
+						Range: targetRange,
+					},
+				)
+			}
+
+			return NewPublicFunctionMember(
+				arrayType,
+				identifier,
+				&FunctionType{
+					ReturnTypeAnnotation: NewTypeAnnotation(resultType),
+				},
+				docString,
+			)
+		},
+	}
+}
+
+// arrayTypeExtremumMemberResolver returns the member resolver
+// for a function that returns the smallest or largest element of the array
+//
+func arrayTypeExtremumMemberResolver(arrayType ArrayType, docString string) MemberResolver {
+	return MemberResolver{
+		Kind: common.DeclarationKindFunction,
+		Resolve: func(identifier string, targetRange ast.Range, report func(error)) *Member {
+
+			elementType := arrayType.ElementType(false)
+
+			// The result is a copy of an element,
+			// so it is impossible for an array of resources
+
+			if elementType.IsResourceType() {
+				report(
+					&InvalidResourceArrayMemberError{
+						Name:            identifier,
+						DeclarationKind: common.DeclarationKindFunction,
+						Range:           targetRange,
+					},
+				)
+			}
+
+			if !elementType.IsComparable() {
+				report(
+					&NotComparableTypeError{
+						Type:  elementType,
+						Range: targetRange,
+					},
+				)
+			}
+
+			return NewPublicFunctionMember(
+				arrayType,
+				identifier,
+				&FunctionType{
+					ReturnTypeAnnotation: NewTypeAnnotation(
+						&OptionalType{
+							Type: elementType,
+						},
+					),
+				},
+				docString,
+			)
+		},
+	}
+}
+
 func getArrayMembers(arrayType ArrayType) map[string]MemberResolver {
 
 	members := map[string]MemberResolver{
@@ -3335,26 +4725,200 @@ func getArrayMembers(arrayType ArrayType) map[string]MemberResolver {
 						Parameters: []*Parameter{
 							{
 								Label:          ArgumentLabelNotRequired,
-								Identifier:     "element",
-								TypeAnnotation: NewTypeAnnotation(elementType),
+								Identifier:     "element",
+								TypeAnnotation: NewTypeAnnotation(elementType),
+							},
+						},
+						ReturnTypeAnnotation: NewTypeAnnotation(
+							&BoolType{},
+						),
+					},
+					arrayTypeContainsFunctionDocString,
+				)
+			},
+		},
+		"lastIndexOf": {
+			Kind: common.DeclarationKindFunction,
+			Resolve: func(identifier string, targetRange ast.Range, report func(error)) *Member {
+
+				elementType := arrayType.ElementType(false)
+
+				// It is impossible for an array of resources to have a `lastIndexOf` function:
+				// if the resource is passed as an argument, it cannot be inside the array
+
+				if elementType.IsResourceType() {
+					report(
+						&InvalidResourceArrayMemberError{
+							Name:            identifier,
+							DeclarationKind: common.DeclarationKindFunction,
+							Range:           targetRange,
+						},
+					)
+				}
+
+				if !elementType.IsEquatable() {
+					report(
+						&NotEquatableTypeError{
+							Type:  elementType,
+							Range: targetRange,
+						},
+					)
+				}
+
+				return NewPublicFunctionMember(
+					arrayType,
+					identifier,
+					&FunctionType{
+						Parameters: []*Parameter{
+							{
+								Identifier:     "of",
+								TypeAnnotation: NewTypeAnnotation(elementType),
+							},
+						},
+						ReturnTypeAnnotation: NewTypeAnnotation(
+							&OptionalType{
+								Type: &IntType{},
+							},
+						),
+					},
+					arrayTypeLastIndexOfFunctionDocString,
+				)
+			},
+		},
+		"length": {
+			Kind: common.DeclarationKindField,
+			Resolve: func(identifier string, _ ast.Range, _ func(error)) *Member {
+				return NewPublicConstantFieldMember(
+					arrayType,
+					identifier,
+					&IntType{},
+					arrayTypeLengthFieldDocString,
+				)
+			},
+		},
+		"min": arrayTypeExtremumMemberResolver(arrayType, arrayTypeMinFunctionDocString),
+		"max": arrayTypeExtremumMemberResolver(arrayType, arrayTypeMaxFunctionDocString),
+		"filter": {
+			Kind: common.DeclarationKindFunction,
+			Resolve: func(identifier string, targetRange ast.Range, report func(error)) *Member {
+
+				// TODO: maybe allow for resource element type
+
+				elementType := arrayType.ElementType(false)
+
+				if elementType.IsResourceType() {
+					report(
+						&InvalidResourceArrayMemberError{
+							Name:            identifier,
+							DeclarationKind: common.DeclarationKindFunction,
+							Range:           targetRange,
+						},
+					)
+				}
+
+				return NewPublicFunctionMember(
+					arrayType,
+					identifier,
+					&FunctionType{
+						Parameters: []*Parameter{
+							{
+								Label:      ArgumentLabelNotRequired,
+								Identifier: "predicate",
+								TypeAnnotation: NewTypeAnnotation(
+									&FunctionType{
+										Parameters: []*Parameter{
+											{
+												Label:          ArgumentLabelNotRequired,
+												Identifier:     "element",
+												TypeAnnotation: NewTypeAnnotation(elementType),
+											},
+										},
+										ReturnTypeAnnotation: NewTypeAnnotation(
+											&BoolType{},
+										),
+									},
+								),
+							},
+						},
+						ReturnTypeAnnotation: NewTypeAnnotation(
+							&VariableSizedType{
+								Type: elementType,
+							},
+						),
+					},
+					arrayTypeFilterFunctionDocString,
+				)
+			},
+		},
+		"reduce": {
+			Kind: common.DeclarationKindFunction,
+			Resolve: func(identifier string, targetRange ast.Range, report func(error)) *Member {
+
+				elementType := arrayType.ElementType(false)
+
+				// The elements are passed to the combine function,
+				// so arrays of resources cannot be supported
+
+				if elementType.IsResourceType() {
+					report(
+						&InvalidResourceArrayMemberError{
+							Name:            identifier,
+							DeclarationKind: common.DeclarationKindFunction,
+							Range:           targetRange,
+						},
+					)
+				}
+
+				typeParameter := &TypeParameter{
+					Name: "U",
+				}
+
+				resultType := &GenericType{
+					TypeParameter: typeParameter,
+				}
+
+				return NewPublicFunctionMember(
+					arrayType,
+					identifier,
+					&FunctionType{
+						TypeParameters: []*TypeParameter{
+							typeParameter,
+						},
+						Parameters: []*Parameter{
+							{
+								Label:          ArgumentLabelNotRequired,
+								Identifier:     "initial",
+								TypeAnnotation: NewTypeAnnotation(resultType),
+							},
+							{
+								Label:      ArgumentLabelNotRequired,
+								Identifier: "combine",
+								TypeAnnotation: NewTypeAnnotation(
+									&FunctionType{
+										Parameters: []*Parameter{
+											{
+												Label:          ArgumentLabelNotRequired,
+												Identifier:     "accumulator",
+												TypeAnnotation: NewTypeAnnotation(resultType),
+											},
+											{
+												Label:          ArgumentLabelNotRequired,
+												Identifier:     "element",
+												TypeAnnotation: NewTypeAnnotation(elementType),
+											},
+										},
+										ReturnTypeAnnotation: NewTypeAnnotation(
+											resultType,
+										),
+									},
+								),
 							},
 						},
 						ReturnTypeAnnotation: NewTypeAnnotation(
-							&BoolType{},
+							resultType,
 						),
 					},
-					arrayTypeContainsFunctionDocString,
-				)
-			},
-		},
-		"length": {
-			Kind: common.DeclarationKindField,
-			Resolve: func(identifier string, _ ast.Range, _ func(error)) *Member {
-				return NewPublicConstantFieldMember(
-					arrayType,
-					identifier,
-					&IntType{},
-					arrayTypeLengthFieldDocString,
+					arrayTypeReduceFunctionDocString,
 				)
 			},
 		},
@@ -3426,6 +4990,43 @@ func getArrayMembers(arrayType ArrayType) map[string]MemberResolver {
 			},
 		}
 
+		members["withExactLength"] = MemberResolver{
+			Kind: common.DeclarationKindFunction,
+			Resolve: func(identifier string, targetRange ast.Range, report func(error)) *Member {
+
+				// The result is a copy of the array,
+				// so arrays of resources cannot be supported
+
+				elementType := arrayType.ElementType(false)
+
+				if elementType.IsResourceType() {
+					report(
+						&InvalidResourceArrayMemberError{
+							Name:            identifier,
+							DeclarationKind: common.DeclarationKindFunction,
+							Range:           targetRange,
+						},
+					)
+				}
+
+				return NewPublicFunctionMember(
+					arrayType,
+					identifier,
+					&FunctionType{
+						Parameters: []*Parameter{
+							{
+								Label:          ArgumentLabelNotRequired,
+								Identifier:     "n",
+								TypeAnnotation: NewTypeAnnotation(&IntType{}),
+							},
+						},
+						ReturnTypeAnnotation: NewTypeAnnotation(arrayType),
+					},
+					arrayTypeWithExactLengthFunctionDocString,
+				)
+			},
+		}
+
 		members["insert"] = MemberResolver{
 			Kind: common.DeclarationKindFunction,
 			Resolve: func(identifier string, _ ast.Range, _ func(error)) *Member {
@@ -3519,6 +5120,109 @@ func getArrayMembers(arrayType ArrayType) map[string]MemberResolver {
 				)
 			},
 		}
+
+		members["sort"] = arrayTypeSortMemberResolver(
+			arrayType,
+			&VoidType{},
+			arrayTypeSortFunctionDocString,
+		)
+
+		members["sorted"] = arrayTypeSortMemberResolver(
+			arrayType,
+			arrayType,
+			arrayTypeSortedFunctionDocString,
+		)
+
+		members["removeAll"] = MemberResolver{
+			Kind: common.DeclarationKindFunction,
+			Resolve: func(identifier string, targetRange ast.Range, report func(error)) *Member {
+
+				// Removing all elements of an array of resources
+				// would lose the resources
+
+				elementType := arrayType.ElementType(false)
+
+				if elementType.IsResourceType() {
+					report(
+						&InvalidResourceArrayMemberError{
+							Name:            identifier,
+							DeclarationKind: common.DeclarationKindFunction,
+							Range:           targetRange,
+						},
+					)
+				}
+
+				return NewPublicFunctionMember(
+					arrayType,
+					identifier,
+					&FunctionType{
+						ReturnTypeAnnotation: NewTypeAnnotation(
+							&VoidType{},
+						),
+					},
+					arrayTypeRemoveAllFunctionDocString,
+				)
+			},
+		}
+
+		// Only byte arrays, i.e. arrays of type `[UInt8]`,
+		// have an `encodeHex` function
+
+		if arrayType.ElementType(false).Equal(&UInt8Type{}) {
+
+			members["encodeHex"] = MemberResolver{
+				Kind: common.DeclarationKindFunction,
+				Resolve: func(identifier string, _ ast.Range, _ func(error)) *Member {
+					return NewPublicFunctionMember(
+						arrayType,
+						identifier,
+						&FunctionType{
+							ReturnTypeAnnotation: NewTypeAnnotation(
+								&StringType{},
+							),
+						},
+						arrayTypeEncodeHexFunctionDocString,
+					)
+				},
+			}
+		}
+	}
+
+	if _, ok := arrayType.(*ConstantSizedType); ok {
+
+		members["toVariableSized"] = MemberResolver{
+			Kind: common.DeclarationKindFunction,
+			Resolve: func(identifier string, targetRange ast.Range, report func(error)) *Member {
+
+				// The result is a copy of the array,
+				// so arrays of resources cannot be supported
+
+				elementType := arrayType.ElementType(false)
+
+				if elementType.IsResourceType() {
+					report(
+						&InvalidResourceArrayMemberError{
+							Name:            identifier,
+							DeclarationKind: common.DeclarationKindFunction,
+							Range:           targetRange,
+						},
+					)
+				}
+
+				return NewPublicFunctionMember(
+					arrayType,
+					identifier,
+					&FunctionType{
+						ReturnTypeAnnotation: NewTypeAnnotation(
+							&VariableSizedType{
+								Type: elementType,
+							},
+						),
+					},
+					arrayTypeToVariableSizedFunctionDocString,
+				)
+			},
+		}
 	}
 
 	return withBuiltinMembers(arrayType, members)
@@ -3531,6 +5235,10 @@ type VariableSizedType struct {
 
 func (*VariableSizedType) IsType() {}
 
+func (*VariableSizedType) Tag() TypeTag {
+	return TypeTagVariableSized
+}
+
 func (*VariableSizedType) isArrayType() {}
 
 func (t *VariableSizedType) String() string {
@@ -3575,6 +5283,10 @@ func (*VariableSizedType) IsEquatable() bool {
 	return false
 }
 
+func (*VariableSizedType) IsComparable() bool {
+	return false
+}
+
 func (t *VariableSizedType) TypeAnnotationState() TypeAnnotationState {
 	return t.Type.TypeAnnotationState()
 }
@@ -3639,6 +5351,10 @@ type ConstantSizedType struct {
 
 func (*ConstantSizedType) IsType() {}
 
+func (*ConstantSizedType) Tag() TypeTag {
+	return TypeTagConstantSized
+}
+
 func (*ConstantSizedType) isArrayType() {}
 
 func (t *ConstantSizedType) String() string {
@@ -3684,6 +5400,10 @@ func (*ConstantSizedType) IsEquatable() bool {
 	return false
 }
 
+func (*ConstantSizedType) IsComparable() bool {
+	return false
+}
+
 func (t *ConstantSizedType) TypeAnnotationState() TypeAnnotationState {
 	return t.Type.TypeAnnotationState()
 }
@@ -3821,7 +5541,15 @@ func (p *Parameter) EffectiveArgumentLabel() string {
 type TypeParameter struct {
 	Name      string
 	TypeBound Type
-	Optional  bool
+	// Constraints are the additional bounds declared in a `where` clause,
+	// e.g. `I1` and `I2` in `<T where T: I1, T: I2>`.
+	// A type argument must be a subtype of the type bound and all constraints
+	Constraints []Type
+	Optional    bool
+	// TypeArgumentCheck is an optional additional check for a type argument.
+	// It is performed before the type bound and the constraints are checked,
+	// so it may report a more specific error than a type mismatch
+	TypeArgumentCheck func(ty Type, typeRange ast.Range) error
 }
 
 func (p TypeParameter) string(typeFormatter func(Type) string) string {
@@ -3831,6 +5559,17 @@ func (p TypeParameter) string(typeFormatter func(Type) string) string {
 		builder.WriteString(": ")
 		builder.WriteString(typeFormatter(p.TypeBound))
 	}
+	if len(p.Constraints) > 0 {
+		builder.WriteString(" where ")
+		for i, constraint := range p.Constraints {
+			if i > 0 {
+				builder.WriteString(", ")
+			}
+			builder.WriteString(p.Name)
+			builder.WriteString(": ")
+			builder.WriteString(typeFormatter(constraint))
+		}
+	}
 	return builder.String()
 }
 
@@ -3863,20 +5602,59 @@ func (p TypeParameter) Equal(other *TypeParameter) bool {
 		}
 	}
 
+	if len(p.Constraints) != len(other.Constraints) {
+		return false
+	}
+
+	for i, constraint := range p.Constraints {
+		if !constraint.Equal(other.Constraints[i]) {
+			return false
+		}
+	}
+
 	return p.Optional == other.Optional
 }
 
+// checkTypeBound checks that the given type satisfies the type bound
+// and all constraints of the type parameter
+//
 func (p TypeParameter) checkTypeBound(ty Type, typeRange ast.Range) error {
-	if p.TypeBound == nil ||
-		p.TypeBound.IsInvalidType() ||
-		ty.IsInvalidType() {
+	if ty.IsInvalidType() {
+		return nil
+	}
+
+	if p.TypeArgumentCheck != nil {
+		err := p.TypeArgumentCheck(ty, typeRange)
+		if err != nil {
+			return err
+		}
+	}
+
+	if p.TypeBound != nil {
+		err := checkTypeParameterBound(ty, p.TypeBound, typeRange)
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, constraint := range p.Constraints {
+		err := checkTypeParameterBound(ty, constraint, typeRange)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
 
+func checkTypeParameterBound(ty Type, bound Type, typeRange ast.Range) error {
+	if bound.IsInvalidType() {
 		return nil
 	}
 
-	if !IsSubType(ty, p.TypeBound) {
+	if !IsSubType(ty, bound) {
 		return &TypeMismatchError{
-			ExpectedType: p.TypeBound,
+			ExpectedType: bound,
 			ActualType:   ty,
 			Range:        typeRange,
 		}
@@ -3891,6 +5669,7 @@ func formatFunctionType(
 	spaces bool,
 	typeParameters []string,
 	parameters []string,
+	requiredArgumentCount *int,
 	returnTypeAnnotation string,
 ) string {
 
@@ -3919,6 +5698,9 @@ func formatFunctionType(
 		}
 		builder.WriteString(parameter)
 	}
+	if requiredArgumentCount != nil {
+		builder.WriteString(fmt.Sprintf(";%d", *requiredArgumentCount))
+	}
 	builder.WriteString("):")
 	if spaces {
 		builder.WriteRune(' ')
@@ -3931,14 +5713,21 @@ func formatFunctionType(
 // FunctionType
 //
 type FunctionType struct {
-	TypeParameters        []*TypeParameter
-	Parameters            []*Parameter
-	ReturnTypeAnnotation  *TypeAnnotation
+	TypeParameters       []*TypeParameter
+	Parameters           []*Parameter
+	ReturnTypeAnnotation *TypeAnnotation
+	// RequiredArgumentCount is the minimum number of arguments an invocation must provide.
+	// If nil, the number of arguments must be exactly the number of parameters.
+	// If set, trailing parameters may be omitted, and additional arguments may be provided
 	RequiredArgumentCount *int
 }
 
 func (*FunctionType) IsType() {}
 
+func (*FunctionType) Tag() TypeTag {
+	return TypeTagFunction
+}
+
 func (t *FunctionType) InvocationFunctionType() *FunctionType {
 	return t
 }
@@ -3967,6 +5756,7 @@ func (t *FunctionType) String() string {
 		true,
 		typeParameters,
 		parameters,
+		nil,
 		returnTypeAnnotation,
 	)
 }
@@ -3991,11 +5781,13 @@ func (t *FunctionType) QualifiedString() string {
 		true,
 		typeParameters,
 		parameters,
+		nil,
 		returnTypeAnnotation,
 	)
 }
 
 // NOTE: parameter names and argument labels are *not* part of the ID!
+// The required argument count is, as it is considered in Equal
 func (t *FunctionType) ID() TypeID {
 	typeParameters := make([]string, len(t.TypeParameters))
 
@@ -4016,6 +5808,7 @@ func (t *FunctionType) ID() TypeID {
 			false,
 			typeParameters,
 			parameters,
+			t.RequiredArgumentCount,
 			returnTypeAnnotation,
 		),
 	)
@@ -4054,18 +5847,41 @@ func (t *FunctionType) Equal(other Type) bool {
 		}
 	}
 
+	// required argument count
+
+	if !haveSameRequiredArgumentCount(t.RequiredArgumentCount, otherFunction.RequiredArgumentCount) {
+		return false
+	}
+
 	// return type
 
 	return t.ReturnTypeAnnotation.Equal(otherFunction.ReturnTypeAnnotation)
 }
 
+// haveSameRequiredArgumentCount returns true if the given required argument counts are equal.
+// A function type without a required argument count requires all arguments,
+// so it is not equal to a function type which has a required argument count,
+// even if the count is the number of parameters, as the latter also accepts additional arguments
+//
+func haveSameRequiredArgumentCount(count, otherCount *int) bool {
+	if count == nil || otherCount == nil {
+		return count == nil && otherCount == nil
+	}
+
+	return *count == *otherCount
+}
+
 func (t *FunctionType) HasSameArgumentLabels(other *FunctionType) bool {
-	if len(t.Parameters) != len(other.Parameters) {
+	return haveSameArgumentLabels(t.Parameters, other.Parameters)
+}
+
+func haveSameArgumentLabels(parameters, otherParameters []*Parameter) bool {
+	if len(parameters) != len(otherParameters) {
 		return false
 	}
 
-	for i, parameter := range t.Parameters {
-		otherParameter := other.Parameters[i]
+	for i, parameter := range parameters {
+		otherParameter := otherParameters[i]
 		if parameter.EffectiveArgumentLabel() != otherParameter.EffectiveArgumentLabel() {
 			return false
 		}
@@ -4087,6 +5903,12 @@ func (t *FunctionType) IsInvalidType() bool {
 
 			return true
 		}
+
+		for _, constraint := range typeParameter.Constraints {
+			if constraint.IsInvalidType() {
+				return true
+			}
+		}
 	}
 
 	for _, parameter := range t.Parameters {
@@ -4107,6 +5929,10 @@ func (*FunctionType) IsEquatable() bool {
 	return false
 }
 
+func (*FunctionType) IsComparable() bool {
+	return false
+}
+
 func (t *FunctionType) TypeAnnotationState() TypeAnnotationState {
 
 	for _, typeParameter := range t.TypeParameters {
@@ -4134,17 +5960,44 @@ func (t *FunctionType) TypeAnnotationState() TypeAnnotationState {
 func (t *FunctionType) RewriteWithRestrictedTypes() (Type, bool) {
 	anyRewritten := false
 
-	rewrittenTypeParameterTypeBounds := map[*TypeParameter]Type{}
+	rewrittenTypeParameters := map[*TypeParameter]*TypeParameter{}
 
 	for _, typeParameter := range t.TypeParameters {
-		if typeParameter.TypeBound == nil {
-			continue
+
+		typeParameterRewritten := false
+
+		rewrittenTypeBound := typeParameter.TypeBound
+		if rewrittenTypeBound != nil {
+			var rewritten bool
+			rewrittenTypeBound, rewritten = rewrittenTypeBound.RewriteWithRestrictedTypes()
+			if rewritten {
+				typeParameterRewritten = true
+			}
 		}
 
-		rewrittenType, rewritten := typeParameter.TypeBound.RewriteWithRestrictedTypes()
-		if rewritten {
+		// Rewrite the constraints the same way as the type bound
+
+		var rewrittenConstraints []Type
+		if len(typeParameter.Constraints) > 0 {
+			rewrittenConstraints = make([]Type, len(typeParameter.Constraints))
+			for i, constraint := range typeParameter.Constraints {
+				rewrittenConstraint, rewritten := constraint.RewriteWithRestrictedTypes()
+				if rewritten {
+					typeParameterRewritten = true
+				}
+				rewrittenConstraints[i] = rewrittenConstraint
+			}
+		}
+
+		if typeParameterRewritten {
 			anyRewritten = true
-			rewrittenTypeParameterTypeBounds[typeParameter] = rewrittenType
+			rewrittenTypeParameters[typeParameter] = &TypeParameter{
+				Name:              typeParameter.Name,
+				TypeBound:         rewrittenTypeBound,
+				Constraints:       rewrittenConstraints,
+				Optional:          typeParameter.Optional,
+				TypeArgumentCheck: typeParameter.TypeArgumentCheck,
+			}
 		}
 	}
 
@@ -4164,19 +6017,15 @@ func (t *FunctionType) RewriteWithRestrictedTypes() (Type, bool) {
 	}
 
 	if anyRewritten {
-		var rewrittenTypeParameters []*TypeParameter
+		var typeParameters []*TypeParameter
 		if len(t.TypeParameters) > 0 {
-			rewrittenTypeParameters = make([]*TypeParameter, len(t.TypeParameters))
+			typeParameters = make([]*TypeParameter, len(t.TypeParameters))
 			for i, typeParameter := range t.TypeParameters {
-				rewrittenTypeBound, ok := rewrittenTypeParameterTypeBounds[typeParameter]
+				rewrittenTypeParameter, ok := rewrittenTypeParameters[typeParameter]
 				if ok {
-					rewrittenTypeParameters[i] = &TypeParameter{
-						Name:      typeParameter.Name,
-						TypeBound: rewrittenTypeBound,
-						Optional:  typeParameter.Optional,
-					}
+					typeParameters[i] = rewrittenTypeParameter
 				} else {
-					rewrittenTypeParameters[i] = typeParameter
+					typeParameters[i] = typeParameter
 				}
 			}
 		}
@@ -4199,7 +6048,7 @@ func (t *FunctionType) RewriteWithRestrictedTypes() (Type, bool) {
 		}
 
 		return &FunctionType{
-			TypeParameters:        rewrittenTypeParameters,
+			TypeParameters:        typeParameters,
 			Parameters:            rewrittenParameters,
 			ReturnTypeAnnotation:  NewTypeAnnotation(rewrittenReturnType),
 			RequiredArgumentCount: t.RequiredArgumentCount,
@@ -4327,15 +6176,24 @@ func (t *FunctionType) GetMembers() map[string]MemberResolver {
 type SpecialFunctionType struct {
 	*FunctionType
 	Members map[string]*Member
+	// Overloads are the function types of all overloads, in declaration order,
+	// if the special function is overloaded, e.g. a constructor
+	// of a composite which declares multiple initializers.
+	// The embedded function type is the type of the first overload
+	Overloads []*FunctionType
 }
 
 func (t *SpecialFunctionType) GetMembers() map[string]MemberResolver {
+	return withBuiltinMembers(t, memberResolvers(t.Members))
+}
+
+func memberResolvers(members map[string]*Member) map[string]MemberResolver {
 	// TODO: optimize
-	members := make(map[string]MemberResolver, len(t.Members))
-	for name, loopMember := range t.Members {
+	resolvers := make(map[string]MemberResolver, len(members))
+	for name, loopMember := range members {
 		// NOTE: don't capture loop variable
 		member := loopMember
-		members[name] = MemberResolver{
+		resolvers[name] = MemberResolver{
 			Kind: member.DeclarationKind,
 			Resolve: func(_ string, _ ast.Range, _ func(error)) *Member {
 				return member
@@ -4343,7 +6201,7 @@ func (t *SpecialFunctionType) GetMembers() map[string]MemberResolver {
 		}
 	}
 
-	return withBuiltinMembers(t, members)
+	return resolvers
 }
 
 // CheckedFunctionType is the the type representing a function that checks the arguments,
@@ -4358,6 +6216,11 @@ type ArgumentExpressionsCheck func(
 type CheckedFunctionType struct {
 	*FunctionType
 	ArgumentExpressionsCheck ArgumentExpressionsCheck
+	Members                  map[string]*Member
+}
+
+func (t *CheckedFunctionType) GetMembers() map[string]MemberResolver {
+	return withBuiltinMembers(t, memberResolvers(t.Members))
 }
 
 func (t *CheckedFunctionType) CheckArgumentExpressions(
@@ -4523,21 +6386,37 @@ func init() {
 				panic(errors.NewUnreachableError())
 			}
 
-			BaseValues[typeName] = baseFunction{
-				name: typeName,
-				invokableType: &CheckedFunctionType{
-					FunctionType: &FunctionType{
-						Parameters: []*Parameter{
-							{
-								Label:          ArgumentLabelNotRequired,
-								Identifier:     "value",
-								TypeAnnotation: NewTypeAnnotation(&NumberType{}),
-							},
+			functionType := &CheckedFunctionType{
+				FunctionType: &FunctionType{
+					Parameters: []*Parameter{
+						{
+							Label:          ArgumentLabelNotRequired,
+							Identifier:     "value",
+							TypeAnnotation: NewTypeAnnotation(&NumberType{}),
 						},
-						ReturnTypeAnnotation: &TypeAnnotation{Type: numberType},
 					},
-					ArgumentExpressionsCheck: numberFunctionArgumentExpressionsChecker(numberType),
+					ReturnTypeAnnotation: &TypeAnnotation{Type: numberType},
 				},
+				ArgumentExpressionsCheck: numberFunctionArgumentExpressionsChecker(numberType),
+			}
+
+			// Integer conversion functions have a `fromBigEndianBytes` function,
+			// e.g. `UInt64.fromBigEndianBytes([0, 0, 0, 0, 0, 0, 0, 1])`
+
+			if IsSubType(numberType, &IntegerType{}) {
+				functionType.Members = map[string]*Member{
+					NumberTypeFromBigEndianBytesFunctionName: NewPublicFunctionMember(
+						functionType,
+						NumberTypeFromBigEndianBytesFunctionName,
+						numberTypeFromBigEndianBytesFunctionType(numberType),
+						numberTypeFromBigEndianBytesFunctionDocString,
+					),
+				}
+			}
+
+			BaseValues[typeName] = baseFunction{
+				name:          typeName,
+				invokableType: functionType,
 			}
 		}
 	}
@@ -4563,21 +6442,93 @@ func init() {
 						TypeAnnotation: NewTypeAnnotation(&IntegerType{}),
 					},
 				},
-				ReturnTypeAnnotation: &TypeAnnotation{Type: addressType},
+				ReturnTypeAnnotation: &TypeAnnotation{Type: addressType},
+			},
+			ArgumentExpressionsCheck: func(checker *Checker, argumentExpressions []ast.Expression, _ ast.Range) {
+				if len(argumentExpressions) < 1 {
+					return
+				}
+
+				intExpression, ok := argumentExpressions[0].(*ast.IntegerExpression)
+				if !ok {
+					return
+				}
+
+				checker.checkAddressLiteral(intExpression)
+			},
+		},
+	}
+}
+
+// PathConstructorFunctionNames are the names of the base functions
+// which construct a path of the respective domain from an identifier,
+// e.g. `PublicPath(identifier: "foo")`
+//
+var PathConstructorFunctionNames = map[common.PathDomain]string{
+	common.PathDomainStorage: "StoragePath",
+	common.PathDomainPrivate: "PrivatePath",
+	common.PathDomainPublic:  "PublicPath",
+}
+
+const PathConstructorIdentifierParameterName = "identifier"
+
+func init() {
+	for _, functionName := range PathConstructorFunctionNames {
+
+		// check function is not accidentally redeclared
+		if _, ok := BaseValues[functionName]; ok {
+			panic(errors.NewUnreachableError())
+		}
+
+		BaseValues[functionName] = baseFunction{
+			name: functionName,
+			invokableType: &CheckedFunctionType{
+				FunctionType: &FunctionType{
+					Parameters: []*Parameter{
+						{
+							Identifier:     PathConstructorIdentifierParameterName,
+							TypeAnnotation: NewTypeAnnotation(&StringType{}),
+						},
+					},
+					ReturnTypeAnnotation: NewTypeAnnotation(
+						&OptionalType{
+							Type: &PathType{},
+						},
+					),
+				},
+				ArgumentExpressionsCheck: pathConstructorArgumentExpressionsCheck,
 			},
-			ArgumentExpressionsCheck: func(checker *Checker, argumentExpressions []ast.Expression, _ ast.Range) {
-				if len(argumentExpressions) < 1 {
-					return
-				}
+			argumentLabels: []string{
+				PathConstructorIdentifierParameterName,
+			},
+		}
+	}
+}
 
-				intExpression, ok := argumentExpressions[0].(*ast.IntegerExpression)
-				if !ok {
-					return
-				}
+// pathConstructorArgumentExpressionsCheck checks that the identifier argument
+// of a path constructor function is a valid path identifier, if it is a string literal
+//
+func pathConstructorArgumentExpressionsCheck(
+	checker *Checker,
+	argumentExpressions []ast.Expression,
+	_ ast.Range,
+) {
+	if len(argumentExpressions) < 1 {
+		return
+	}
 
-				checker.checkAddressLiteral(intExpression)
+	stringExpression, ok := argumentExpressions[0].(*ast.StringExpression)
+	if !ok {
+		return
+	}
+
+	if !IsValidPathIdentifier(stringExpression.Value) {
+		checker.report(
+			&InvalidPathIdentifierError{
+				Identifier: stringExpression.Value,
+				Range:      ast.NewRangeFromPositioned(stringExpression),
 			},
-		},
+		)
 	}
 }
 
@@ -4745,11 +6696,22 @@ type CompositeType struct {
 	ExplicitInterfaceConformances       []*InterfaceType
 	ImplicitTypeRequirementConformances []*CompositeType
 	Members                             map[string]*Member
-	Fields                              []string
-	// TODO: add support for overloaded initializers
+	// Fields are the names of the fields, in a stable order:
+	// the predeclared fields (e.g. `uuid` of resources, `rawValue` of enums) come first,
+	// followed by the declared fields in source order.
+	// For events, the fields are the parameters of the initializer, in order
+	Fields []string
+	// ConstructorParameters are the parameters of the first initializer
 	ConstructorParameters []*Parameter
-	nestedTypes           map[string]Type
-	ContainerType         Type
+	// ConstructorParameterLists are the parameters of all initializers,
+	// in declaration order. Only structures and resources may declare
+	// more than one initializer
+	ConstructorParameterLists [][]*Parameter
+	// EnumRawType is the raw type of an enum,
+	// i.e. the type of the `rawValue` field of its cases
+	EnumRawType   Type
+	nestedTypes   map[string]Type
+	ContainerType Type
 }
 
 func (t *CompositeType) ExplicitInterfaceConformanceSet() InterfaceSet {
@@ -4766,6 +6728,40 @@ func (t *CompositeType) ExplicitInterfaceConformanceSet() InterfaceSet {
 	return t.explicitInterfaceConformanceSet
 }
 
+// Conformances returns all interfaces the composite type conforms to:
+// first the explicitly declared conformances, in declaration order,
+// followed by the interface types of the type requirements
+// the composite type implicitly conforms to.
+//
+// The result is deterministic and contains no duplicates
+//
+func (t *CompositeType) Conformances() []*InterfaceType {
+	conformanceCount := len(t.ExplicitInterfaceConformances) +
+		len(t.ImplicitTypeRequirementConformances)
+
+	conformances := make([]*InterfaceType, 0, conformanceCount)
+	seen := make(map[TypeID]struct{}, conformanceCount)
+
+	add := func(interfaceType *InterfaceType) {
+		id := interfaceType.ID()
+		if _, ok := seen[id]; ok {
+			return
+		}
+		seen[id] = struct{}{}
+		conformances = append(conformances, interfaceType)
+	}
+
+	for _, conformance := range t.ExplicitInterfaceConformances {
+		add(conformance)
+	}
+
+	for _, typeRequirement := range t.ImplicitTypeRequirementConformances {
+		add(typeRequirement.InterfaceType())
+	}
+
+	return conformances
+}
+
 func (t *CompositeType) AddImplicitTypeRequirementConformance(typeRequirement *CompositeType) {
 	t.ImplicitTypeRequirementConformances =
 		append(t.ImplicitTypeRequirementConformances, typeRequirement)
@@ -4773,6 +6769,10 @@ func (t *CompositeType) AddImplicitTypeRequirementConformance(typeRequirement *C
 
 func (*CompositeType) IsType() {}
 
+func (*CompositeType) Tag() TypeTag {
+	return TypeTagComposite
+}
+
 func (t *CompositeType) String() string {
 	return t.Identifier
 }
@@ -4864,8 +6864,13 @@ func (t *CompositeType) IsStorable(results map[*Member]bool) bool {
 	return true
 }
 
-func (*CompositeType) IsEquatable() bool {
-	// TODO:
+func (t *CompositeType) IsEquatable() bool {
+	// TODO: add support for more composite kinds.
+	//   Enum cases are equal if they have the same raw value
+	return t.Kind == common.CompositeKindEnum
+}
+
+func (*CompositeType) IsComparable() bool {
 	return false
 }
 
@@ -4922,6 +6927,47 @@ func (t *CompositeType) NestedTypes() map[string]Type {
 	return t.nestedTypes
 }
 
+// FieldType returns the type of the field with the given name,
+// and true if the composite type has a field with the given name.
+// It returns false if there is no such member, or if the member is not a field,
+// e.g. a function
+//
+func (t *CompositeType) FieldType(name string) (Type, bool) {
+	return membersFieldType(t.Members, name)
+}
+
+// FunctionType returns the type of the function with the given name,
+// and true if the composite type has a function with the given name.
+// It returns false if there is no such member, or if the member is not a function,
+// e.g. a field
+//
+func (t *CompositeType) FunctionType(name string) (*FunctionType, bool) {
+	return membersFunctionType(t.Members, name)
+}
+
+// membersFieldType returns the type of the field member with the given name
+//
+func membersFieldType(members map[string]*Member, name string) (Type, bool) {
+	member, ok := members[name]
+	if !ok || member.DeclarationKind != common.DeclarationKindField {
+		return nil, false
+	}
+
+	return member.TypeAnnotation.Type, true
+}
+
+// membersFunctionType returns the type of the function member with the given name
+//
+func membersFunctionType(members map[string]*Member, name string) (*FunctionType, bool) {
+	member, ok := members[name]
+	if !ok || member.DeclarationKind != common.DeclarationKindFunction {
+		return nil, false
+	}
+
+	functionType, ok := member.TypeAnnotation.Type.(*FunctionType)
+	return functionType, ok
+}
+
 // AuthAccountType represents the authorized access to an account.
 // Access to an AuthAccount means having full access to its storage, public keys, and code.
 // Only signed transactions can get the AuthAccount for an account.
@@ -4930,6 +6976,10 @@ type AuthAccountType struct{}
 
 func (*AuthAccountType) IsType() {}
 
+func (*AuthAccountType) Tag() TypeTag {
+	return TypeTagAuthAccount
+}
+
 func (*AuthAccountType) String() string {
 	return "AuthAccount"
 }
@@ -4963,6 +7013,10 @@ func (*AuthAccountType) IsEquatable() bool {
 	return false
 }
 
+func (*AuthAccountType) IsComparable() bool {
+	return false
+}
+
 func (*AuthAccountType) TypeAnnotationState() TypeAnnotationState {
 	return TypeAnnotationStateValid
 }
@@ -5083,6 +7137,64 @@ If there is already an object stored under the given path, the program aborts.
 The path must be a storage path, i.e., only the domain ` + "`storage`" + ` is allowed
 `
 
+var authAccountTypeTrySaveFunctionType = func() *FunctionType {
+
+	typeParameter := &TypeParameter{
+		Name:              "T",
+		TypeBound:         &StorableType{},
+		TypeArgumentCheck: checkTrySaveType,
+	}
+
+	return &FunctionType{
+		TypeParameters: []*TypeParameter{
+			typeParameter,
+		},
+		Parameters: []*Parameter{
+			{
+				Label:      ArgumentLabelNotRequired,
+				Identifier: "value",
+				TypeAnnotation: NewTypeAnnotation(
+					&GenericType{
+						TypeParameter: typeParameter,
+					},
+				),
+			},
+			{
+				Label:          "to",
+				Identifier:     "path",
+				TypeAnnotation: NewTypeAnnotation(&PathType{}),
+			},
+		},
+		ReturnTypeAnnotation: NewTypeAnnotation(&BoolType{}),
+	}
+}()
+
+// checkTrySaveType checks that the given type of the value to save is not a resource type,
+// as the resource would be lost if it cannot be saved
+//
+func checkTrySaveType(ty Type, typeRange ast.Range) error {
+	if ty.IsResourceType() {
+		return &InvalidResourceTrySaveError{
+			Type:  ty,
+			Range: typeRange,
+		}
+	}
+
+	return nil
+}
+
+const authAccountTypeTrySaveFunctionDocString = `
+Saves the given structure into the account's storage at the given path, if no object is stored under the given path yet.
+The structure is copied.
+
+Returns true if the structure was saved, or false if there is already an object stored under the given path.
+In the latter case, the stored object is left unchanged.
+
+Resources cannot be saved using this function, as they would be lost if they cannot be saved.
+
+The path must be a storage path, i.e., only the domain ` + "`storage`" + ` is allowed
+`
+
 var authAccountTypeLoadFunctionType = func() *FunctionType {
 
 	typeParameter := &TypeParameter{
@@ -5125,11 +7237,26 @@ The given type must not necessarily be exactly the same as the type of the loade
 The path must be a storage path, i.e., only the domain ` + "`storage`" + ` is allowed
 `
 
+const authAccountTypeLoadStrictFunctionDocString = `
+Loads an object from the account's storage which is stored under the given path, or nil if no object is stored under the given path.
+
+If there is an object stored, the stored resource or structure is moved out of storage and returned as an optional.
+
+When the function returns, the storage no longer contains an object under the given path.
+
+The given type must be a supertype of the type of the loaded object.
+If it is not, the program aborts, and the object stays stored.
+The given type must not necessarily be exactly the same as the type of the loaded object.
+
+The path must be a storage path, i.e., only the domain ` + "`storage`" + ` is allowed
+`
+
 var authAccountTypeCopyFunctionType = func() *FunctionType {
 
 	typeParameter := &TypeParameter{
-		Name:      "T",
-		TypeBound: &AnyStructType{},
+		Name:              "T",
+		TypeBound:         &AnyStructType{},
+		TypeArgumentCheck: checkCopyType,
 	}
 
 	return &FunctionType{
@@ -5153,6 +7280,20 @@ var authAccountTypeCopyFunctionType = func() *FunctionType {
 	}
 }()
 
+// checkCopyType checks that the given copy type is not a resource type,
+// as resources can never be copied
+//
+func checkCopyType(ty Type, typeRange ast.Range) error {
+	if ty.IsResourceType() {
+		return &InvalidResourceCopyError{
+			Type:  ty,
+			Range: typeRange,
+		}
+	}
+
+	return nil
+}
+
 const authAccountTypeCopyFunctionDocString = `
 Returns a copy of a structure stored in account storage under the given path, without removing it from storage, or nil if no object is stored under the given path.
 
@@ -5166,13 +7307,80 @@ The given type must not necessarily be exactly the same as the type of the copie
 The path must be a storage path, i.e., only the domain ` + "`storage`" + ` is allowed
 `
 
+var authAccountTypeTypeFunctionType = &FunctionType{
+	Parameters: []*Parameter{
+		{
+			Label:          "at",
+			Identifier:     "path",
+			TypeAnnotation: NewTypeAnnotation(&PathType{}),
+		},
+	},
+	ReturnTypeAnnotation: NewTypeAnnotation(
+		&OptionalType{
+			Type: &MetaType{},
+		},
+	),
+}
+
+const authAccountTypeTypeFunctionDocString = `
+Returns the run-time type of the object stored in account storage under the given path, or nil if no object is stored under the given path.
+
+The object stays stored in storage after the function returns.
+
+The path must be a storage path, i.e., only the domain ` + "`storage`" + ` is allowed
+`
+
+// AuthAccountForEachStoredFunctionName is the name of the function `AuthAccount.forEachStored`.
+//
+// The function is only available if storage iteration is enabled in the checker,
+// see `WithStorageIteration`
+//
+const AuthAccountForEachStoredFunctionName = "forEachStored"
+
+var authAccountTypeForEachStoredFunctionType = &FunctionType{
+	Parameters: []*Parameter{
+		{
+			Label:      ArgumentLabelNotRequired,
+			Identifier: "function",
+			TypeAnnotation: NewTypeAnnotation(
+				&FunctionType{
+					Parameters: []*Parameter{
+						{
+							Label:          ArgumentLabelNotRequired,
+							Identifier:     "path",
+							TypeAnnotation: NewTypeAnnotation(&PathType{}),
+						},
+						{
+							Label:          ArgumentLabelNotRequired,
+							Identifier:     "type",
+							TypeAnnotation: NewTypeAnnotation(&MetaType{}),
+						},
+					},
+					ReturnTypeAnnotation: NewTypeAnnotation(&BoolType{}),
+				},
+			),
+		},
+	},
+	ReturnTypeAnnotation: NewTypeAnnotation(&VoidType{}),
+}
+
+const authAccountTypeForEachStoredFunctionDocString = `
+Calls the given function for each object stored in account storage,
+with the storage path and the run-time type of the object.
+
+Iteration stops when the function returns false.
+
+The order of iteration is undefined
+`
+
 var authAccountTypeBorrowFunctionType = func() *FunctionType {
 
 	typeParameter := &TypeParameter{
 		TypeBound: &ReferenceType{
 			Type: &AnyType{},
 		},
-		Name: "T",
+		Name:              "T",
+		TypeArgumentCheck: checkBorrowType,
 	}
 
 	return &FunctionType{
@@ -5196,6 +7404,44 @@ var authAccountTypeBorrowFunctionType = func() *FunctionType {
 	}
 }()
 
+// checkBorrowType checks that the given borrow type is a reference
+// to a type of which a value could be stored, i.e. not a function
+// and not `Never`, as such a borrow could never succeed
+//
+func checkBorrowType(ty Type, typeRange ast.Range) error {
+	err := checkCapabilityLinkType(ty, typeRange)
+	if err != nil {
+		return err
+	}
+
+	referenceType := ty.(*ReferenceType)
+
+	switch referenceType.Type.(type) {
+	case *FunctionType, *NeverType:
+		return &InvalidBorrowTypeError{
+			Type:  ty,
+			Range: typeRange,
+		}
+	}
+
+	return nil
+}
+
+// checkCapabilityLinkType checks that the given type argument
+// of a function which links, obtains, or borrows a capability
+// or stored object is a reference type
+//
+func checkCapabilityLinkType(ty Type, typeRange ast.Range) error {
+	if _, ok := ty.(*ReferenceType); !ok {
+		return &InvalidCapabilityLinkTypeError{
+			Type:  ty,
+			Range: typeRange,
+		}
+	}
+
+	return nil
+}
+
 const authAccountTypeBorrowFunctionDocString = `
 Returns a reference to an object in storage without removing it from storage.
 
@@ -5217,7 +7463,8 @@ var authAccountTypeLinkFunctionType = func() *FunctionType {
 		TypeBound: &ReferenceType{
 			Type: &AnyType{},
 		},
-		Name: "T",
+		Name:              "T",
+		TypeArgumentCheck: checkCapabilityLinkType,
 	}
 
 	return &FunctionType{
@@ -5275,14 +7522,33 @@ const authAccountTypeUnlinkFunctionDocString = `
 Removes the capability at the given public or private path
 `
 
-var accountTypeGetCapabilityFunctionType = func() *FunctionType {
+// newAccountTypeGetCapabilityFunctionType returns the type of a function
+// which returns the capability at a given path.
+//
+// If optional is true, the type argument and the result are optional,
+// as for the deprecated function `getCapability`
+//
+func newAccountTypeGetCapabilityFunctionType(optional bool) *FunctionType {
 
 	typeParameter := &TypeParameter{
 		TypeBound: &ReferenceType{
 			Type: &AnyType{},
 		},
-		Name:     "T",
-		Optional: true,
+		Name:              "T",
+		Optional:          optional,
+		TypeArgumentCheck: checkCapabilityLinkType,
+	}
+
+	var returnType Type = &CapabilityType{
+		BorrowType: &GenericType{
+			TypeParameter: typeParameter,
+		},
+	}
+
+	if optional {
+		returnType = &OptionalType{
+			Type: returnType,
+		}
 	}
 
 	return &FunctionType{
@@ -5296,20 +7562,25 @@ var accountTypeGetCapabilityFunctionType = func() *FunctionType {
 				TypeAnnotation: NewTypeAnnotation(&PathType{}),
 			},
 		},
-		ReturnTypeAnnotation: NewTypeAnnotation(
-			&OptionalType{
-				Type: &CapabilityType{
-					BorrowType: &GenericType{
-						TypeParameter: typeParameter,
-					},
-				},
-			},
-		),
+		ReturnTypeAnnotation: NewTypeAnnotation(returnType),
 	}
-}()
+}
+
+var accountTypeGetCapabilityFunctionType = newAccountTypeGetCapabilityFunctionType(true)
+
+var accountTypeCapabilityFunctionType = newAccountTypeGetCapabilityFunctionType(false)
 
 const authAccountTypeGetCapabilityFunctionDocString = `
-Returns the capability at the given private or public path, or nil if it does not exist
+Returns the capability at the given private or public path, or nil if it does not exist.
+
+Deprecated: use the function ` + "`capability`" + `, which returns a non-optional capability
+`
+
+const authAccountTypeCapabilityFunctionDocString = `
+Returns the capability at the given private or public path.
+
+The capability is returned even if no object is linked at the path, as links are latent.
+The given type is the type the capability can be borrowed as
 `
 
 var accountTypeGetLinkTargetFunctionType = &FunctionType{
@@ -5398,32 +7669,79 @@ func (t *AuthAccountType) GetMembers() map[string]MemberResolver {
 				return NewPublicFunctionMember(
 					t,
 					identifier,
-					authAccountTypeSaveFunctionType,
-					authAccountTypeSaveFunctionDocString,
+					authAccountTypeSaveFunctionType,
+					authAccountTypeSaveFunctionDocString,
+				)
+			},
+		},
+		"trySave": {
+			Kind: common.DeclarationKindFunction,
+			Resolve: func(identifier string, _ ast.Range, _ func(error)) *Member {
+				return NewPublicFunctionMember(
+					t,
+					identifier,
+					authAccountTypeTrySaveFunctionType,
+					authAccountTypeTrySaveFunctionDocString,
+				)
+			},
+		},
+		"load": {
+			Kind: common.DeclarationKindFunction,
+			Resolve: func(identifier string, _ ast.Range, _ func(error)) *Member {
+				return NewPublicFunctionMember(
+					t,
+					identifier,
+					authAccountTypeLoadFunctionType,
+					authAccountTypeLoadFunctionDocString,
+				)
+			},
+		},
+		"loadStrict": {
+			Kind: common.DeclarationKindFunction,
+			Resolve: func(identifier string, _ ast.Range, _ func(error)) *Member {
+				return NewPublicFunctionMember(
+					t,
+					identifier,
+					authAccountTypeLoadFunctionType,
+					authAccountTypeLoadStrictFunctionDocString,
+				)
+			},
+		},
+		"copy": {
+			Kind: common.DeclarationKindFunction,
+			Resolve: func(identifier string, _ ast.Range, _ func(error)) *Member {
+				return NewPublicFunctionMember(
+					t,
+					identifier,
+					authAccountTypeCopyFunctionType,
+					authAccountTypeCopyFunctionDocString,
 				)
 			},
 		},
-		"load": {
+		"type": {
 			Kind: common.DeclarationKindFunction,
 			Resolve: func(identifier string, _ ast.Range, _ func(error)) *Member {
 				return NewPublicFunctionMember(
 					t,
 					identifier,
-					authAccountTypeLoadFunctionType,
-					authAccountTypeLoadFunctionDocString,
+					authAccountTypeTypeFunctionType,
+					authAccountTypeTypeFunctionDocString,
 				)
 			},
 		},
-		"copy": {
+		AuthAccountForEachStoredFunctionName: {
 			Kind: common.DeclarationKindFunction,
 			Resolve: func(identifier string, _ ast.Range, _ func(error)) *Member {
 				return NewPublicFunctionMember(
 					t,
 					identifier,
-					authAccountTypeCopyFunctionType,
-					authAccountTypeCopyFunctionDocString,
+					authAccountTypeForEachStoredFunctionType,
+					authAccountTypeForEachStoredFunctionDocString,
 				)
 			},
+			Available: func(checker *Checker) bool {
+				return checker.storageIteration
+			},
 		},
 		"borrow": {
 			Kind: common.DeclarationKindFunction,
@@ -5469,6 +7787,17 @@ func (t *AuthAccountType) GetMembers() map[string]MemberResolver {
 				)
 			},
 		},
+		"capability": {
+			Kind: common.DeclarationKindFunction,
+			Resolve: func(identifier string, _ ast.Range, _ func(error)) *Member {
+				return NewPublicFunctionMember(
+					t,
+					identifier,
+					accountTypeCapabilityFunctionType,
+					authAccountTypeCapabilityFunctionDocString,
+				)
+			},
+		},
 		"getLinkTarget": {
 			Kind: common.DeclarationKindFunction,
 			Resolve: func(identifier string, _ ast.Range, _ func(error)) *Member {
@@ -5497,6 +7826,10 @@ type PublicAccountType struct{}
 
 func (*PublicAccountType) IsType() {}
 
+func (*PublicAccountType) Tag() TypeTag {
+	return TypeTagPublicAccount
+}
+
 func (*PublicAccountType) String() string {
 	return "PublicAccount"
 }
@@ -5530,6 +7863,10 @@ func (*PublicAccountType) IsEquatable() bool {
 	return false
 }
 
+func (*PublicAccountType) IsComparable() bool {
+	return false
+}
+
 func (*PublicAccountType) TypeAnnotationState() TypeAnnotationState {
 	return TypeAnnotationStateValid
 }
@@ -5542,6 +7879,13 @@ const publicAccountTypeGetLinkTargetFunctionDocString = `
 Returns the capability at the given public path, or nil if it does not exist
 `
 
+const publicAccountTypeCapabilityFunctionDocString = `
+Returns the capability at the given public path.
+
+The capability is returned even if no object is linked at the path, as links are latent.
+The given type is the type the capability can be borrowed as
+`
+
 func (t *PublicAccountType) GetMembers() map[string]MemberResolver {
 	return withBuiltinMembers(t, map[string]MemberResolver{
 		"address": {
@@ -5566,6 +7910,17 @@ func (t *PublicAccountType) GetMembers() map[string]MemberResolver {
 				)
 			},
 		},
+		"capability": {
+			Kind: common.DeclarationKindFunction,
+			Resolve: func(identifier string, _ ast.Range, _ func(error)) *Member {
+				return NewPublicFunctionMember(
+					t,
+					identifier,
+					accountTypeCapabilityFunctionType,
+					publicAccountTypeCapabilityFunctionDocString,
+				)
+			},
+		},
 		"getLinkTarget": {
 			Kind: common.DeclarationKindFunction,
 			Resolve: func(identifier string, _ ast.Range, _ func(error)) *Member {
@@ -5641,7 +7996,14 @@ func NewPublicConstantFieldMember(
 	}
 }
 
-// IsStorable returns whether a member is a storable field
+// IsStorable returns whether a member is a storable field.
+//
+// The given results are used to break cycles, e.g. for a composite
+// which has a field of its own type. While a member is being checked,
+// it is temporarily assumed to be storable, so results recorded for other members
+// during the check are only reliable for the member the check started with.
+// The results should therefore not be reused for subsequent checks of other members.
+//
 func (m *Member) IsStorable(results map[*Member]bool) (result bool) {
 
 	// Prevent a potential stack overflow due to cyclic declarations
@@ -5689,6 +8051,102 @@ func (m *Member) IsStorable(results map[*Member]bool) (result bool) {
 	return result
 }
 
+// NonStorablePath returns the chain of types which causes the given type to be non-storable,
+// starting with the given type and ending with the type which is itself non-storable,
+// e.g. `[S]`, `S`, `((): Int)` for `[S]`, where struct `S` has a field with a function type.
+//
+// Returns nil if the given type is storable.
+//
+func NonStorablePath(ty Type) []Type {
+	return nonStorablePath(ty, map[Type]struct{}{})
+}
+
+func nonStorablePath(ty Type, visited map[Type]struct{}) []Type {
+
+	// NOTE: use new results for each type, see Member.IsStorable
+
+	if ty.IsStorable(map[*Member]bool{}) {
+		return nil
+	}
+
+	var innerTypes []Type
+
+	switch ty := ty.(type) {
+	case *OptionalType:
+		innerTypes = []Type{ty.Type}
+
+	case *VariableSizedType:
+		innerTypes = []Type{ty.Type}
+
+	case *ConstantSizedType:
+		innerTypes = []Type{ty.Type}
+
+	case *DictionaryType:
+		innerTypes = []Type{ty.KeyType, ty.ValueType}
+
+	case *RestrictedType:
+		if ty.Type != nil {
+			innerTypes = append(innerTypes, ty.Type)
+		}
+		for _, restriction := range ty.Restrictions {
+			innerTypes = append(innerTypes, restriction)
+		}
+
+	case *CompositeType:
+		// Prevent infinite recursion for cyclic declarations:
+		// A composite type which is already part of the current path
+		// is not considered to be the cause again.
+		// The type may be part of another path, e.g. when it is
+		// the type of multiple fields, so only track it for the current path
+
+		if _, ok := visited[ty]; ok {
+			return nil
+		}
+		visited[ty] = struct{}{}
+		defer delete(visited, ty)
+
+		innerTypes = nonStorablePathFieldTypes(ty.Fields, ty.Members)
+
+	case *InterfaceType:
+		if _, ok := visited[ty]; ok {
+			return nil
+		}
+		visited[ty] = struct{}{}
+		defer delete(visited, ty)
+
+		innerTypes = nonStorablePathFieldTypes(ty.Fields, ty.Members)
+	}
+
+	if len(innerTypes) == 0 {
+		return []Type{ty}
+	}
+
+	for _, innerType := range innerTypes {
+		innerPath := nonStorablePath(innerType, visited)
+		if innerPath != nil {
+			return append([]Type{ty}, innerPath...)
+		}
+	}
+
+	// All inner types only lead back to a type which is already part of the path
+
+	return nil
+}
+
+// nonStorablePathFieldTypes returns the types of the given fields, in declaration order
+//
+func nonStorablePathFieldTypes(fields []string, members map[string]*Member) []Type {
+	fieldTypes := make([]Type, 0, len(fields))
+	for _, field := range fields {
+		member, ok := members[field]
+		if !ok || member.Predeclared {
+			continue
+		}
+		fieldTypes = append(fieldTypes, member.TypeAnnotation.Type)
+	}
+	return fieldTypes
+}
+
 // InterfaceType
 
 type InterfaceType struct {
@@ -5705,6 +8163,10 @@ type InterfaceType struct {
 
 func (*InterfaceType) IsType() {}
 
+func (*InterfaceType) Tag() TypeTag {
+	return TypeTagInterface
+}
+
 func (t *InterfaceType) String() string {
 	return t.Identifier
 }
@@ -5786,10 +8248,21 @@ func (*InterfaceType) IsEquatable() bool {
 	return false
 }
 
+func (*InterfaceType) IsComparable() bool {
+	return false
+}
+
 func (*InterfaceType) TypeAnnotationState() TypeAnnotationState {
 	return TypeAnnotationStateValid
 }
 
+// RewriteWithRestrictedTypes rewrites resource and structure interface types
+// to restricted types, i.e. `I` to `AnyResource{I}` or `AnyStruct{I}`.
+//
+// Contract interface types are not rewritten:
+// A contract is a subtype of a contract interface type it conforms to,
+// so contract interface types can be used as types themselves, e.g. in references
+//
 func (t *InterfaceType) RewriteWithRestrictedTypes() (Type, bool) {
 	switch t.CompositeKind {
 	case common.CompositeKindResource:
@@ -5805,6 +8278,8 @@ func (t *InterfaceType) RewriteWithRestrictedTypes() (Type, bool) {
 		}, true
 
 	default:
+		// Contract interface types, and interface types of other kinds,
+		// e.g. of event type requirements, are not rewritten
 		return t, false
 	}
 }
@@ -5822,6 +8297,24 @@ func (t *InterfaceType) NestedTypes() map[string]Type {
 	return t.nestedTypes
 }
 
+// FieldType returns the type of the field with the given name,
+// and true if the interface type has a field with the given name.
+// It returns false if there is no such member, or if the member is not a field,
+// e.g. a function
+//
+func (t *InterfaceType) FieldType(name string) (Type, bool) {
+	return membersFieldType(t.Members, name)
+}
+
+// FunctionType returns the type of the function with the given name,
+// and true if the interface type has a function with the given name.
+// It returns false if there is no such member, or if the member is not a function,
+// e.g. a field
+//
+func (t *InterfaceType) FunctionType(name string) (*FunctionType, bool) {
+	return membersFunctionType(t.Members, name)
+}
+
 // DictionaryType consists of the key and value type
 // for all key-value pairs in the dictionary:
 // All keys have to be a subtype of the key type,
@@ -5834,6 +8327,10 @@ type DictionaryType struct {
 
 func (*DictionaryType) IsType() {}
 
+func (*DictionaryType) Tag() TypeTag {
+	return TypeTagDictionary
+}
+
 func (t *DictionaryType) String() string {
 	return fmt.Sprintf(
 		"{%s: %s}",
@@ -5888,6 +8385,10 @@ func (*DictionaryType) IsEquatable() bool {
 	return false
 }
 
+func (*DictionaryType) IsComparable() bool {
+	return false
+}
+
 func (t *DictionaryType) TypeAnnotationState() TypeAnnotationState {
 	keyTypeAnnotationState := t.KeyType.TypeAnnotationState()
 	if keyTypeAnnotationState != TypeAnnotationStateValid {
@@ -5921,11 +8422,30 @@ The number of entries in the dictionary
 `
 
 const dictionaryTypeKeysFieldDocString = `
-An array containing all keys of the dictionary
+An array containing all keys of the dictionary.
+
+The keys are in insertion order: Updating the value of an existing key keeps the key's position,
+removing a key and inserting it again moves it to the end
 `
 
 const dictionaryTypeValuesFieldDocString = `
-An array containing all values of the dictionary
+An array containing all values of the dictionary.
+
+The values are in the insertion order of their keys, i.e. in the same order as the keys in the field ` + "`keys`" + `
+`
+
+const dictionaryTypeForEachValueFunctionDocString = `
+Calls the given function with a reference to each value of the dictionary,
+in the insertion order of their keys.
+
+The values are not moved or copied, so this function is also available for dictionaries of resources.
+Iteration stops when the function returns false
+`
+
+const dictionaryTypeSortedKeysFunctionDocString = `
+Returns an array containing all keys of the dictionary, sorted in ascending order.
+
+The key type must be comparable, i.e. a number type, String, or Address
 `
 
 const dictionaryTypeInsertFunctionDocString = `
@@ -5940,6 +8460,26 @@ Removes the value for the given key from the dictionary.
 Returns the value as an optional if the dictionary contained the key, or nil if the dictionary did not contain the key
 `
 
+const dictionaryTypeFilterFunctionDocString = `
+Returns a new dictionary containing the entries of the dictionary for which the given predicate function returns true.
+
+The predicate function is called with the key and the value of each entry.
+The entries are copied, the original dictionary is not modified
+`
+
+const dictionaryTypeReduceFunctionDocString = `
+Combines all entries of the dictionary into a single value.
+
+The given combine function is called for each entry of the dictionary
+with the accumulated value, the key, and the value of the entry, and returns the new accumulated value.
+The accumulated value starts with the given initial value.
+Returns the final accumulated value, or the initial value if the dictionary is empty
+`
+
+const dictionaryTypeRemoveAllFunctionDocString = `
+Removes all entries from the dictionary
+`
+
 func (t *DictionaryType) GetMembers() map[string]MemberResolver {
 	return withBuiltinMembers(t, map[string]MemberResolver{
 		"length": {
@@ -5999,6 +8539,197 @@ func (t *DictionaryType) GetMembers() map[string]MemberResolver {
 				)
 			},
 		},
+		"sortedKeys": {
+			Kind: common.DeclarationKindFunction,
+			Resolve: func(identifier string, targetRange ast.Range, report func(error)) *Member {
+
+				if !t.KeyType.IsComparable() {
+					report(
+						&NotComparableTypeError{
+							Type:  t.KeyType,
+							Range: targetRange,
+						},
+					)
+				}
+
+				return NewPublicFunctionMember(t,
+					identifier,
+					&FunctionType{
+						ReturnTypeAnnotation: NewTypeAnnotation(
+							&VariableSizedType{Type: t.KeyType},
+						),
+					},
+					dictionaryTypeSortedKeysFunctionDocString,
+				)
+			},
+		},
+		"filter": {
+			Kind: common.DeclarationKindFunction,
+			Resolve: func(identifier string, targetRange ast.Range, report func(error)) *Member {
+
+				// The entries are copied into the result,
+				// so dictionaries of resources cannot be supported
+
+				if t.IsResourceType() {
+					report(
+						&InvalidResourceDictionaryMemberError{
+							Name:            identifier,
+							DeclarationKind: common.DeclarationKindFunction,
+							Range:           targetRange,
+						},
+					)
+				}
+
+				return NewPublicFunctionMember(t,
+					identifier,
+					&FunctionType{
+						Parameters: []*Parameter{
+							{
+								Label:      ArgumentLabelNotRequired,
+								Identifier: "predicate",
+								TypeAnnotation: NewTypeAnnotation(
+									&FunctionType{
+										Parameters: []*Parameter{
+											{
+												Label:          ArgumentLabelNotRequired,
+												Identifier:     "key",
+												TypeAnnotation: NewTypeAnnotation(t.KeyType),
+											},
+											{
+												Label:          ArgumentLabelNotRequired,
+												Identifier:     "value",
+												TypeAnnotation: NewTypeAnnotation(t.ValueType),
+											},
+										},
+										ReturnTypeAnnotation: NewTypeAnnotation(
+											&BoolType{},
+										),
+									},
+								),
+							},
+						},
+						ReturnTypeAnnotation: NewTypeAnnotation(t),
+					},
+					dictionaryTypeFilterFunctionDocString,
+				)
+			},
+		},
+		"reduce": {
+			Kind: common.DeclarationKindFunction,
+			Resolve: func(identifier string, targetRange ast.Range, report func(error)) *Member {
+
+				// The keys and values are passed to the combine function,
+				// so dictionaries of resources cannot be supported
+
+				if t.IsResourceType() {
+					report(
+						&InvalidResourceDictionaryMemberError{
+							Name:            identifier,
+							DeclarationKind: common.DeclarationKindFunction,
+							Range:           targetRange,
+						},
+					)
+				}
+
+				typeParameter := &TypeParameter{
+					Name: "U",
+				}
+
+				resultType := &GenericType{
+					TypeParameter: typeParameter,
+				}
+
+				return NewPublicFunctionMember(t,
+					identifier,
+					&FunctionType{
+						TypeParameters: []*TypeParameter{
+							typeParameter,
+						},
+						Parameters: []*Parameter{
+							{
+								Label:          ArgumentLabelNotRequired,
+								Identifier:     "initial",
+								TypeAnnotation: NewTypeAnnotation(resultType),
+							},
+							{
+								Label:      ArgumentLabelNotRequired,
+								Identifier: "combine",
+								TypeAnnotation: NewTypeAnnotation(
+									&FunctionType{
+										Parameters: []*Parameter{
+											{
+												Label:          ArgumentLabelNotRequired,
+												Identifier:     "accumulator",
+												TypeAnnotation: NewTypeAnnotation(resultType),
+											},
+											{
+												Label:          ArgumentLabelNotRequired,
+												Identifier:     "key",
+												TypeAnnotation: NewTypeAnnotation(t.KeyType),
+											},
+											{
+												Label:          ArgumentLabelNotRequired,
+												Identifier:     "value",
+												TypeAnnotation: NewTypeAnnotation(t.ValueType),
+											},
+										},
+										ReturnTypeAnnotation: NewTypeAnnotation(
+											resultType,
+										),
+									},
+								),
+							},
+						},
+						ReturnTypeAnnotation: NewTypeAnnotation(
+							resultType,
+						),
+					},
+					dictionaryTypeReduceFunctionDocString,
+				)
+			},
+		},
+		"forEachValue": {
+			Kind: common.DeclarationKindFunction,
+			Resolve: func(identifier string, _ ast.Range, _ func(error)) *Member {
+
+				// NOTE: the function is passed references to the values,
+				// so it is also available for resource value types
+
+				return NewPublicFunctionMember(t,
+					identifier,
+					&FunctionType{
+						Parameters: []*Parameter{
+							{
+								Label:      ArgumentLabelNotRequired,
+								Identifier: "function",
+								TypeAnnotation: NewTypeAnnotation(
+									&FunctionType{
+										Parameters: []*Parameter{
+											{
+												Label:      ArgumentLabelNotRequired,
+												Identifier: "value",
+												TypeAnnotation: NewTypeAnnotation(
+													&ReferenceType{
+														Type: t.ValueType,
+													},
+												),
+											},
+										},
+										ReturnTypeAnnotation: NewTypeAnnotation(
+											&BoolType{},
+										),
+									},
+								),
+							},
+						},
+						ReturnTypeAnnotation: NewTypeAnnotation(
+							&VoidType{},
+						),
+					},
+					dictionaryTypeForEachValueFunctionDocString,
+				)
+			},
+		},
 		"insert": {
 			Kind: common.DeclarationKindFunction,
 			Resolve: func(identifier string, _ ast.Range, _ func(error)) *Member {
@@ -6048,6 +8779,34 @@ func (t *DictionaryType) GetMembers() map[string]MemberResolver {
 				)
 			},
 		},
+		"removeAll": {
+			Kind: common.DeclarationKindFunction,
+			Resolve: func(identifier string, targetRange ast.Range, report func(error)) *Member {
+
+				// Removing all entries of a dictionary of resources
+				// would lose the resources
+
+				if t.ValueType.IsResourceType() {
+					report(
+						&InvalidResourceDictionaryMemberError{
+							Name:            identifier,
+							DeclarationKind: common.DeclarationKindFunction,
+							Range:           targetRange,
+						},
+					)
+				}
+
+				return NewPublicFunctionMember(t,
+					identifier,
+					&FunctionType{
+						ReturnTypeAnnotation: NewTypeAnnotation(
+							&VoidType{},
+						),
+					},
+					dictionaryTypeRemoveAllFunctionDocString,
+				)
+			},
+		},
 	})
 }
 
@@ -6113,6 +8872,10 @@ type ReferenceType struct {
 
 func (*ReferenceType) IsType() {}
 
+func (*ReferenceType) Tag() TypeTag {
+	return TypeTagReference
+}
+
 func (t *ReferenceType) string(typeFormatter func(Type) string) string {
 	if t.Type == nil {
 		return "reference"
@@ -6163,6 +8926,14 @@ func (t *ReferenceType) IsResourceType() bool {
 	return false
 }
 
+// ReferencesResource returns true if the referenced type is a resource type.
+//
+// NOTE: The reference type itself is never a resource type, see `IsResourceType`
+//
+func (t *ReferenceType) ReferencesResource() bool {
+	return t.Type.IsResourceType()
+}
+
 func (t *ReferenceType) IsInvalidType() bool {
 	return t.Type.IsInvalidType()
 }
@@ -6175,6 +8946,10 @@ func (*ReferenceType) IsEquatable() bool {
 	return true
 }
 
+func (*ReferenceType) IsComparable() bool {
+	return false
+}
+
 func (*ReferenceType) TypeAnnotationState() TypeAnnotationState {
 	return TypeAnnotationStateValid
 }
@@ -6242,6 +9017,10 @@ type AddressType struct{}
 
 func (*AddressType) IsType() {}
 
+func (*AddressType) Tag() TypeTag {
+	return TypeTagAddress
+}
+
 func (*AddressType) String() string {
 	return "Address"
 }
@@ -6275,6 +9054,10 @@ func (*AddressType) IsEquatable() bool {
 	return true
 }
 
+func (*AddressType) IsComparable() bool {
+	return true
+}
+
 func (*AddressType) TypeAnnotationState() TypeAnnotationState {
 	return TypeAnnotationStateValid
 }
@@ -6331,6 +9114,93 @@ func (t *AddressType) GetMembers() map[string]MemberResolver {
 	})
 }
 
+// The tags of the number types which are subtypes of the abstract number types,
+// including the abstract number types themselves
+
+var signedIntegerTypeTags = newTypeTagSet(
+	TypeTagSignedInteger,
+	TypeTagInt,
+	TypeTagInt8,
+	TypeTagInt16,
+	TypeTagInt32,
+	TypeTagInt64,
+	TypeTagInt128,
+	TypeTagInt256,
+)
+
+var integerTypeTags = signedIntegerTypeTags |
+	newTypeTagSet(
+		TypeTagInteger,
+		TypeTagUInt,
+		TypeTagUInt8,
+		TypeTagUInt16,
+		TypeTagUInt32,
+		TypeTagUInt64,
+		TypeTagUInt128,
+		TypeTagUInt256,
+		TypeTagWord8,
+		TypeTagWord16,
+		TypeTagWord32,
+		TypeTagWord64,
+	)
+
+var signedFixedPointTypeTags = newTypeTagSet(
+	TypeTagSignedFixedPoint,
+	TypeTagFix64,
+)
+
+var fixedPointTypeTags = signedFixedPointTypeTags |
+	newTypeTagSet(
+		TypeTagFixedPoint,
+		TypeTagUFix64,
+	)
+
+var signedNumberTypeTags = signedIntegerTypeTags |
+	signedFixedPointTypeTags |
+	newTypeTagSet(TypeTagSignedNumber)
+
+var numberTypeTags = integerTypeTags |
+	fixedPointTypeTags |
+	newTypeTagSet(
+		TypeTagNumber,
+		TypeTagSignedNumber,
+	)
+
+// The tags of the unsigned integer types which have wrapping arithmetic functions
+
+var wrappingArithmeticTypeTags = newTypeTagSet(
+	TypeTagUInt8,
+	TypeTagUInt16,
+	TypeTagUInt32,
+	TypeTagUInt64,
+)
+
+var abstractNumberTypeTags = newTypeTagSet(
+	TypeTagNumber,
+	TypeTagSignedNumber,
+	TypeTagInteger,
+	TypeTagSignedInteger,
+	TypeTagFixedPoint,
+	TypeTagSignedFixedPoint,
+)
+
+// IsReferencedSubType returns true if a reference to a value of type `subType`
+// may be used as a reference to a value of type `superType`.
+//
+// Arrays and dictionaries are covariant, but references to them are invariant
+// in the element, key, and value types: the referenced container may be mutated
+// through the reference, e.g. `&[Int]` is not a subtype of `&[AnyStruct]`,
+// as otherwise a `String` could be appended to an `[Int]`
+//
+func IsReferencedSubType(subType Type, superType Type) bool {
+	switch superType.(type) {
+	case ArrayType, *DictionaryType:
+		return subType.Equal(superType)
+	}
+
+	return IsSubType(subType, superType)
+}
+
 // IsSubType determines if the given subtype is a subtype
 // of the given supertype.
 //
@@ -6363,68 +9233,22 @@ func IsSubType(subType Type, superType Type) bool {
 		return subType.IsResourceType()
 
 	case *NumberType:
-		switch subType.(type) {
-		case *NumberType, *SignedNumberType:
-			return true
-		}
-
-		return IsSubType(subType, &IntegerType{}) ||
-			IsSubType(subType, &FixedPointType{})
+		return numberTypeTags.Contains(subType.Tag())
 
 	case *SignedNumberType:
-		if _, ok := subType.(*SignedNumberType); ok {
-			return true
-		}
-
-		return IsSubType(subType, &SignedIntegerType{}) ||
-			IsSubType(subType, &SignedFixedPointType{})
+		return signedNumberTypeTags.Contains(subType.Tag())
 
 	case *IntegerType:
-		switch subType.(type) {
-		case *IntegerType, *SignedIntegerType,
-			*IntType, *UIntType,
-			*Int8Type, *Int16Type, *Int32Type, *Int64Type, *Int128Type, *Int256Type,
-			*UInt8Type, *UInt16Type, *UInt32Type, *UInt64Type, *UInt128Type, *UInt256Type,
-			*Word8Type, *Word16Type, *Word32Type, *Word64Type:
-
-			return true
-
-		default:
-			return false
-		}
+		return integerTypeTags.Contains(subType.Tag())
 
 	case *SignedIntegerType:
-		switch subType.(type) {
-		case *SignedIntegerType,
-			*IntType,
-			*Int8Type, *Int16Type, *Int32Type, *Int64Type, *Int128Type, *Int256Type:
-
-			return true
-
-		default:
-			return false
-		}
+		return signedIntegerTypeTags.Contains(subType.Tag())
 
 	case *FixedPointType:
-		switch subType.(type) {
-		case *FixedPointType, *SignedFixedPointType,
-			*Fix64Type, *UFix64Type:
-
-			return true
-
-		default:
-			return false
-		}
+		return fixedPointTypeTags.Contains(subType.Tag())
 
 	case *SignedFixedPointType:
-		switch subType.(type) {
-		case *SignedNumberType, *Fix64Type:
-
-			return true
-
-		default:
-			return false
-		}
+		return signedFixedPointTypeTags.Contains(subType.Tag())
 
 	case *OptionalType:
 		optionalSubType, ok := subType.(*OptionalType)
@@ -6450,6 +9274,11 @@ func IsSubType(subType Type, superType Type) bool {
 			return false
 		}
 
+		// Arrays are covariant: [T] <: [U] if T <: U.
+		//
+		// NOTE: References to arrays are invariant in the element type,
+		// see `IsReferencedSubType`
+
 		return IsSubType(
 			typedSubType.ElementType(false),
 			typedSuperType.ElementType(false),
@@ -6483,7 +9312,7 @@ func IsSubType(subType Type, superType Type) bool {
 		// if `T` is a subtype of `U`
 
 		if typedSubType.Authorized {
-			return IsSubType(typedSubType.Type, typedSuperType.Type)
+			return IsReferencedSubType(typedSubType.Type, typedSuperType.Type)
 		}
 
 		// An unauthorized reference type is not a subtype of an authorized reference type.
@@ -6596,6 +9425,20 @@ func IsSubType(subType Type, superType Type) bool {
 
 			return false
 
+		case *InterfaceType:
+			// An unauthorized reference to a type `&T`
+			// is a subtype of a reference to an interface type `&V`:
+			// if `T` is a subtype of `V`.
+			//
+			// NOTE: resource and structure interface types are rewritten to restricted types
+			// (see `InterfaceType.RewriteWithRestrictedTypes`), and are never supertypes.
+			// Contract interface types are not rewritten, a contract is a subtype of them
+			// if it conforms to them.
+			//
+			// The holder of the reference may only restrict the reference.
+
+			return IsSubType(typedSubType.Type, typedInnerSuperType)
+
 		case *AnyType:
 
 			// An unauthorized reference to a restricted type `&T{Us}`
@@ -6901,6 +9744,84 @@ func IsSubType(subType Type, superType Type) bool {
 	return false
 }
 
+// MissingRestrictions returns the restrictions of the given restricted supertype
+// which are not satisfied by the given subtype, in the order they are declared.
+//
+// It is meant to explain why the subtype is not a subtype of the supertype (see `IsSubType`):
+// The subtype might be a composite type which does not conform to the restrictions,
+// or a restricted type which does not have the restrictions.
+// Optional types and references are unwrapped.
+//
+// The result is nil if the supertype is not restricted to `AnyResource`, `AnyStruct`, or `Any`,
+// or if the restrictions of the supertype cannot be statically satisfied by the subtype
+//
+func MissingRestrictions(subType, superType Type) []*InterfaceType {
+
+	switch typedSuperType := superType.(type) {
+	case *OptionalType:
+		if typedSubType, ok := subType.(*OptionalType); ok {
+			return MissingRestrictions(typedSubType.Type, typedSuperType.Type)
+		}
+		return MissingRestrictions(subType, typedSuperType.Type)
+
+	case *ReferenceType:
+		if typedSubType, ok := subType.(*ReferenceType); ok {
+			return MissingRestrictions(typedSubType.Type, typedSuperType.Type)
+		}
+		return nil
+
+	case *RestrictedType:
+		switch typedSuperType.Type.(type) {
+		case *AnyResourceType, *AnyStructType, *AnyType:
+			break
+		default:
+			return nil
+		}
+
+		var satisfiedRestrictions InterfaceSet
+
+		switch typedSubType := subType.(type) {
+		case *CompositeType:
+			if !IsSubType(typedSubType, typedSuperType.Type) {
+				return nil
+			}
+
+			satisfiedRestrictions = typedSubType.ExplicitInterfaceConformanceSet()
+
+		case *RestrictedType:
+			if !IsSubType(typedSubType.Type, typedSuperType.Type) {
+				return nil
+			}
+
+			switch restrictedSubType := typedSubType.Type.(type) {
+			case *AnyResourceType, *AnyStructType, *AnyType:
+				satisfiedRestrictions = typedSubType.RestrictionSet()
+
+			case *CompositeType:
+				satisfiedRestrictions = restrictedSubType.ExplicitInterfaceConformanceSet()
+
+			default:
+				return nil
+			}
+
+		default:
+			return nil
+		}
+
+		var missingRestrictions []*InterfaceType
+
+		for _, restriction := range typedSuperType.Restrictions {
+			if !satisfiedRestrictions.Includes(restriction) {
+				missingRestrictions = append(missingRestrictions, restriction)
+			}
+		}
+
+		return missingRestrictions
+	}
+
+	return nil
+}
+
 // UnwrapOptionalType returns the type if it is not an optional type,
 // or the inner-most type if it is (optional types are repeatedly unwrapped)
 //
@@ -6937,19 +9858,16 @@ func AreCompatibleEquatableTypes(leftType, rightType Type) bool {
 	return false
 }
 
-// IsNilType returns true if the given type is the type of `nil`, i.e. `Never?`.
+// IsNilType returns true if the given type is the type of `nil`, i.e. `Never?`,
+// or a nested optional of `Never`, e.g. `Never??`.
 //
 func IsNilType(ty Type) bool {
-	optionalType, ok := ty.(*OptionalType)
-	if !ok {
-		return false
-	}
-
-	if _, ok := optionalType.Type.(*NeverType); !ok {
+	if _, ok := ty.(*OptionalType); !ok {
 		return false
 	}
 
-	return true
+	_, ok := UnwrapOptionalType(ty).(*NeverType)
+	return ok
 }
 
 type TransactionType struct {
@@ -6986,6 +9904,10 @@ func (*TransactionType) ExecuteFunctionType() *SpecialFunctionType {
 
 func (*TransactionType) IsType() {}
 
+func (*TransactionType) Tag() TypeTag {
+	return TypeTagTransaction
+}
+
 func (*TransactionType) String() string {
 	return "Transaction"
 }
@@ -7019,6 +9941,10 @@ func (*TransactionType) IsEquatable() bool {
 	return false
 }
 
+func (*TransactionType) IsComparable() bool {
+	return false
+}
+
 func (*TransactionType) TypeAnnotationState() TypeAnnotationState {
 	return TypeAnnotationStateValid
 }
@@ -7098,6 +10024,10 @@ func (t *RestrictedType) RestrictionSet() InterfaceSet {
 
 func (*RestrictedType) IsType() {}
 
+func (*RestrictedType) Tag() TypeTag {
+	return TypeTagRestricted
+}
+
 func (t *RestrictedType) string(separator string, typeFormatter func(Type) string) string {
 	var result strings.Builder
 	result.WriteString(typeFormatter(t.Type))
@@ -7196,6 +10126,10 @@ func (*RestrictedType) IsEquatable() bool {
 	return false
 }
 
+func (*RestrictedType) IsComparable() bool {
+	return false
+}
+
 func (*RestrictedType) TypeAnnotationState() TypeAnnotationState {
 	return TypeAnnotationStateValid
 }
@@ -7211,15 +10145,28 @@ func (t *RestrictedType) GetMembers() map[string]MemberResolver {
 	members := map[string]MemberResolver{}
 
 	// Return the members of all restrictions.
-	// The invariant that restrictions may not have overlapping members is not checked here,
-	// but implicitly when the resource declaration's conformances are checked.
+	// The invariant that restrictions may not have overlapping members with differing types
+	// is not checked here, but when the restricted type is converted
+	// (see `RestrictionMemberClashError`).
+	//
+	// If multiple restrictions declare the same member,
+	// the member with the most permissive access is effective.
+
+	restrictionResolvers := map[string][]MemberResolver{}
 
 	for _, restriction := range t.Restrictions {
 		for name, resolver := range restriction.GetMembers() {
-			if _, ok := members[name]; !ok {
-				members[name] = resolver
-			}
+			restrictionResolvers[name] = append(restrictionResolvers[name], resolver)
+		}
+	}
+
+	for name, resolvers := range restrictionResolvers {
+		if len(resolvers) == 1 {
+			members[name] = resolvers[0]
+			continue
 		}
+
+		members[name] = mergedRestrictionMemberResolver(resolvers)
 	}
 
 	// Also include members of the restricted type for convenience,
@@ -7257,6 +10204,52 @@ func (t *RestrictedType) GetMembers() map[string]MemberResolver {
 	return members
 }
 
+// mergedRestrictionMemberResolver returns a member resolver for a member
+// which is declared by multiple restrictions.
+//
+// The resolved member is the one with the most permissive access.
+// A conflict is reported if the members are declared differently,
+// e.g. one as a field and one as a function.
+//
+func mergedRestrictionMemberResolver(resolvers []MemberResolver) MemberResolver {
+	return MemberResolver{
+		Kind: resolvers[0].Kind,
+		Resolve: func(identifier string, targetRange ast.Range, report func(error)) *Member {
+			var effectiveMember *Member
+
+			for _, resolver := range resolvers {
+				member := resolver.Resolve(identifier, targetRange, report)
+				if member == nil {
+					continue
+				}
+
+				if effectiveMember == nil {
+					effectiveMember = member
+					continue
+				}
+
+				if member.DeclarationKind != effectiveMember.DeclarationKind {
+					report(
+						&RestrictionMemberKindConflictError{
+							Name:                 identifier,
+							DeclarationKind:      effectiveMember.DeclarationKind,
+							OtherDeclarationKind: member.DeclarationKind,
+							Range:                targetRange,
+						},
+					)
+					return effectiveMember
+				}
+
+				if effectiveMember.Access.IsLessPermissiveThan(member.Access) {
+					effectiveMember = member
+				}
+			}
+
+			return effectiveMember
+		},
+	}
+}
+
 func (*RestrictedType) Unify(_ Type, _ map[*TypeParameter]Type, _ func(err error), _ ast.Range) bool {
 	// TODO: how do we unify the restriction sets?
 	return false
@@ -7273,6 +10266,10 @@ type PathType struct{}
 
 func (*PathType) IsType() {}
 
+func (*PathType) Tag() TypeTag {
+	return TypeTagPath
+}
+
 func (*PathType) String() string {
 	return "Path"
 }
@@ -7307,6 +10304,10 @@ func (*PathType) IsEquatable() bool {
 	return false
 }
 
+func (*PathType) IsComparable() bool {
+	return true
+}
+
 func (*PathType) TypeAnnotationState() TypeAnnotationState {
 	return TypeAnnotationStateValid
 }
@@ -7335,6 +10336,10 @@ type CapabilityType struct {
 
 func (*CapabilityType) IsType() {}
 
+func (*CapabilityType) Tag() TypeTag {
+	return TypeTagCapability
+}
+
 func (t *CapabilityType) string(typeFormatter func(Type) string) string {
 	var builder strings.Builder
 	builder.WriteString("Capability")
@@ -7402,6 +10407,10 @@ func (*CapabilityType) IsEquatable() bool {
 	return false
 }
 
+func (*CapabilityType) IsComparable() bool {
+	return false
+}
+
 func (t *CapabilityType) RewriteWithRestrictedTypes() (Type, bool) {
 	if t.BorrowType == nil {
 		return t, false
@@ -7427,7 +10436,10 @@ func (t *CapabilityType) Unify(
 		return false
 	}
 
-	if t.BorrowType == nil {
+	// An unparameterized capability type has nothing to unify,
+	// and its borrow type can't be unified with an unspecified one
+
+	if t.BorrowType == nil || otherCap.BorrowType == nil {
 		return false
 	}
 
@@ -7437,6 +10449,10 @@ func (t *CapabilityType) Unify(
 func (t *CapabilityType) Resolve(typeParameters map[*TypeParameter]Type) Type {
 	var resolvedBorrowType Type
 	if t.BorrowType != nil {
+		// NOTE: If the borrow type can't be resolved,
+		// e.g. because an optional type argument was omitted,
+		// the result is the unparameterized type `Capability`
+
 		resolvedBorrowType = t.BorrowType.Resolve(typeParameters)
 	}
 
@@ -7450,6 +10466,7 @@ var capabilityTypeParameter = &TypeParameter{
 	TypeBound: &ReferenceType{
 		Type: &AnyType{},
 	},
+	TypeArgumentCheck: checkCapabilityLinkType,
 }
 
 func (t *CapabilityType) TypeParameters() []*TypeParameter {
@@ -7472,15 +10489,15 @@ func (t *CapabilityType) BaseType() Type {
 	return &CapabilityType{}
 }
 
+// TypeArguments returns the borrow type as the only type argument,
+// or nil if the capability type is unparameterized (see BaseType)
+//
 func (t *CapabilityType) TypeArguments() []Type {
-	borrowType := t.BorrowType
-	if borrowType == nil {
-		borrowType = &ReferenceType{
-			Type: &AnyType{},
-		}
+	if t.BorrowType == nil {
+		return nil
 	}
 	return []Type{
-		borrowType,
+		t.BorrowType,
 	}
 }
 
@@ -7495,6 +10512,22 @@ func capabilityTypeBorrowFunctionType(borrowType Type) *FunctionType {
 			typeParameter,
 		}
 
+		borrowType = &GenericType{
+			TypeParameter: typeParameter,
+		}
+	} else if referenceType, ok := borrowType.(*ReferenceType); ok {
+
+		// The type argument is optional and defaults to the borrow type.
+		// If the borrow type is an authorized reference,
+		// the capability may also be borrowed as a subtype of it,
+		// e.g. to downcast the referenced type
+
+		typeParameter := capabilityTypeRefinedBorrowTypeParameter(referenceType)
+
+		typeParameters = []*TypeParameter{
+			typeParameter,
+		}
+
 		borrowType = &GenericType{
 			TypeParameter: typeParameter,
 		}
@@ -7510,6 +10543,45 @@ func capabilityTypeBorrowFunctionType(borrowType Type) *FunctionType {
 	}
 }
 
+// capabilityTypeRefinedBorrowTypeParameter returns the optional type parameter
+// of the `borrow` and `check` functions of a capability with the given borrow type.
+//
+// If the borrow type is authorized, a type argument must be a subtype of the borrow type.
+// If the borrow type is unauthorized, a type argument must be the borrow type itself,
+// as borrowing a subtype, e.g. an unrestricted or authorized reference,
+// would grant more access than the capability provides
+//
+func capabilityTypeRefinedBorrowTypeParameter(borrowType *ReferenceType) *TypeParameter {
+	return &TypeParameter{
+		Name:      "T",
+		TypeBound: borrowType,
+		Optional:  true,
+		TypeArgumentCheck: func(ty Type, typeRange ast.Range) error {
+			err := checkBorrowType(ty, typeRange)
+			if err != nil {
+				return err
+			}
+
+			var valid bool
+			if borrowType.Authorized {
+				valid = IsSubType(ty, borrowType)
+			} else {
+				valid = ty.Equal(borrowType)
+			}
+
+			if !valid {
+				return &InvalidCapabilityBorrowTypeError{
+					ExpectedBorrowType: borrowType,
+					ActualBorrowType:   ty,
+					Range:              typeRange,
+				}
+			}
+
+			return nil
+		},
+	}
+}
+
 func capabilityTypeCheckFunctionType(borrowType Type) *FunctionType {
 
 	var typeParameters []*TypeParameter
@@ -7518,6 +10590,10 @@ func capabilityTypeCheckFunctionType(borrowType Type) *FunctionType {
 		typeParameters = []*TypeParameter{
 			capabilityTypeParameter,
 		}
+	} else if referenceType, ok := borrowType.(*ReferenceType); ok {
+		typeParameters = []*TypeParameter{
+			capabilityTypeRefinedBorrowTypeParameter(referenceType),
+		}
 	}
 
 	return &FunctionType{
@@ -7567,10 +10643,18 @@ func (t *CapabilityType) GetMembers() map[string]MemberResolver {
 // to user programs, i.e. can't be used in type annotations
 // for e.g. parameters, return types, fields, etc.
 //
+// Generic functions declared by the host environment, e.g. storage helpers,
+// can use it as the type bound or as a constraint of a type parameter,
+// to only accept storable type arguments.
+//
 type StorableType struct{}
 
 func (*StorableType) IsType() {}
 
+func (*StorableType) Tag() TypeTag {
+	return TypeTagStorable
+}
+
 func (*StorableType) String() string {
 	return "Storable"
 }
@@ -7612,6 +10696,10 @@ func (*StorableType) IsEquatable() bool {
 	return false
 }
 
+func (*StorableType) IsComparable() bool {
+	return false
+}
+
 func (*StorableType) TypeAnnotationState() TypeAnnotationState {
 	return TypeAnnotationStateValid
 }
@@ -0,0 +1,402 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2020 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package sema
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/onflow/cadence/runtime/ast"
+)
+
+// TypeFromID reconstructs the type with the given type ID,
+// i.e. it is the inverse of `Type.ID()`.
+//
+// Composite and interface types are resolved by calling the given function
+// with the location and the qualified identifier of the type.
+// The function should return nil if the type is unknown.
+//
+func TypeFromID(id TypeID, resolve func(ast.Location, string) Type) (Type, error) {
+	parser := &typeIDParser{
+		id:      string(id),
+		resolve: resolve,
+	}
+
+	ty := parser.parseType()
+	if parser.failed || parser.offset != len(parser.id) {
+		return nil, &InvalidTypeIDError{TypeID: id}
+	}
+
+	return ty, nil
+}
+
+// typeIDBaseTypes are the types which are not composed of other types,
+// indexed by their type ID
+
+var typeIDBaseTypes = map[string]Type{}
+
+func init() {
+	typeIDBaseTypes[string((&AnyType{}).ID())] = &AnyType{}
+	typeIDBaseTypes[string((&StorableType{}).ID())] = &StorableType{}
+
+	for _, ty := range baseTypes {
+		typeIDBaseTypes[string(ty.ID())] = ty
+	}
+}
+
+// typeIDDelimiters are the characters which may not occur in
+// the ID of a base type, composite type, or interface type
+
+const typeIDDelimiters = "[]{}()<>:;,?&"
+
+type typeIDParser struct {
+	id      string
+	offset  int
+	resolve func(ast.Location, string) Type
+	failed  bool
+}
+
+func (p *typeIDParser) rest() string {
+	return p.id[p.offset:]
+}
+
+func (p *typeIDParser) accept(prefix string) bool {
+	if !strings.HasPrefix(p.rest(), prefix) {
+		return false
+	}
+	p.offset += len(prefix)
+	return true
+}
+
+func (p *typeIDParser) expect(prefix string) {
+	if !p.accept(prefix) {
+		p.failed = true
+	}
+}
+
+func (p *typeIDParser) parseType() Type {
+	ty := p.parseRestrictedType()
+
+	for !p.failed && p.accept("?") {
+		ty = &OptionalType{Type: ty}
+	}
+
+	return ty
+}
+
+// parseRestrictedType parses a type which is optionally restricted.
+//
+// Optional types bind weaker than reference types,
+// e.g. `&R?` is an optional reference, not a reference to an optional,
+// and restrictions bind stronger than reference types,
+// e.g. `&R{I}` is a reference to a restricted type.
+//
+func (p *typeIDParser) parseRestrictedType() Type {
+	ty := p.parsePrimaryType()
+	if p.failed || !p.accept("{") {
+		return ty
+	}
+
+	restrictions := []*InterfaceType{}
+
+	if !p.accept("}") {
+		for {
+			restriction, ok := p.parseType().(*InterfaceType)
+			if !ok {
+				p.failed = true
+			}
+			if p.failed {
+				return nil
+			}
+
+			restrictions = append(restrictions, restriction)
+
+			if p.accept("}") {
+				break
+			}
+			p.expect(",")
+			if p.failed {
+				return nil
+			}
+		}
+	}
+
+	return &RestrictedType{
+		Type:         ty,
+		Restrictions: restrictions,
+	}
+}
+
+func (p *typeIDParser) parsePrimaryType() Type {
+	switch {
+	case p.accept("["):
+		return p.parseArrayType()
+
+	case p.accept("{"):
+		return p.parseDictionaryType()
+
+	case p.accept("("):
+		return p.parseFunctionType()
+
+	case p.accept("auth &"):
+		return p.parseReferenceType(true)
+
+	case p.accept("&"):
+		return p.parseReferenceType(false)
+
+	case p.accept("Capability<"):
+		borrowType := p.parseType()
+		p.expect(">")
+		return &CapabilityType{
+			BorrowType: borrowType,
+		}
+
+	default:
+		return p.parseNominalType()
+	}
+}
+
+func (p *typeIDParser) parseArrayType() Type {
+	elementType := p.parseType()
+	if p.failed {
+		return nil
+	}
+
+	if p.accept("]") {
+		return &VariableSizedType{
+			Type: elementType,
+		}
+	}
+
+	p.expect(";")
+	if p.failed {
+		return nil
+	}
+
+	size := p.parseCount(']')
+	if p.failed {
+		return nil
+	}
+
+	return &ConstantSizedType{
+		Type: elementType,
+		Size: size,
+	}
+}
+
+// parseCount parses a count, e.g. the size of a constant-sized array,
+// followed by the given terminator, which is consumed.
+//
+// Only the canonical representation of the count is accepted,
+// as produced by the type IDs: a non-negative decimal number without a sign or leading zeros
+//
+func (p *typeIDParser) parseCount(terminator rune) int64 {
+	end := strings.IndexRune(p.rest(), terminator)
+	if end < 0 {
+		p.failed = true
+		return 0
+	}
+
+	literal := p.rest()[:end]
+	if !isCanonicalCount(literal) {
+		p.failed = true
+		return 0
+	}
+
+	count, err := strconv.ParseInt(literal, 10, 64)
+	if err != nil {
+		p.failed = true
+		return 0
+	}
+
+	p.offset += end + 1
+
+	return count
+}
+
+func isCanonicalCount(literal string) bool {
+	if len(literal) == 0 {
+		return false
+	}
+
+	if len(literal) > 1 && literal[0] == '0' {
+		return false
+	}
+
+	for _, r := range literal {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (p *typeIDParser) parseDictionaryType() Type {
+	keyType := p.parseType()
+	p.expect(":")
+	valueType := p.parseType()
+	p.expect("}")
+
+	return &DictionaryType{
+		KeyType:   keyType,
+		ValueType: valueType,
+	}
+}
+
+// parseFunctionType parses a function type without type parameters,
+// the leading parenthesis is already consumed.
+//
+func (p *typeIDParser) parseFunctionType() Type {
+	p.expect("(")
+
+	var parameters []*Parameter
+	var requiredArgumentCount *int
+
+	if !p.failed && !p.accept(")") {
+
+		// The parameter types are optionally followed by the required argument count,
+		// separated by a semicolon, e.g. `((Int,Int;1):Void)`
+
+		hasRequiredArgumentCount := p.accept(";")
+
+		for !hasRequiredArgumentCount {
+			parameterType := p.parseType()
+			if p.failed {
+				return nil
+			}
+
+			parameters = append(
+				parameters,
+				&Parameter{
+					TypeAnnotation: NewTypeAnnotation(parameterType),
+				},
+			)
+
+			if p.accept(")") {
+				break
+			}
+			if p.accept(";") {
+				hasRequiredArgumentCount = true
+				break
+			}
+			p.expect(",")
+			if p.failed {
+				return nil
+			}
+		}
+
+		if hasRequiredArgumentCount {
+			count := int(p.parseCount(')'))
+			if p.failed {
+				return nil
+			}
+			requiredArgumentCount = &count
+		}
+	}
+
+	p.expect(":")
+	returnType := p.parseType()
+	p.expect(")")
+
+	return &FunctionType{
+		Parameters:            parameters,
+		ReturnTypeAnnotation:  NewTypeAnnotation(returnType),
+		RequiredArgumentCount: requiredArgumentCount,
+	}
+}
+
+func (p *typeIDParser) parseReferenceType(authorized bool) Type {
+	return &ReferenceType{
+		Authorized: authorized,
+		Type:       p.parseRestrictedType(),
+	}
+}
+
+// parseNominalType parses a base type, a composite type, or an interface type
+//
+func (p *typeIDParser) parseNominalType() Type {
+	rest := p.rest()
+
+	end := strings.IndexAny(rest, typeIDDelimiters)
+	if end < 0 {
+		end = len(rest)
+	}
+
+	identifier := rest[:end]
+	if identifier == "" {
+		p.failed = true
+		return nil
+	}
+
+	p.offset += end
+
+	if ty, ok := typeIDBaseTypes[identifier]; ok {
+		return ty
+	}
+
+	location, qualifiedIdentifier, err := parseCompositeTypeID(TypeID(identifier))
+	if err != nil || p.resolve == nil {
+		p.failed = true
+		return nil
+	}
+
+	ty := p.resolve(location, qualifiedIdentifier)
+	if ty == nil {
+		p.failed = true
+		return nil
+	}
+
+	return ty
+}
+
+// parseCompositeTypeID splits the given ID of a composite type or interface type
+// into the location and the qualified identifier of the type,
+// i.e. it is the inverse of `CompositeType.ID()` and `InterfaceType.ID()`.
+//
+// The location ID may itself contain dots (e.g. for address contract locations),
+// so the location is determined from the location prefix, see `ast.LocationFromTypeID`.
+// The remainder is the qualified identifier, which contains dots for nested types,
+// e.g. `A.B.C`.
+//
+func parseCompositeTypeID(id TypeID) (ast.Location, string, error) {
+	location := ast.LocationFromTypeID(string(id))
+	if location == nil {
+		return nil, "", &InvalidTypeIDError{TypeID: id}
+	}
+
+	// The type ID must start with the canonical location ID,
+	// e.g. addresses must not be shortened
+
+	locationPrefix := string(location.ID()) + "."
+
+	if !strings.HasPrefix(string(id), locationPrefix) {
+		return nil, "", &InvalidTypeIDError{TypeID: id}
+	}
+
+	qualifiedIdentifier := string(id)[len(locationPrefix):]
+
+	for _, identifier := range strings.Split(qualifiedIdentifier, ".") {
+		if identifier == "" {
+			return nil, "", &InvalidTypeIDError{TypeID: id}
+		}
+	}
+
+	return location, qualifiedIdentifier, nil
+}
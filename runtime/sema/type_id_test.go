@@ -0,0 +1,320 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2020 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package sema
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/cadence/runtime/ast"
+	"github.com/onflow/cadence/runtime/common"
+)
+
+func TestTypeFromID(t *testing.T) {
+
+	t.Parallel()
+
+	location := ast.StringLocation("a")
+
+	requiredArgumentCount := func(count int) *int {
+		return &count
+	}
+
+	contractType := &CompositeType{
+		Location:   location,
+		Identifier: "C",
+		Kind:       common.CompositeKindContract,
+		Members:    map[string]*Member{},
+	}
+
+	resourceType := &CompositeType{
+		Location:      location,
+		Identifier:    "R",
+		Kind:          common.CompositeKindResource,
+		Members:       map[string]*Member{},
+		ContainerType: contractType,
+	}
+
+	interfaceType := &InterfaceType{
+		Location:      location,
+		Identifier:    "I",
+		CompositeKind: common.CompositeKindResource,
+		Members:       map[string]*Member{},
+	}
+
+	structType := &CompositeType{
+		Location:   ast.AddressContractLocation{AddressLocation: []byte{0x1}, Name: "S"},
+		Identifier: "S",
+		Kind:       common.CompositeKindStructure,
+		Members:    map[string]*Member{},
+	}
+
+	resolve := func(location ast.Location, qualifiedIdentifier string) Type {
+		for _, ty := range []Type{contractType, resourceType, interfaceType, structType} {
+			if ty.ID() == TypeID(string(location.ID())+"."+qualifiedIdentifier) {
+				return ty
+			}
+		}
+		return nil
+	}
+
+	types := []Type{
+		&IntType{},
+		&UFix64Type{},
+		&AnyType{},
+		&AnyStructType{},
+		&AnyResourceType{},
+		&StringType{},
+		&AddressType{},
+		&PathType{},
+		&StorableType{},
+		&CapabilityType{},
+		&AuthAccountType{},
+		contractType,
+		resourceType,
+		interfaceType,
+		structType,
+		&OptionalType{Type: &StringType{}},
+		&OptionalType{Type: &OptionalType{Type: resourceType}},
+		&VariableSizedType{Type: &IntType{}},
+		&ConstantSizedType{Type: &VariableSizedType{Type: structType}, Size: 3},
+		&DictionaryType{
+			KeyType:   &StringType{},
+			ValueType: &OptionalType{Type: resourceType},
+		},
+		&DictionaryType{
+			KeyType: &AddressType{},
+			ValueType: &DictionaryType{
+				KeyType:   &Int8Type{},
+				ValueType: &VariableSizedType{Type: &BoolType{}},
+			},
+		},
+		&ReferenceType{Type: resourceType},
+		&ReferenceType{Authorized: true, Type: &AnyStructType{}},
+		&OptionalType{Type: &ReferenceType{Type: structType}},
+		&RestrictedType{
+			Type:         &AnyResourceType{},
+			Restrictions: []*InterfaceType{interfaceType},
+		},
+		&ReferenceType{
+			Type: &RestrictedType{
+				Type:         resourceType,
+				Restrictions: []*InterfaceType{interfaceType, interfaceType},
+			},
+		},
+		&CapabilityType{
+			BorrowType: &ReferenceType{Type: resourceType},
+		},
+		&FunctionType{
+			Parameters: []*Parameter{
+				{TypeAnnotation: NewTypeAnnotation(&IntType{})},
+				{TypeAnnotation: NewTypeAnnotation(&VariableSizedType{Type: resourceType})},
+			},
+			ReturnTypeAnnotation: NewTypeAnnotation(&OptionalType{Type: &StringType{}}),
+		},
+		&FunctionType{
+			ReturnTypeAnnotation: NewTypeAnnotation(&VoidType{}),
+		},
+		&FunctionType{
+			Parameters: []*Parameter{
+				{TypeAnnotation: NewTypeAnnotation(&IntType{})},
+				{TypeAnnotation: NewTypeAnnotation(&IntType{})},
+			},
+			ReturnTypeAnnotation:  NewTypeAnnotation(&VoidType{}),
+			RequiredArgumentCount: requiredArgumentCount(1),
+		},
+		&FunctionType{
+			ReturnTypeAnnotation:  NewTypeAnnotation(&VoidType{}),
+			RequiredArgumentCount: requiredArgumentCount(0),
+		},
+	}
+
+	for _, ty := range types {
+
+		ty := ty
+
+		t.Run(string(ty.ID()), func(t *testing.T) {
+
+			t.Parallel()
+
+			result, err := TypeFromID(ty.ID(), resolve)
+			require.NoError(t, err)
+
+			assert.True(t, result.Equal(ty))
+			assert.Equal(t, ty.ID(), result.ID())
+		})
+	}
+
+	t.Run("invalid", func(t *testing.T) {
+
+		t.Parallel()
+
+		for _, id := range []TypeID{
+			"",
+			"Foo",
+			"[Int",
+			"[Int;x]",
+			"[Int;]",
+			"[Int;-1]",
+			"[Int;+1]",
+			"[Int;01]",
+			"[Int; 1]",
+			"{Int:}",
+			"Int?}",
+			"S.a.X",
+			"AnyResource{Int}",
+			"&",
+			"((Int;):Void)",
+			"((Int;01):Void)",
+			"((Int;1;2):Void)",
+			"((Int,;1):Void)",
+		} {
+			_, err := TypeFromID(id, resolve)
+			require.IsType(t, &InvalidTypeIDError{}, err, string(id))
+		}
+	})
+}
+
+func TestParseCompositeTypeID(t *testing.T) {
+
+	t.Parallel()
+
+	type testCase struct {
+		id                  TypeID
+		location            ast.Location
+		qualifiedIdentifier string
+	}
+
+	address := ast.AddressLocation{0x1}
+
+	for name, test := range map[string]testCase{
+		"identifier location": {
+			id:                  "I.a.T",
+			location:            ast.IdentifierLocation("a"),
+			qualifiedIdentifier: "T",
+		},
+		"string location": {
+			id:                  "S.a.T",
+			location:            ast.StringLocation("a"),
+			qualifiedIdentifier: "T",
+		},
+		"address location": {
+			id:                  "A.0000000000000001.T",
+			location:            address,
+			qualifiedIdentifier: "T",
+		},
+		"address contract location": {
+			id: "AC.0000000000000001.C.C",
+			location: ast.AddressContractLocation{
+				AddressLocation: address,
+				Name:            "C",
+			},
+			qualifiedIdentifier: "C",
+		},
+		"nested": {
+			id:                  "S.a.A.B",
+			location:            ast.StringLocation("a"),
+			qualifiedIdentifier: "A.B",
+		},
+		"deeply nested": {
+			id:                  "A.0000000000000001.A.B.C.D",
+			location:            address,
+			qualifiedIdentifier: "A.B.C.D",
+		},
+		"deeply nested in address contract location": {
+			id: "AC.0000000000000001.C.C.R.E",
+			location: ast.AddressContractLocation{
+				AddressLocation: address,
+				Name:            "C",
+			},
+			qualifiedIdentifier: "C.R.E",
+		},
+	} {
+		// NOTE: don't capture loop variable
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+
+			t.Parallel()
+
+			location, qualifiedIdentifier, err := parseCompositeTypeID(test.id)
+			require.NoError(t, err)
+
+			assert.Equal(t, test.location.ID(), location.ID())
+			assert.Equal(t, test.qualifiedIdentifier, qualifiedIdentifier)
+		})
+	}
+
+	t.Run("round trip", func(t *testing.T) {
+
+		t.Parallel()
+
+		outerType := &CompositeType{
+			Location:   ast.AddressContractLocation{AddressLocation: address, Name: "C"},
+			Identifier: "C",
+			Kind:       common.CompositeKindContract,
+		}
+
+		innerType := &CompositeType{
+			Location:      outerType.Location,
+			Identifier:    "R",
+			Kind:          common.CompositeKindResource,
+			ContainerType: outerType,
+		}
+
+		interfaceType := &InterfaceType{
+			Location:      outerType.Location,
+			Identifier:    "I",
+			CompositeKind: common.CompositeKindResource,
+			ContainerType: innerType,
+		}
+
+		for _, ty := range []Type{outerType, innerType, interfaceType} {
+
+			location, qualifiedIdentifier, err := parseCompositeTypeID(ty.ID())
+			require.NoError(t, err)
+
+			assert.Equal(t, outerType.Location.ID(), location.ID())
+			assert.Equal(t, ty.QualifiedString(), qualifiedIdentifier)
+		}
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+
+		t.Parallel()
+
+		for _, id := range []TypeID{
+			"",
+			"T",
+			"X.a.T",
+			"S.a",
+			"S.a.",
+			"S.a.A..B",
+			"S.a.A.",
+			"A.1.T",
+			"A.xyz.T",
+			"AC.0000000000000001.C",
+		} {
+			_, _, err := parseCompositeTypeID(id)
+			require.IsType(t, &InvalidTypeIDError{}, err, string(id))
+		}
+	})
+}
@@ -513,6 +513,55 @@ func TestRestrictedType_GetMember(t *testing.T) {
 	})
 }
 
+func TestFunctionType_RewriteWithRestrictedTypes_TypeArgumentCheck(t *testing.T) {
+
+	t.Parallel()
+
+	interfaceType := &InterfaceType{
+		Location:      ast.StringLocation("test"),
+		Identifier:    "I",
+		CompositeKind: common.CompositeKindResource,
+	}
+
+	checked := false
+
+	ty := &FunctionType{
+		TypeParameters: []*TypeParameter{
+			{
+				Name:      "T",
+				TypeBound: &ReferenceType{Type: interfaceType},
+				TypeArgumentCheck: func(_ Type, _ ast.Range) error {
+					checked = true
+					return nil
+				},
+			},
+		},
+		ReturnTypeAnnotation: NewTypeAnnotation(&VoidType{}),
+	}
+
+	rewrittenType, rewritten := ty.RewriteWithRestrictedTypes()
+	require.True(t, rewritten)
+
+	rewrittenTypeParameter := rewrittenType.(*FunctionType).TypeParameters[0]
+
+	assert.Equal(t,
+		&ReferenceType{
+			Type: &RestrictedType{
+				Type:         &AnyResourceType{},
+				Restrictions: []*InterfaceType{interfaceType},
+			},
+		},
+		rewrittenTypeParameter.TypeBound,
+	)
+
+	require.NotNil(t, rewrittenTypeParameter.TypeArgumentCheck)
+
+	err := rewrittenTypeParameter.TypeArgumentCheck(&AnyResourceType{}, ast.Range{})
+	require.NoError(t, err)
+
+	assert.True(t, checked)
+}
+
 func TestBeforeType_Strings(t *testing.T) {
 
 	t.Parallel()
@@ -529,3 +578,825 @@ func TestBeforeType_Strings(t *testing.T) {
 		beforeType.QualifiedString(),
 	)
 }
+
+func TestIsComparable(t *testing.T) {
+
+	t.Parallel()
+
+	comparableTypes := []Type{
+		&CharacterType{},
+		&StringType{},
+		&AddressType{},
+		&PathType{},
+	}
+	for _, ty := range AllNumberTypes {
+		if isLeafNumberType(ty) {
+			comparableTypes = append(comparableTypes, ty)
+		}
+	}
+
+	for _, ty := range comparableTypes {
+		assert.True(t, ty.IsComparable(), ty.String())
+	}
+
+	// The abstract number types are not comparable,
+	// as their values may have different concrete types
+
+	nonComparableTypes := []Type{
+		&NumberType{},
+		&SignedNumberType{},
+		&IntegerType{},
+		&SignedIntegerType{},
+		&FixedPointType{},
+		&SignedFixedPointType{},
+		&BoolType{},
+		&AnyStructType{},
+		&NeverType{},
+		&VoidType{},
+		&MetaType{},
+		&OptionalType{Type: &IntType{}},
+		&VariableSizedType{Type: &IntType{}},
+		&DictionaryType{
+			KeyType:   &StringType{},
+			ValueType: &IntType{},
+		},
+		&ReferenceType{Type: &IntType{}},
+		&FunctionType{
+			ReturnTypeAnnotation: NewTypeAnnotation(&IntType{}),
+		},
+	}
+
+	for _, ty := range nonComparableTypes {
+		assert.False(t, ty.IsComparable(), ty.String())
+	}
+}
+
+func TestCompositeType_FieldType(t *testing.T) {
+
+	t.Parallel()
+
+	compositeType := &CompositeType{
+		Kind:       common.CompositeKindStructure,
+		Identifier: "S",
+		Location:   ast.StringLocation("a"),
+		Fields:     []string{"x"},
+		Members:    map[string]*Member{},
+	}
+
+	compositeType.Members["x"] = NewPublicConstantFieldMember(
+		compositeType,
+		"x",
+		&IntType{},
+		"",
+	)
+
+	compositeType.Members["f"] = NewPublicFunctionMember(
+		compositeType,
+		"f",
+		&FunctionType{
+			ReturnTypeAnnotation: NewTypeAnnotation(&VoidType{}),
+		},
+		"",
+	)
+
+	t.Run("field", func(t *testing.T) {
+
+		t.Parallel()
+
+		fieldType, ok := compositeType.FieldType("x")
+		require.True(t, ok)
+		assert.Equal(t, &IntType{}, fieldType)
+	})
+
+	t.Run("function", func(t *testing.T) {
+
+		t.Parallel()
+
+		fieldType, ok := compositeType.FieldType("f")
+		require.False(t, ok)
+		assert.Nil(t, fieldType)
+	})
+
+	t.Run("absent", func(t *testing.T) {
+
+		t.Parallel()
+
+		fieldType, ok := compositeType.FieldType("y")
+		require.False(t, ok)
+		assert.Nil(t, fieldType)
+	})
+}
+
+func TestInterfaceType_FieldType(t *testing.T) {
+
+	t.Parallel()
+
+	interfaceType := &InterfaceType{
+		CompositeKind: common.CompositeKindStructure,
+		Identifier:    "I",
+		Location:      ast.StringLocation("a"),
+		Members:       map[string]*Member{},
+	}
+
+	interfaceType.Members["x"] = NewPublicConstantFieldMember(
+		interfaceType,
+		"x",
+		&StringType{},
+		"",
+	)
+
+	interfaceType.Members["f"] = NewPublicFunctionMember(
+		interfaceType,
+		"f",
+		&FunctionType{
+			ReturnTypeAnnotation: NewTypeAnnotation(&VoidType{}),
+		},
+		"",
+	)
+
+	fieldType, ok := interfaceType.FieldType("x")
+	require.True(t, ok)
+	assert.Equal(t, &StringType{}, fieldType)
+
+	_, ok = interfaceType.FieldType("f")
+	assert.False(t, ok)
+
+	_, ok = interfaceType.FieldType("y")
+	assert.False(t, ok)
+}
+
+func TestCompositeType_FunctionType(t *testing.T) {
+
+	t.Parallel()
+
+	compositeType := &CompositeType{
+		Kind:       common.CompositeKindStructure,
+		Identifier: "S",
+		Location:   ast.StringLocation("a"),
+		Fields:     []string{"x"},
+		Members:    map[string]*Member{},
+	}
+
+	compositeType.Members["x"] = NewPublicConstantFieldMember(
+		compositeType,
+		"x",
+		&IntType{},
+		"",
+	)
+
+	functionType := &FunctionType{
+		ReturnTypeAnnotation: NewTypeAnnotation(&VoidType{}),
+	}
+
+	compositeType.Members["f"] = NewPublicFunctionMember(
+		compositeType,
+		"f",
+		functionType,
+		"",
+	)
+
+	result, ok := compositeType.FunctionType("f")
+	require.True(t, ok)
+	assert.Same(t, functionType, result)
+
+	_, ok = compositeType.FunctionType("x")
+	assert.False(t, ok)
+
+	_, ok = compositeType.FunctionType("y")
+	assert.False(t, ok)
+}
+
+func TestInterfaceType_FunctionType(t *testing.T) {
+
+	t.Parallel()
+
+	interfaceType := &InterfaceType{
+		CompositeKind: common.CompositeKindStructure,
+		Identifier:    "I",
+		Location:      ast.StringLocation("a"),
+		Members:       map[string]*Member{},
+	}
+
+	interfaceType.Members["x"] = NewPublicConstantFieldMember(
+		interfaceType,
+		"x",
+		&StringType{},
+		"",
+	)
+
+	functionType := &FunctionType{
+		Parameters: []*Parameter{
+			{
+				Label:          ArgumentLabelNotRequired,
+				Identifier:     "value",
+				TypeAnnotation: NewTypeAnnotation(&IntType{}),
+			},
+		},
+		ReturnTypeAnnotation: NewTypeAnnotation(&BoolType{}),
+	}
+
+	interfaceType.Members["f"] = NewPublicFunctionMember(
+		interfaceType,
+		"f",
+		functionType,
+		"",
+	)
+
+	result, ok := interfaceType.FunctionType("f")
+	require.True(t, ok)
+	assert.Same(t, functionType, result)
+
+	_, ok = interfaceType.FunctionType("x")
+	assert.False(t, ok)
+
+	_, ok = interfaceType.FunctionType("y")
+	assert.False(t, ok)
+}
+
+func TestCompositeType_Conformances(t *testing.T) {
+
+	t.Parallel()
+
+	location := ast.StringLocation("a")
+
+	newInterfaceType := func(identifier string) *InterfaceType {
+		return &InterfaceType{
+			Location:      location,
+			Identifier:    identifier,
+			CompositeKind: common.CompositeKindResource,
+			Members:       map[string]*Member{},
+		}
+	}
+
+	interfaceType1 := newInterfaceType("I1")
+	interfaceType2 := newInterfaceType("I2")
+
+	contractInterfaceType := &InterfaceType{
+		Location:      location,
+		Identifier:    "C",
+		CompositeKind: common.CompositeKindContract,
+		Members:       map[string]*Member{},
+	}
+
+	typeRequirement := &CompositeType{
+		Location:      location,
+		Identifier:    "R",
+		Kind:          common.CompositeKindResource,
+		Members:       map[string]*Member{},
+		ContainerType: contractInterfaceType,
+	}
+
+	compositeType := &CompositeType{
+		Location:   location,
+		Identifier: "R",
+		Kind:       common.CompositeKindResource,
+		ExplicitInterfaceConformances: []*InterfaceType{
+			interfaceType2,
+			interfaceType1,
+			interfaceType2,
+		},
+		Members: map[string]*Member{},
+	}
+
+	compositeType.AddImplicitTypeRequirementConformance(typeRequirement)
+	compositeType.AddImplicitTypeRequirementConformance(typeRequirement)
+
+	conformances := compositeType.Conformances()
+
+	require.Len(t, conformances, 3)
+
+	assert.Same(t, interfaceType2, conformances[0])
+	assert.Same(t, interfaceType1, conformances[1])
+	assert.Equal(t, TypeID("S.a.C.R"), conformances[2].ID())
+}
+
+func TestFlattenNestedTypes(t *testing.T) {
+
+	t.Parallel()
+
+	location := ast.StringLocation("a")
+
+	contractType := &CompositeType{
+		Location:    location,
+		Identifier:  "C",
+		Kind:        common.CompositeKindContract,
+		Members:     map[string]*Member{},
+		nestedTypes: map[string]Type{},
+	}
+
+	interfaceType := &InterfaceType{
+		Location:      location,
+		Identifier:    "I",
+		CompositeKind: common.CompositeKindStructure,
+		Members:       map[string]*Member{},
+		nestedTypes:   map[string]Type{},
+		ContainerType: contractType,
+	}
+
+	structType := &CompositeType{
+		Location:      location,
+		Identifier:    "S",
+		Kind:          common.CompositeKindStructure,
+		Members:       map[string]*Member{},
+		nestedTypes:   map[string]Type{},
+		ContainerType: contractType,
+	}
+
+	resourceType := &CompositeType{
+		Location:      location,
+		Identifier:    "R",
+		Kind:          common.CompositeKindResource,
+		Members:       map[string]*Member{},
+		nestedTypes:   map[string]Type{},
+		ContainerType: contractType,
+	}
+
+	eventType := &CompositeType{
+		Location:      location,
+		Identifier:    "E",
+		Kind:          common.CompositeKindEvent,
+		Members:       map[string]*Member{},
+		ContainerType: contractType,
+	}
+
+	nestedInterfaceType := &InterfaceType{
+		Location:      location,
+		Identifier:    "NI",
+		CompositeKind: common.CompositeKindResource,
+		Members:       map[string]*Member{},
+		nestedTypes:   map[string]Type{},
+		ContainerType: interfaceType,
+	}
+
+	contractType.nestedTypes["S"] = structType
+	contractType.nestedTypes["I"] = interfaceType
+	contractType.nestedTypes["R"] = resourceType
+	contractType.nestedTypes["E"] = eventType
+	interfaceType.nestedTypes["NI"] = nestedInterfaceType
+
+	expected := []Type{
+		contractType,
+		eventType,
+		interfaceType,
+		nestedInterfaceType,
+		resourceType,
+		structType,
+	}
+
+	// Each container is followed by its nested types, sorted by identifier
+
+	flattened := FlattenNestedTypes(contractType)
+
+	require.Len(t, flattened, len(expected))
+
+	for i, expectedType := range expected {
+		assert.Same(t, expectedType, flattened[i])
+	}
+
+	// The result contains the same types as visited by `VisitContainerAndNested`
+
+	var visited []Type
+	VisitContainerAndNested(contractType, func(ty Type) {
+		visited = append(visited, ty)
+	})
+
+	assert.ElementsMatch(t, expected, visited)
+
+	// A container without nested types is flattened to just itself
+
+	assert.Equal(t,
+		[]Type{structType},
+		FlattenNestedTypes(structType),
+	)
+}
+
+func TestCompositeType_IsStorable_SelfReferential(t *testing.T) {
+
+	t.Parallel()
+
+	newCompositeType := func(identifier string) *CompositeType {
+		return &CompositeType{
+			Kind:       common.CompositeKindResource,
+			Identifier: identifier,
+			Location:   ast.StringLocation("a"),
+			Members:    map[string]*Member{},
+		}
+	}
+
+	addField := func(compositeType *CompositeType, identifier string, fieldType Type) {
+		compositeType.Fields = append(compositeType.Fields, identifier)
+		compositeType.Members[identifier] = NewPublicConstantFieldMember(
+			compositeType,
+			identifier,
+			fieldType,
+			"",
+		)
+	}
+
+	nonStorableType := &FunctionType{
+		ReturnTypeAnnotation: NewTypeAnnotation(&IntType{}),
+	}
+
+	t.Run("direct", func(t *testing.T) {
+
+		t.Parallel()
+
+		ty := newCompositeType("R")
+		addField(ty, "r", ty)
+
+		assert.True(t, ty.IsStorable(map[*Member]bool{}))
+		assert.True(t, ty.Members["r"].IsStorable(map[*Member]bool{}))
+	})
+
+	t.Run("optional", func(t *testing.T) {
+
+		t.Parallel()
+
+		ty := newCompositeType("R")
+		addField(ty, "r", &OptionalType{Type: ty})
+
+		assert.True(t, ty.IsStorable(map[*Member]bool{}))
+		assert.True(t, ty.Members["r"].IsStorable(map[*Member]bool{}))
+	})
+
+	t.Run("array", func(t *testing.T) {
+
+		t.Parallel()
+
+		ty := newCompositeType("R")
+		addField(ty, "rs", &VariableSizedType{Type: ty})
+
+		assert.True(t, ty.IsStorable(map[*Member]bool{}))
+		assert.True(t, ty.Members["rs"].IsStorable(map[*Member]bool{}))
+	})
+
+	t.Run("non-storable field", func(t *testing.T) {
+
+		t.Parallel()
+
+		ty := newCompositeType("R")
+		addField(ty, "r", &OptionalType{Type: ty})
+		addField(ty, "rs", &VariableSizedType{Type: ty})
+		addField(ty, "f", nonStorableType)
+
+		assert.False(t, ty.IsStorable(map[*Member]bool{}))
+
+		for _, field := range ty.Fields {
+			assert.False(t,
+				ty.Members[field].IsStorable(map[*Member]bool{}),
+				field,
+			)
+		}
+	})
+
+	t.Run("indirect, non-storable field", func(t *testing.T) {
+
+		t.Parallel()
+
+		// R has a field of type S, which in turn has a field of type R?
+		// and a non-storable field.
+		// All fields of R are non-storable, independent of the order
+		// in which the members are checked
+
+		r := newCompositeType("R")
+		s := newCompositeType("S")
+
+		addField(s, "r", &OptionalType{Type: r})
+		addField(s, "f", nonStorableType)
+
+		addField(r, "s", s)
+		addField(r, "rs", &VariableSizedType{Type: r})
+
+		assert.False(t, r.IsStorable(map[*Member]bool{}))
+		assert.False(t, s.IsStorable(map[*Member]bool{}))
+
+		for _, field := range r.Fields {
+			assert.False(t,
+				r.Members[field].IsStorable(map[*Member]bool{}),
+				field,
+			)
+		}
+	})
+}
+
+func TestFunctionType_Equal_RequiredArgumentCount(t *testing.T) {
+
+	t.Parallel()
+
+	newFunctionType := func(requiredArgumentCount *int) *FunctionType {
+		return &FunctionType{
+			Parameters: []*Parameter{
+				{
+					Identifier:     "a",
+					TypeAnnotation: NewTypeAnnotation(&IntType{}),
+				},
+				{
+					Identifier:     "b",
+					TypeAnnotation: NewTypeAnnotation(&IntType{}),
+				},
+			},
+			ReturnTypeAnnotation:  NewTypeAnnotation(&VoidType{}),
+			RequiredArgumentCount: requiredArgumentCount,
+		}
+	}
+
+	count := func(count int) *int {
+		return &count
+	}
+
+	t.Run("both without", func(t *testing.T) {
+
+		t.Parallel()
+
+		assert.True(t, newFunctionType(nil).Equal(newFunctionType(nil)))
+	})
+
+	t.Run("same", func(t *testing.T) {
+
+		t.Parallel()
+
+		assert.True(t, newFunctionType(count(1)).Equal(newFunctionType(count(1))))
+	})
+
+	t.Run("different", func(t *testing.T) {
+
+		t.Parallel()
+
+		assert.False(t, newFunctionType(count(1)).Equal(newFunctionType(count(2))))
+		assert.False(t, newFunctionType(count(2)).Equal(newFunctionType(count(1))))
+	})
+
+	t.Run("one without", func(t *testing.T) {
+
+		t.Parallel()
+
+		assert.False(t, newFunctionType(nil).Equal(newFunctionType(count(1))))
+		assert.False(t, newFunctionType(count(1)).Equal(newFunctionType(nil)))
+	})
+
+	t.Run("one without, other requires all", func(t *testing.T) {
+
+		t.Parallel()
+
+		assert.False(t, newFunctionType(nil).Equal(newFunctionType(count(2))))
+		assert.False(t, newFunctionType(count(2)).Equal(newFunctionType(nil)))
+	})
+
+	t.Run("ID", func(t *testing.T) {
+
+		t.Parallel()
+
+		assert.Equal(t, TypeID("((Int,Int):Void)"), newFunctionType(nil).ID())
+		assert.Equal(t, TypeID("((Int,Int;1):Void)"), newFunctionType(count(1)).ID())
+		assert.Equal(t, TypeID("((Int,Int;2):Void)"), newFunctionType(count(2)).ID())
+	})
+}
+
+func TestTypeTag(t *testing.T) {
+
+	t.Parallel()
+
+	types := []Type{
+		&MetaType{},
+		&AnyType{},
+		&AnyStructType{},
+		&AnyResourceType{},
+		&NeverType{},
+		&VoidType{},
+		&InvalidType{},
+		&OptionalType{Type: &IntType{}},
+		&GenericType{},
+		&BoolType{},
+		&CharacterType{},
+		&StringType{},
+		&VariableSizedType{Type: &IntType{}},
+		&ConstantSizedType{Type: &IntType{}, Size: 1},
+		&FunctionType{},
+		&CompositeType{},
+		&AuthAccountType{},
+		&PublicAccountType{},
+		&InterfaceType{},
+		&DictionaryType{KeyType: &IntType{}, ValueType: &IntType{}},
+		&ReferenceType{Type: &IntType{}},
+		&AddressType{},
+		&TransactionType{},
+		&RestrictedType{Type: &AnyStructType{}},
+		&PathType{},
+		&CapabilityType{},
+		&StorableType{},
+	}
+
+	for _, ty := range AllNumberTypes {
+		types = append(types, ty)
+	}
+
+	seen := map[TypeTag]Type{}
+
+	for _, ty := range types {
+
+		tag := ty.Tag()
+
+		assert.NotEqual(t, TypeTagUnknown, tag)
+		assert.Less(t, uint(tag), uint(typeTagCount))
+
+		if other, ok := seen[tag]; ok {
+			assert.Fail(t, "duplicate type tag", "%s: %T and %T", tag, other, ty)
+		}
+		seen[tag] = ty
+	}
+}
+
+func TestIsSubType_AbstractNumberTypes(t *testing.T) {
+
+	t.Parallel()
+
+	// The abstract number types are only subtypes of themselves
+	// and of their abstract supertypes.
+	//
+	// In particular, `SignedNumber` is a supertype of `SignedFixedPoint`,
+	// not a subtype of it
+
+	assert.False(t, IsSubType(&SignedNumberType{}, &SignedFixedPointType{}))
+	assert.True(t, IsSubType(&SignedFixedPointType{}, &SignedNumberType{}))
+
+	assert.False(t, IsSubType(&SignedNumberType{}, &SignedIntegerType{}))
+	assert.True(t, IsSubType(&SignedIntegerType{}, &SignedNumberType{}))
+
+	assert.False(t, IsSubType(&NumberType{}, &FixedPointType{}))
+	assert.True(t, IsSubType(&FixedPointType{}, &NumberType{}))
+
+	assert.True(t, IsSubType(&SignedFixedPointType{}, &FixedPointType{}))
+	assert.False(t, IsSubType(&FixedPointType{}, &SignedFixedPointType{}))
+}
+
+func BenchmarkIsSubType(b *testing.B) {
+
+	superTypes := []Type{
+		&NumberType{},
+		&SignedNumberType{},
+		&IntegerType{},
+		&SignedIntegerType{},
+		&FixedPointType{},
+		&SignedFixedPointType{},
+		&AnyStructType{},
+	}
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		for _, subType := range AllNumberTypes {
+			for _, superType := range superTypes {
+				IsSubType(subType, superType)
+			}
+			isLeafNumberType(subType)
+		}
+	}
+}
+
+func TestIsNilType(t *testing.T) {
+
+	t.Parallel()
+
+	tests := map[Type]bool{
+		&NeverType{}: false,
+		&OptionalType{
+			Type: &NeverType{},
+		}: true,
+		&OptionalType{
+			Type: &OptionalType{
+				Type: &NeverType{},
+			},
+		}: true,
+		&IntType{}: false,
+		&OptionalType{
+			Type: &IntType{},
+		}: false,
+		&OptionalType{
+			Type: &OptionalType{
+				Type: &IntType{},
+			},
+		}: false,
+	}
+
+	for ty, expected := range tests {
+		assert.Equal(t, expected, IsNilType(ty), ty.String())
+	}
+}
+
+func TestAreCompatibleEquatableTypes_Nil(t *testing.T) {
+
+	t.Parallel()
+
+	doubleOptionalIntType := &OptionalType{
+		Type: &OptionalType{
+			Type: &IntType{},
+		},
+	}
+
+	for _, nilType := range []Type{
+		&OptionalType{
+			Type: &NeverType{},
+		},
+		&OptionalType{
+			Type: &OptionalType{
+				Type: &NeverType{},
+			},
+		},
+	} {
+		assert.True(t, AreCompatibleEquatableTypes(doubleOptionalIntType, nilType), nilType.String())
+		assert.True(t, AreCompatibleEquatableTypes(nilType, doubleOptionalIntType), nilType.String())
+	}
+
+	assert.False(t,
+		AreCompatibleEquatableTypes(
+			doubleOptionalIntType,
+			&NeverType{},
+		),
+	)
+}
+
+func TestReferenceType_ReferencesResource(t *testing.T) {
+
+	t.Parallel()
+
+	resourceType := &CompositeType{
+		Location:   ast.StringLocation("test"),
+		Identifier: "R",
+		Kind:       common.CompositeKindResource,
+	}
+
+	structType := &CompositeType{
+		Location:   ast.StringLocation("test"),
+		Identifier: "S",
+		Kind:       common.CompositeKindStructure,
+	}
+
+	tests := map[Type]bool{
+		resourceType:                              true,
+		&OptionalType{Type: resourceType}:         true,
+		&AnyResourceType{}:                        true,
+		&RestrictedType{Type: &AnyResourceType{}}: true,
+		structType:                                false,
+		&OptionalType{Type: structType}:           false,
+		&AnyStructType{}:                          false,
+		&IntType{}:                                false,
+	}
+
+	for referencedType, expected := range tests {
+
+		referenceType := &ReferenceType{
+			Type: referencedType,
+		}
+
+		assert.Equal(t, expected, referenceType.ReferencesResource(), referencedType.String())
+
+		// References are never resources themselves
+
+		assert.False(t, referenceType.IsResourceType(), referencedType.String())
+	}
+}
+
+func TestFunctionType_RewriteWithRestrictedTypes_Constraints(t *testing.T) {
+
+	t.Parallel()
+
+	interfaceType := &InterfaceType{
+		Location:      ast.StringLocation("test"),
+		Identifier:    "I",
+		CompositeKind: common.CompositeKindResource,
+	}
+
+	ty := &FunctionType{
+		TypeParameters: []*TypeParameter{
+			{
+				Name:      "T",
+				TypeBound: &AnyResourceType{},
+				Constraints: []Type{
+					interfaceType,
+				},
+			},
+		},
+		ReturnTypeAnnotation: NewTypeAnnotation(&VoidType{}),
+	}
+
+	rewrittenType, rewritten := ty.RewriteWithRestrictedTypes()
+	require.True(t, rewritten)
+
+	rewrittenTypeParameter := rewrittenType.(*FunctionType).TypeParameters[0]
+
+	assert.Equal(t,
+		&AnyResourceType{},
+		rewrittenTypeParameter.TypeBound,
+	)
+
+	assert.Equal(t,
+		[]Type{
+			&RestrictedType{
+				Type:         &AnyResourceType{},
+				Restrictions: []*InterfaceType{interfaceType},
+			},
+		},
+		rewrittenTypeParameter.Constraints,
+	)
+}
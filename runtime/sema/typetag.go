@@ -0,0 +1,108 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2020 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package sema
+
+//go:generate go run golang.org/x/tools/cmd/stringer -type=TypeTag
+
+// TypeTag is a discriminator for the concrete type of a `Type`,
+// which allows switching on types without type switches or type assertions
+//
+type TypeTag uint
+
+const (
+	TypeTagUnknown TypeTag = iota
+	TypeTagMeta
+	TypeTagAny
+	TypeTagAnyStruct
+	TypeTagAnyResource
+	TypeTagNever
+	TypeTagVoid
+	TypeTagInvalid
+	TypeTagOptional
+	TypeTagGeneric
+	TypeTagBool
+	TypeTagCharacter
+	TypeTagString
+	TypeTagNumber
+	TypeTagSignedNumber
+	TypeTagInteger
+	TypeTagSignedInteger
+	TypeTagInt
+	TypeTagInt8
+	TypeTagInt16
+	TypeTagInt32
+	TypeTagInt64
+	TypeTagInt128
+	TypeTagInt256
+	TypeTagUInt
+	TypeTagUInt8
+	TypeTagUInt16
+	TypeTagUInt32
+	TypeTagUInt64
+	TypeTagUInt128
+	TypeTagUInt256
+	TypeTagWord8
+	TypeTagWord16
+	TypeTagWord32
+	TypeTagWord64
+	TypeTagFixedPoint
+	TypeTagSignedFixedPoint
+	TypeTagFix64
+	TypeTagUFix64
+	TypeTagVariableSized
+	TypeTagConstantSized
+	TypeTagFunction
+	TypeTagComposite
+	TypeTagAuthAccount
+	TypeTagPublicAccount
+	TypeTagInterface
+	TypeTagDictionary
+	TypeTagReference
+	TypeTagAddress
+	TypeTagTransaction
+	TypeTagRestricted
+	TypeTagPath
+	TypeTagCapability
+	TypeTagStorable
+	TypeTagBlock
+
+	// NOTE: must be last
+	typeTagCount
+)
+
+// typeTagSet is a set of type tags
+//
+type typeTagSet uint64
+
+func init() {
+	if typeTagCount > 64 {
+		panic("too many type tags for type tag set")
+	}
+}
+
+func newTypeTagSet(tags ...TypeTag) (set typeTagSet) {
+	for _, tag := range tags {
+		set |= 1 << tag
+	}
+	return
+}
+
+func (s typeTagSet) Contains(tag TypeTag) bool {
+	return s&(1<<tag) != 0
+}
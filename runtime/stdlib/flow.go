@@ -266,6 +266,10 @@ type BlockType struct{}
 
 func (*BlockType) IsType() {}
 
+func (*BlockType) Tag() sema.TypeTag {
+	return sema.TypeTagBlock
+}
+
 func (*BlockType) String() string {
 	return "Block"
 }
@@ -304,6 +308,10 @@ func (*BlockType) IsEquatable() bool {
 	return false
 }
 
+func (*BlockType) IsComparable() bool {
+	return false
+}
+
 func (t *BlockType) RewriteWithRestrictedTypes() (sema.Type, bool) {
 	return t, false
 }
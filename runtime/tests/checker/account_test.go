@@ -49,6 +49,7 @@ func ParseAndCheckAccount(t *testing.T, code string) (*sema.Checker, error) {
 					"authAccount":   constantDeclaration("authAccount", &sema.AuthAccountType{}),
 					"publicAccount": constantDeclaration("publicAccount", &sema.PublicAccountType{}),
 				}),
+				sema.WithStorageIteration(true),
 			},
 		},
 	)
@@ -309,6 +310,138 @@ func TestCheckAccount_save(t *testing.T) {
 
 }
 
+func TestCheckAccount_trySave(t *testing.T) {
+
+	t.Parallel()
+
+	for _, domain := range common.AllPathDomainsByIdentifier {
+
+		// NOTE: all domains are statically valid at the moment
+
+		domainName := domain.Name()
+		domainIdentifier := domain.Identifier()
+
+		t.Run(fmt.Sprintf("implicit type argument, %s, struct", domainName), func(t *testing.T) {
+
+			t.Parallel()
+
+			checker, err := ParseAndCheckAccount(t,
+				fmt.Sprintf(
+					`
+                      struct S {}
+
+                      let saved = authAccount.trySave(S(), to: /%s/s)
+                    `,
+					domainIdentifier,
+				),
+			)
+
+			require.NoError(t, err)
+
+			assert.Equal(t,
+				&sema.BoolType{},
+				checker.GlobalValues["saved"].Type,
+			)
+		})
+
+		t.Run(fmt.Sprintf("explicit type argument, %s, struct", domainName), func(t *testing.T) {
+
+			t.Parallel()
+
+			checker, err := ParseAndCheckAccount(t,
+				fmt.Sprintf(
+					`
+                      struct S {}
+
+                      let saved = authAccount.trySave<S>(S(), to: /%s/s)
+                    `,
+					domainIdentifier,
+				),
+			)
+
+			require.NoError(t, err)
+
+			assert.Equal(t,
+				&sema.BoolType{},
+				checker.GlobalValues["saved"].Type,
+			)
+		})
+
+		t.Run(fmt.Sprintf("implicit type argument, %s, resource", domainName), func(t *testing.T) {
+
+			t.Parallel()
+
+			_, err := ParseAndCheckAccount(t,
+				fmt.Sprintf(
+					`
+                      resource R {}
+
+                      fun test() {
+                          let r <- create R()
+                          authAccount.trySave(<-r, to: /%s/r)
+                      }
+                    `,
+					domainIdentifier,
+				),
+			)
+
+			errs := ExpectCheckerErrors(t, err, 1)
+
+			require.IsType(t, &sema.InvalidResourceTrySaveError{}, errs[0])
+		})
+
+		t.Run(fmt.Sprintf("explicit type argument, %s, resource", domainName), func(t *testing.T) {
+
+			t.Parallel()
+
+			_, err := ParseAndCheckAccount(t,
+				fmt.Sprintf(
+					`
+                      resource R {}
+
+                      fun test() {
+                          let r <- create R()
+                          authAccount.trySave<@R>(<-r, to: /%s/r)
+                      }
+                    `,
+					domainIdentifier,
+				),
+			)
+
+			errs := ExpectCheckerErrors(t, err, 1)
+
+			require.IsType(t, &sema.InvalidResourceTrySaveError{}, errs[0])
+
+			assert.Equal(t,
+				"cannot try to save resource type: `R`",
+				errs[0].Error(),
+			)
+		})
+
+		t.Run(fmt.Sprintf("non-storable, %s", domainName), func(t *testing.T) {
+
+			t.Parallel()
+
+			_, err := ParseAndCheckAccount(t,
+				fmt.Sprintf(
+					`
+                      fun one(): Int {
+                          return 1
+                      }
+
+                      let saved = authAccount.trySave(one, to: /%s/one)
+                    `,
+					domainIdentifier,
+				),
+			)
+
+			errs := ExpectCheckerErrors(t, err, 1)
+
+			require.IsType(t, &sema.TypeMismatchError{}, errs[0])
+		})
+	}
+}
+
 func TestCheckAccount_load(t *testing.T) {
 
 	t.Parallel()
@@ -430,6 +563,48 @@ func TestCheckAccount_load(t *testing.T) {
 	}
 }
 
+func TestCheckAccount_loadStrict(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("explicit type argument", func(t *testing.T) {
+
+		t.Parallel()
+
+		checker, err := ParseAndCheckAccount(t,
+			`
+              resource R {}
+
+              let r <- authAccount.loadStrict<@R>(from: /storage/r)
+            `,
+		)
+
+		require.NoError(t, err)
+
+		assert.Equal(t,
+			&sema.OptionalType{
+				Type: checker.GlobalTypes["R"].Type,
+			},
+			checker.GlobalValues["r"].Type,
+		)
+	})
+
+	t.Run("missing type argument", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheckAccount(t,
+			`
+              let s = authAccount.loadStrict(from: /storage/s)
+            `,
+		)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		require.IsType(t, &sema.TypeParameterTypeInferenceError{}, errs[0])
+	})
+}
+
 func TestCheckAccount_copy(t *testing.T) {
 
 	t.Parallel()
@@ -515,12 +690,165 @@ func TestCheckAccount_copy(t *testing.T) {
 
 				errs := ExpectCheckerErrors(t, err, 1)
 
-				require.IsType(t, &sema.TypeMismatchError{}, errs[0])
+				require.IsType(t, &sema.InvalidResourceCopyError{}, errs[0])
+
+				assert.Equal(t,
+					"cannot copy resource type: `R`",
+					errs[0].Error(),
+				)
 			})
 		})
 	}
 }
 
+func TestCheckAccount_type(t *testing.T) {
+
+	t.Parallel()
+
+	for _, domain := range common.AllPathDomainsByIdentifier {
+
+		// NOTE: all domains are statically valid at the moment
+
+		testName := fmt.Sprintf(
+			"AuthAccount.type: %s",
+			domain.Name(),
+		)
+
+		t.Run(testName, func(t *testing.T) {
+
+			checker, err := ParseAndCheckAccount(t,
+				fmt.Sprintf(
+					`
+                      let t = authAccount.type(at: /%s/s)
+                    `,
+					domain.Identifier(),
+				),
+			)
+
+			require.NoError(t, err)
+
+			require.Equal(t,
+				&sema.OptionalType{
+					Type: &sema.MetaType{},
+				},
+				checker.GlobalValues["t"].Type,
+			)
+		})
+	}
+
+	t.Run("AuthAccount.type: missing argument label", func(t *testing.T) {
+
+		_, err := ParseAndCheckAccount(t, `
+            let t = authAccount.type(/storage/s)
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		require.IsType(t, &sema.MissingArgumentLabelError{}, errs[0])
+	})
+
+	t.Run("PublicAccount.type", func(t *testing.T) {
+
+		_, err := ParseAndCheckAccount(t, `
+            let t = publicAccount.type(at: /storage/s)
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		require.IsType(t, &sema.NotDeclaredMemberError{}, errs[0])
+	})
+}
+
+func TestCheckAccount_forEachStored(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("valid", func(t *testing.T) {
+
+		_, err := ParseAndCheckAccount(t, `
+            fun test() {
+                authAccount.forEachStored(fun (path: Path, type: Type): Bool {
+                    return true
+                })
+            }
+        `)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("invalid function return type", func(t *testing.T) {
+
+		_, err := ParseAndCheckAccount(t, `
+            fun test() {
+                authAccount.forEachStored(fun (path: Path, type: Type) {})
+            }
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		require.IsType(t, &sema.TypeMismatchError{}, errs[0])
+	})
+
+	t.Run("invalid function parameter types", func(t *testing.T) {
+
+		_, err := ParseAndCheckAccount(t, `
+            fun test() {
+                authAccount.forEachStored(fun (path: Path, type: String): Bool {
+                    return true
+                })
+            }
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		require.IsType(t, &sema.TypeMismatchError{}, errs[0])
+	})
+
+	t.Run("PublicAccount", func(t *testing.T) {
+
+		_, err := ParseAndCheckAccount(t, `
+            fun test() {
+                publicAccount.forEachStored(fun (path: Path, type: Type): Bool {
+                    return true
+                })
+            }
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		require.IsType(t, &sema.NotDeclaredMemberError{}, errs[0])
+	})
+
+	t.Run("storage iteration disabled", func(t *testing.T) {
+
+		_, err := ParseAndCheckWithOptions(t,
+			`
+              fun test() {
+                  authAccount.forEachStored(fun (path: Path, type: Type): Bool {
+                      return true
+                  })
+              }
+            `,
+			ParseAndCheckOptions{
+				Options: []sema.Option{
+					sema.WithPredeclaredValues(map[string]sema.ValueDeclaration{
+						"authAccount": stdlib.StandardLibraryValue{
+							Name:       "authAccount",
+							Type:       &sema.AuthAccountType{},
+							Kind:       common.DeclarationKindConstant,
+							IsConstant: true,
+						},
+					}),
+				},
+			},
+		)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		require.IsType(t, &sema.NotDeclaredMemberError{}, errs[0])
+	})
+}
+
 func TestCheckAccount_borrow(t *testing.T) {
 
 	t.Parallel()
@@ -681,7 +1009,7 @@ func TestCheckAccount_borrow(t *testing.T) {
 
 				errs := ExpectCheckerErrors(t, err, 1)
 
-				require.IsType(t, &sema.TypeMismatchError{}, errs[0])
+				require.IsType(t, &sema.InvalidCapabilityLinkTypeError{}, errs[0])
 			})
 
 			t.Run("struct", func(t *testing.T) {
@@ -699,7 +1027,68 @@ func TestCheckAccount_borrow(t *testing.T) {
 
 				errs := ExpectCheckerErrors(t, err, 1)
 
-				require.IsType(t, &sema.TypeMismatchError{}, errs[0])
+				require.IsType(t, &sema.InvalidCapabilityLinkTypeError{}, errs[0])
+			})
+		})
+	}
+
+	for _, domain := range common.AllPathDomainsByIdentifier {
+
+		// NOTE: all domains are statically valid at the moment
+
+		testName := fmt.Sprintf(
+			"AuthAccount.borrow: explicit type argument, impossible borrow type, %s",
+			domain.Name(),
+		)
+
+		t.Run(testName, func(t *testing.T) {
+
+			t.Run("function", func(t *testing.T) {
+
+				_, err := ParseAndCheckAccount(t,
+					fmt.Sprintf(
+						`
+                          let f = authAccount.borrow<&((Int): Int)>(from: /%s/f)
+                        `,
+						domain.Identifier(),
+					),
+				)
+
+				errs := ExpectCheckerErrors(t, err, 1)
+
+				require.IsType(t, &sema.InvalidBorrowTypeError{}, errs[0])
+			})
+
+			t.Run("Never", func(t *testing.T) {
+
+				_, err := ParseAndCheckAccount(t,
+					fmt.Sprintf(
+						`
+                          let n = authAccount.borrow<&Never>(from: /%s/n)
+                        `,
+						domain.Identifier(),
+					),
+				)
+
+				errs := ExpectCheckerErrors(t, err, 1)
+
+				require.IsType(t, &sema.InvalidBorrowTypeError{}, errs[0])
+			})
+
+			t.Run("resource", func(t *testing.T) {
+
+				_, err := ParseAndCheckAccount(t,
+					fmt.Sprintf(
+						`
+                          resource Vault {}
+
+                          let vault = authAccount.borrow<&Vault>(from: /%s/vault)
+                        `,
+						domain.Identifier(),
+					),
+				)
+
+				require.NoError(t, err)
 			})
 		})
 	}
@@ -856,7 +1245,7 @@ func TestCheckAccount_link(t *testing.T) {
 
 					errs := ExpectCheckerErrors(t, err, 1)
 
-					require.IsType(t, &sema.TypeMismatchError{}, errs[0])
+					require.IsType(t, &sema.InvalidCapabilityLinkTypeError{}, errs[0])
 				})
 
 				t.Run("struct", func(t *testing.T) {
@@ -877,7 +1266,7 @@ func TestCheckAccount_link(t *testing.T) {
 
 					errs := ExpectCheckerErrors(t, err, 1)
 
-					require.IsType(t, &sema.TypeMismatchError{}, errs[0])
+					require.IsType(t, &sema.InvalidCapabilityLinkTypeError{}, errs[0])
 				})
 			})
 		}
@@ -1012,3 +1401,173 @@ func TestCheckAccount_getCapability(t *testing.T) {
 		}
 	}
 }
+
+func TestCheckAccount_capability(t *testing.T) {
+
+	t.Parallel()
+
+	for accountType, accountVariable := range map[string]string{
+		"AuthAccount":   "authAccount",
+		"PublicAccount": "publicAccount",
+	} {
+
+		accountVariable := accountVariable
+
+		t.Run(fmt.Sprintf("%s.capability: typed", accountType), func(t *testing.T) {
+
+			checker, err := ParseAndCheckAccount(t,
+				fmt.Sprintf(
+					`
+                      let cap = %[1]s.capability<&Int>(/public/r)
+                      let ref = cap.borrow()
+                    `,
+					accountVariable,
+				),
+			)
+
+			require.NoError(t, err)
+
+			assert.Equal(t,
+				&sema.CapabilityType{
+					BorrowType: &sema.ReferenceType{
+						Type: &sema.IntType{},
+					},
+				},
+				checker.GlobalValues["cap"].Type,
+			)
+
+			assert.Equal(t,
+				&sema.OptionalType{
+					Type: &sema.ReferenceType{
+						Type: &sema.IntType{},
+					},
+				},
+				checker.GlobalValues["ref"].Type,
+			)
+		})
+
+		t.Run(fmt.Sprintf("%s.capability: untyped", accountType), func(t *testing.T) {
+
+			_, err := ParseAndCheckAccount(t,
+				fmt.Sprintf(
+					`
+                      let cap = %[1]s.capability(/public/r)
+                    `,
+					accountVariable,
+				),
+			)
+
+			errs := ExpectCheckerErrors(t, err, 1)
+
+			require.IsType(t, &sema.TypeParameterTypeInferenceError{}, errs[0])
+		})
+
+		t.Run(fmt.Sprintf("%s.capability: invalid optional", accountType), func(t *testing.T) {
+
+			_, err := ParseAndCheckAccount(t,
+				fmt.Sprintf(
+					`
+                      let cap = %[1]s.capability<&Int>(/public/r)!
+                    `,
+					accountVariable,
+				),
+			)
+
+			errs := ExpectCheckerErrors(t, err, 1)
+
+			require.IsType(t, &sema.NonOptionalForceError{}, errs[0])
+		})
+	}
+}
+
+func TestCheckAccount_invalidCapabilityLinkType(t *testing.T) {
+
+	t.Parallel()
+
+	for name, code := range map[string]string{
+		"borrow":        `let x = authAccount.borrow<Int>(from: /storage/x)`,
+		"link":          `let x = authAccount.link<Int>(/public/x, target: /storage/x)`,
+		"getCapability": `let x = publicAccount.getCapability<Int>(/public/x)`,
+		"capability":    `let x = publicAccount.capability<Int>(/public/x)`,
+	} {
+
+		code := code
+
+		t.Run(name, func(t *testing.T) {
+
+			t.Parallel()
+
+			_, err := ParseAndCheckAccount(t, code)
+
+			errs := ExpectCheckerErrors(t, err, 1)
+
+			require.IsType(t, &sema.InvalidCapabilityLinkTypeError{}, errs[0])
+
+			assert.Equal(t,
+				"invalid type argument: expected reference type, got `Int`",
+				errs[0].Error(),
+			)
+		})
+	}
+}
+
+func TestCheckAccount_pathUsage(t *testing.T) {
+
+	t.Parallel()
+
+	checker, err := ParseAndCheck(t, `
+      resource R {}
+
+      transaction {
+
+          prepare(signer: AuthAccount) {
+              signer.save(<-create R(), to: /storage/r)
+              let r <- signer.load<@R>(from: /storage/old)!
+              destroy r
+              let ref = signer.borrow<&R>(from: /storage/r)
+              let type = signer.type(at: /storage/typed)
+              signer.link<&R>(/public/r, target: /storage/r)
+              signer.unlink(/private/r)
+
+              let path = /storage/dynamic
+              let other = signer.borrow<&R>(from: path)
+          }
+      }
+    `)
+
+	require.NoError(t, err)
+
+	storagePath := func(identifier string) sema.UsedPath {
+		return sema.UsedPath{
+			Domain:     common.PathDomainStorage,
+			Identifier: identifier,
+		}
+	}
+
+	pathUsage := checker.PathUsage()
+
+	assert.Equal(t,
+		map[sema.UsedPath]bool{
+			storagePath("old"):   true,
+			storagePath("r"):     true,
+			storagePath("typed"): true,
+		},
+		pathUsage.Reads,
+	)
+
+	assert.Equal(t,
+		map[sema.UsedPath]bool{
+			storagePath("r"):   true,
+			storagePath("old"): true,
+			{
+				Domain:     common.PathDomainPublic,
+				Identifier: "r",
+			}: true,
+			{
+				Domain:     common.PathDomainPrivate,
+				Identifier: "r",
+			}: true,
+		},
+		pathUsage.Writes,
+	)
+}
@@ -286,6 +286,320 @@ func TestCheckDictionaryValues(t *testing.T) {
 	)
 }
 
+func TestCheckDictionaryForEachValue(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("struct values", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          fun test(): Int {
+              let dict = {"abc": 1, "def": 2}
+              var count = 0
+              dict.forEachValue(fun (value: &Int): Bool {
+                  count = count + 1
+                  return true
+              })
+              return count
+          }
+        `)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("resource values", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          resource R {
+              let n: Int
+
+              init(n: Int) {
+                  self.n = n
+              }
+          }
+
+          fun test(rs: @{String: R}): Int {
+              var sum = 0
+              rs.forEachValue(fun (r: &R): Bool {
+                  sum = sum + r.n
+                  return true
+              })
+              destroy rs
+              return sum
+          }
+        `)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("invalid function type", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          resource R {}
+
+          fun test(rs: @{String: R}) {
+              rs.forEachValue(fun (r: @R): Bool {
+                  destroy r
+                  return true
+              })
+              destroy rs
+          }
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.TypeMismatchError{}, errs[0])
+	})
+}
+
+func TestCheckDictionaryFilter(t *testing.T) {
+
+	t.Parallel()
+
+	checker, err := ParseAndCheck(t, `
+      let xs = {"a": 1, "b": 2}
+      let filter = xs.filter
+      let ys = xs.filter(fun (key: String, value: Int): Bool {
+          return value > 1
+      })
+    `)
+
+	require.NoError(t, err)
+
+	dictionaryType := &sema.DictionaryType{
+		KeyType:   &sema.StringType{},
+		ValueType: &sema.IntType{},
+	}
+
+	assert.Equal(t,
+		&sema.FunctionType{
+			Parameters: []*sema.Parameter{
+				{
+					Label:      sema.ArgumentLabelNotRequired,
+					Identifier: "predicate",
+					TypeAnnotation: sema.NewTypeAnnotation(
+						&sema.FunctionType{
+							Parameters: []*sema.Parameter{
+								{
+									Label:          sema.ArgumentLabelNotRequired,
+									Identifier:     "key",
+									TypeAnnotation: sema.NewTypeAnnotation(&sema.StringType{}),
+								},
+								{
+									Label:          sema.ArgumentLabelNotRequired,
+									Identifier:     "value",
+									TypeAnnotation: sema.NewTypeAnnotation(&sema.IntType{}),
+								},
+							},
+							ReturnTypeAnnotation: sema.NewTypeAnnotation(&sema.BoolType{}),
+						},
+					),
+				},
+			},
+			ReturnTypeAnnotation: sema.NewTypeAnnotation(dictionaryType),
+		},
+		checker.GlobalValues["filter"].Type,
+	)
+
+	assert.Equal(t,
+		dictionaryType,
+		checker.GlobalValues["ys"].Type,
+	)
+}
+
+func TestCheckInvalidDictionaryFilter(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("wrong predicate type", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          let xs = {"a": 1, "b": 2}
+          let ys = xs.filter(fun (value: Int): Bool {
+              return value > 1
+          })
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.TypeMismatchError{}, errs[0])
+	})
+
+	t.Run("resource values", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          resource R {}
+
+          fun test(rs: @{String: R}, predicate: ((String, @R): Bool)) {
+              let filtered <- rs.filter(predicate)
+              destroy filtered
+              destroy rs
+          }
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.InvalidResourceDictionaryMemberError{}, errs[0])
+	})
+}
+
+func TestCheckDictionaryReduce(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("value type", func(t *testing.T) {
+
+		t.Parallel()
+
+		checker, err := ParseAndCheck(t, `
+          let xs = {"a": 1, "b": 2}
+          let sum = xs.reduce(0, fun (sum: Int, key: String, value: Int): Int {
+              return sum + value
+          })
+        `)
+
+		require.NoError(t, err)
+
+		assert.Equal(t,
+			&sema.IntType{},
+			checker.GlobalValues["sum"].Type,
+		)
+	})
+
+	t.Run("other type", func(t *testing.T) {
+
+		t.Parallel()
+
+		checker, err := ParseAndCheck(t, `
+          let xs = {"a": 1, "b": 2}
+          let keys = xs.reduce("", fun (keys: String, key: String, value: Int): String {
+              return keys.concat(key)
+          })
+        `)
+
+		require.NoError(t, err)
+
+		assert.Equal(t,
+			&sema.StringType{},
+			checker.GlobalValues["keys"].Type,
+		)
+	})
+}
+
+func TestCheckInvalidDictionaryReduce(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("mismatched combine function return type", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          let xs = {"a": 1, "b": 2}
+          let sum = xs.reduce(0, fun (sum: Int, key: String, value: Int): String {
+              return key
+          })
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 2)
+
+		assert.IsType(t, &sema.TypeParameterTypeMismatchError{}, errs[0])
+		assert.IsType(t, &sema.TypeMismatchError{}, errs[1])
+	})
+
+	t.Run("mismatched combine function parameters", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          let xs = {"a": 1, "b": 2}
+          let sum = xs.reduce(0, fun (sum: Int, value: Int): Int {
+              return sum + value
+          })
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.TypeMismatchError{}, errs[0])
+	})
+
+	t.Run("resource values", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          resource R {}
+
+          fun test(rs: @{String: R}, combine: ((Int, String, @R): Int)): Int {
+              let count = rs.reduce(0, combine)
+              destroy rs
+              return count
+          }
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.InvalidResourceDictionaryMemberError{}, errs[0])
+	})
+}
+
+func TestCheckDictionarySortedKeys(t *testing.T) {
+
+	t.Parallel()
+
+	for _, keyType := range []string{"Int", "UInt8", "Fix64", "String", "Address"} {
+
+		keyType := keyType
+
+		t.Run(keyType, func(t *testing.T) {
+
+			t.Parallel()
+
+			checker, err := ParseAndCheck(t,
+				fmt.Sprintf(
+					`
+                      fun test(dict: {%[1]s: Bool}): [%[1]s] {
+                          return dict.sortedKeys()
+                      }
+                    `,
+					keyType,
+				),
+			)
+
+			require.NoError(t, err)
+
+			assert.IsType(t,
+				&sema.FunctionType{},
+				checker.GlobalValues["test"].Type,
+			)
+		})
+	}
+}
+
+func TestCheckInvalidDictionarySortedKeysNotComparable(t *testing.T) {
+
+	t.Parallel()
+
+	_, err := ParseAndCheck(t, `
+      let dict = {true: 1, false: 2}
+      let keys = dict.sortedKeys()
+    `)
+
+	errs := ExpectCheckerErrors(t, err, 1)
+
+	assert.IsType(t, &sema.NotComparableTypeError{}, errs[0])
+}
+
 func TestCheckLength(t *testing.T) {
 
 	t.Parallel()
@@ -363,245 +677,1008 @@ func TestCheckInvalidArrayAppendToConstantSize(t *testing.T) {
 	assert.IsType(t, &sema.NotDeclaredMemberError{}, errs[0])
 }
 
-func TestCheckArrayConcat(t *testing.T) {
+func TestCheckArrayConcat(t *testing.T) {
+
+	t.Parallel()
+
+	_, err := ParseAndCheck(t, `
+	  fun test(): [Int] {
+	 	  let a = [1, 2]
+		  let b = [3, 4]
+          let c = a.concat(b)
+          return c
+      }
+    `)
+
+	require.NoError(t, err)
+}
+
+func TestCheckInvalidArrayConcat(t *testing.T) {
+
+	t.Parallel()
+
+	_, err := ParseAndCheck(t, `
+      fun test(): [Int] {
+		  let a = [1, 2]
+		  let b = ["a", "b"]
+          let c = a.concat(b)
+          return c
+      }
+    `)
+
+	errs := ExpectCheckerErrors(t, err, 1)
+
+	assert.IsType(t, &sema.TypeMismatchError{}, errs[0])
+}
+
+func TestCheckInvalidArrayConcatOfConstantSized(t *testing.T) {
+
+	t.Parallel()
+
+	_, err := ParseAndCheck(t, `
+	  fun test(): [Int] {
+	 	  let a: [Int; 2] = [1, 2]
+		  let b: [Int; 2] = [3, 4]
+          let c = a.concat(b)
+          return c
+      }
+    `)
+
+	errs := ExpectCheckerErrors(t, err, 1)
+
+	assert.IsType(t, &sema.NotDeclaredMemberError{}, errs[0])
+}
+
+func TestCheckArrayConcatBound(t *testing.T) {
+
+	t.Parallel()
+
+	_, err := ParseAndCheck(t, `
+      fun test(): [Int] {
+		  let a = [1, 2]
+		  let b = [3, 4]
+		  let c = a.concat
+		  return c(b)
+      }
+    `)
+
+	require.NoError(t, err)
+}
+
+func TestCheckArrayInsert(t *testing.T) {
+
+	t.Parallel()
+
+	_, err := ParseAndCheck(t, `
+      fun test(): [Int] {
+          let x = [1, 2, 3]
+          x.insert(at: 1, 4)
+          return x
+      }
+    `)
+
+	require.NoError(t, err)
+}
+
+func TestCheckInvalidArrayInsert(t *testing.T) {
+
+	t.Parallel()
+
+	_, err := ParseAndCheck(t, `
+      fun test(): [Int] {
+          let x = [1, 2, 3]
+          x.insert(at: 1, "4")
+          return x
+      }
+    `)
+
+	errs := ExpectCheckerErrors(t, err, 1)
+
+	assert.IsType(t, &sema.TypeMismatchError{}, errs[0])
+}
+
+func TestCheckInvalidArrayInsertIntoConstantSized(t *testing.T) {
+
+	t.Parallel()
+
+	_, err := ParseAndCheck(t, `
+      fun test(): [Int; 3] {
+          let x: [Int; 3] = [1, 2, 3]
+          x.insert(at: 1, 4)
+          return x
+      }
+    `)
+
+	errs := ExpectCheckerErrors(t, err, 1)
+
+	assert.IsType(t, &sema.NotDeclaredMemberError{}, errs[0])
+}
+
+func TestCheckArrayRemove(t *testing.T) {
+
+	t.Parallel()
+
+	_, err := ParseAndCheck(t, `
+      fun test(): [Int] {
+          let x = [1, 2, 3]
+          let old: Int? = x.remove(at: 1)
+          return x
+      }
+    `)
+
+	require.NoError(t, err)
+}
+
+func TestCheckInvalidArrayRemove(t *testing.T) {
+
+	t.Parallel()
+
+	_, err := ParseAndCheck(t, `
+      fun test(): [Int] {
+          let x = [1, 2, 3]
+          let old: Int? = x.remove(at: "1")
+          return x
+      }
+    `)
+
+	errs := ExpectCheckerErrors(t, err, 1)
+
+	assert.IsType(t, &sema.TypeMismatchError{}, errs[0])
+}
+
+func TestCheckInvalidArrayRemoveFromConstantSized(t *testing.T) {
+
+	t.Parallel()
+
+	_, err := ParseAndCheck(t, `
+      fun test(): [Int; 3] {
+          let x: [Int; 3] = [1, 2, 3]
+          let old: Int? = x.remove(at: 1)
+          return x
+      }
+    `)
+
+	errs := ExpectCheckerErrors(t, err, 1)
+
+	assert.IsType(t, &sema.NotDeclaredMemberError{}, errs[0])
+}
+
+func TestCheckArrayRemoveFirst(t *testing.T) {
+
+	t.Parallel()
+
+	_, err := ParseAndCheck(t, `
+      fun test(): [Int] {
+          let x = [1, 2, 3]
+          let old: Int? = x.removeFirst()
+          return x
+      }
+    `)
+
+	require.NoError(t, err)
+}
+
+func TestCheckInvalidArrayRemoveFirst(t *testing.T) {
+
+	t.Parallel()
+
+	_, err := ParseAndCheck(t, `
+      fun test(): [Int] {
+          let x = [1, 2, 3]
+          let old: Int? = x.removeFirst(1)
+          return x
+      }
+	`)
+
+	errs := ExpectCheckerErrors(t, err, 1)
+
+	assert.IsType(t, &sema.ArgumentCountError{}, errs[0])
+}
+
+func TestCheckInvalidArrayRemoveFirstFromConstantSized(t *testing.T) {
+
+	t.Parallel()
+
+	_, err := ParseAndCheck(t, `
+      fun test(): [Int; 3] {
+          let x: [Int; 3] = [1, 2, 3]
+          let old: Int? = x.removeFirst()
+          return x
+      }
+    `)
+
+	errs := ExpectCheckerErrors(t, err, 1)
+
+	assert.IsType(t, &sema.NotDeclaredMemberError{}, errs[0])
+}
+
+func TestCheckArrayRemoveLast(t *testing.T) {
+
+	t.Parallel()
+
+	_, err := ParseAndCheck(t, `
+      fun test(): [Int] {
+          let x = [1, 2, 3]
+          let old: Int? = x.removeLast()
+          return x
+      }
+    `)
+
+	require.NoError(t, err)
+}
+
+func TestCheckInvalidArrayRemoveLastFromConstantSized(t *testing.T) {
+
+	t.Parallel()
+
+	_, err := ParseAndCheck(t, `
+      fun test(): [Int; 3] {
+          let x: [Int; 3] = [1, 2, 3]
+          let old: Int? = x.removeLast()
+          return x
+      }
+    `)
+
+	errs := ExpectCheckerErrors(t, err, 1)
+
+	assert.IsType(t, &sema.NotDeclaredMemberError{}, errs[0])
+}
+
+func TestCheckArrayContains(t *testing.T) {
+
+	t.Parallel()
+
+	_, err := ParseAndCheck(t, `
+      fun test(): Bool {
+          let x = [1, 2, 3]
+          return x.contains(2)
+      }
+    `)
+
+	require.NoError(t, err)
+}
+
+func TestCheckInvalidArrayContains(t *testing.T) {
+
+	t.Parallel()
+
+	_, err := ParseAndCheck(t, `
+      fun test(): Bool {
+          let x = [1, 2, 3]
+          return x.contains("abc")
+      }
+    `)
+
+	errs := ExpectCheckerErrors(t, err, 1)
+
+	assert.IsType(t, &sema.TypeMismatchError{}, errs[0])
+}
+
+func TestCheckInvalidArrayContainsNotEquatable(t *testing.T) {
+
+	t.Parallel()
+
+	_, err := ParseAndCheck(t, `
+      fun test(): Bool {
+          let z = [[1], [2], [3]]
+          return z.contains([1, 2])
+      }
+    `)
+
+	errs := ExpectCheckerErrors(t, err, 1)
+
+	assert.IsType(t, &sema.NotEquatableTypeError{}, errs[0])
+}
+
+func TestCheckArrayLastIndexOf(t *testing.T) {
+
+	t.Parallel()
+
+	checker, err := ParseAndCheck(t, `
+      let xs = [1, 2, 1]
+      let index = xs.lastIndexOf(of: 1)
+    `)
+
+	require.NoError(t, err)
+
+	assert.Equal(t,
+		&sema.OptionalType{
+			Type: &sema.IntType{},
+		},
+		checker.GlobalValues["index"].Type,
+	)
+}
+
+func TestCheckInvalidArrayLastIndexOf(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("invalid argument type", func(t *testing.T) {
+
+		_, err := ParseAndCheck(t, `
+          let xs = [1, 2, 3]
+          let index = xs.lastIndexOf(of: "abc")
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.TypeMismatchError{}, errs[0])
+	})
+
+	t.Run("not equatable", func(t *testing.T) {
+
+		_, err := ParseAndCheck(t, `
+          let xs = [[1], [2], [3]]
+          let index = xs.lastIndexOf(of: [1])
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.NotEquatableTypeError{}, errs[0])
+	})
+
+	t.Run("resource", func(t *testing.T) {
+
+		_, err := ParseAndCheck(t, `
+          resource R {}
+
+          fun test(rs: @[R]): Int? {
+              let r <- create R()
+              let index = rs.lastIndexOf(of: <-r)
+              destroy rs
+              return index
+          }
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 2)
+
+		assert.IsType(t, &sema.InvalidResourceArrayMemberError{}, errs[0])
+		assert.IsType(t, &sema.NotEquatableTypeError{}, errs[1])
+	})
+}
+
+func TestCheckArrayMinMax(t *testing.T) {
+
+	t.Parallel()
+
+	for _, name := range []string{"min", "max"} {
+
+		name := name
+
+		t.Run(name, func(t *testing.T) {
+
+			t.Parallel()
+
+			checker, err := ParseAndCheck(t,
+				fmt.Sprintf(
+					`
+                      let xs: [Int; 3] = [3, 1, 2]
+                      let ys: [Fix64] = []
+                      let x = xs.%[1]s()
+                      let y = ys.%[1]s()
+                    `,
+					name,
+				),
+			)
+
+			require.NoError(t, err)
+
+			assert.Equal(t,
+				&sema.OptionalType{Type: &sema.IntType{}},
+				checker.GlobalValues["x"].Type,
+			)
+
+			assert.Equal(t,
+				&sema.OptionalType{Type: &sema.Fix64Type{}},
+				checker.GlobalValues["y"].Type,
+			)
+		})
+	}
+}
+
+func TestCheckArrayFilter(t *testing.T) {
+
+	t.Parallel()
+
+	for _, arrayType := range []string{"[Int]", "[Int; 3]"} {
+
+		arrayType := arrayType
+
+		t.Run(arrayType, func(t *testing.T) {
+
+			t.Parallel()
+
+			checker, err := ParseAndCheck(t,
+				fmt.Sprintf(
+					`
+                      let xs: %s = [1, 2, 3]
+                      let filter = xs.filter
+                      let ys = xs.filter(fun (x: Int): Bool {
+                          return x > 1
+                      })
+                    `,
+					arrayType,
+				),
+			)
+
+			require.NoError(t, err)
+
+			resultType := &sema.VariableSizedType{
+				Type: &sema.IntType{},
+			}
+
+			assert.Equal(t,
+				&sema.FunctionType{
+					Parameters: []*sema.Parameter{
+						{
+							Label:      sema.ArgumentLabelNotRequired,
+							Identifier: "predicate",
+							TypeAnnotation: sema.NewTypeAnnotation(
+								&sema.FunctionType{
+									Parameters: []*sema.Parameter{
+										{
+											Label:          sema.ArgumentLabelNotRequired,
+											Identifier:     "element",
+											TypeAnnotation: sema.NewTypeAnnotation(&sema.IntType{}),
+										},
+									},
+									ReturnTypeAnnotation: sema.NewTypeAnnotation(&sema.BoolType{}),
+								},
+							),
+						},
+					},
+					ReturnTypeAnnotation: sema.NewTypeAnnotation(resultType),
+				},
+				checker.GlobalValues["filter"].Type,
+			)
+
+			assert.Equal(t,
+				resultType,
+				checker.GlobalValues["ys"].Type,
+			)
+		})
+	}
+}
+
+func TestCheckInvalidArrayFilter(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("wrong predicate type", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          let xs = [1, 2, 3]
+          let ys = xs.filter(fun (x: String): Bool {
+              return true
+          })
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.TypeMismatchError{}, errs[0])
+	})
+
+	t.Run("resource elements", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          resource R {}
+
+          fun test(rs: @[R], predicate: ((@R): Bool)) {
+              let filtered <- rs.filter(predicate)
+              destroy filtered
+              destroy rs
+          }
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.InvalidResourceArrayMemberError{}, errs[0])
+	})
+}
+
+func TestCheckArraySort(t *testing.T) {
+
+	t.Parallel()
+
+	for _, elementType := range []string{"Int", "UInt8", "Fix64", "String", "Character", "Address", "Path"} {
+
+		elementType := elementType
+
+		t.Run(elementType, func(t *testing.T) {
+
+			t.Parallel()
+
+			checker, err := ParseAndCheck(t,
+				fmt.Sprintf(
+					`
+                      fun test(xs: [%[1]s]): [%[1]s] {
+                          xs.sort()
+                          return xs.sorted()
+                      }
+                    `,
+					elementType,
+				),
+			)
+
+			require.NoError(t, err)
+
+			assert.IsType(t,
+				&sema.FunctionType{},
+				checker.GlobalValues["test"].Type,
+			)
+		})
+	}
+
+	t.Run("types", func(t *testing.T) {
+
+		t.Parallel()
+
+		checker, err := ParseAndCheck(t, `
+          let xs = [3, 1, 2]
+          let sort = xs.sort
+          let sorted = xs.sorted
+        `)
+
+		require.NoError(t, err)
+
+		assert.Equal(t,
+			&sema.FunctionType{
+				ReturnTypeAnnotation: sema.NewTypeAnnotation(&sema.VoidType{}),
+			},
+			checker.GlobalValues["sort"].Type,
+		)
+
+		assert.Equal(t,
+			&sema.FunctionType{
+				ReturnTypeAnnotation: sema.NewTypeAnnotation(
+					&sema.VariableSizedType{
+						Type: &sema.IntType{},
+					},
+				),
+			},
+			checker.GlobalValues["sorted"].Type,
+		)
+	})
+}
+
+func TestCheckInvalidArraySort(t *testing.T) {
+
+	t.Parallel()
+
+	for _, name := range []string{"sort", "sorted"} {
+
+		name := name
+
+		t.Run(fmt.Sprintf("%s, not comparable", name), func(t *testing.T) {
+
+			t.Parallel()
+
+			_, err := ParseAndCheck(t,
+				fmt.Sprintf(
+					`
+                      let xs = [true, false]
+                      let f = xs.%s
+                    `,
+					name,
+				),
+			)
+
+			errs := ExpectCheckerErrors(t, err, 1)
+
+			assert.IsType(t, &sema.NotComparableTypeError{}, errs[0])
+		})
+
+		t.Run(fmt.Sprintf("%s, constant-sized", name), func(t *testing.T) {
+
+			t.Parallel()
+
+			_, err := ParseAndCheck(t,
+				fmt.Sprintf(
+					`
+                      let xs: [Int; 2] = [2, 1]
+                      let f = xs.%s
+                    `,
+					name,
+				),
+			)
+
+			errs := ExpectCheckerErrors(t, err, 1)
+
+			assert.IsType(t, &sema.NotDeclaredMemberError{}, errs[0])
+		})
+	}
+}
+
+func TestCheckInvalidResourceArraySort(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("sort", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          resource R {}
+
+          fun test(rs: @[R]) {
+              rs.sort()
+              destroy rs
+          }
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.InvalidResourceArrayMemberError{}, errs[0])
+	})
+
+	t.Run("sorted", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          resource R {}
+
+          fun test(rs: @[R]) {
+              let sorted <- rs.sorted()
+              destroy sorted
+              destroy rs
+          }
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.InvalidResourceArrayMemberError{}, errs[0])
+	})
+}
+
+func TestCheckArrayReduce(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("same type", func(t *testing.T) {
+
+		t.Parallel()
+
+		checker, err := ParseAndCheck(t, `
+          let xs = [1, 2, 3]
+          let sum = xs.reduce(0, fun (sum: Int, x: Int): Int {
+              return sum + x
+          })
+        `)
+
+		require.NoError(t, err)
+
+		assert.Equal(t,
+			&sema.IntType{},
+			checker.GlobalValues["sum"].Type,
+		)
+	})
+
+	t.Run("different type", func(t *testing.T) {
+
+		t.Parallel()
+
+		checker, err := ParseAndCheck(t, `
+          let xs: [Int; 3] = [1, 2, 3]
+          let joined = xs.reduce("", fun (s: String, x: Int): String {
+              return s.concat(x.toString())
+          })
+        `)
+
+		require.NoError(t, err)
+
+		assert.Equal(t,
+			&sema.StringType{},
+			checker.GlobalValues["joined"].Type,
+		)
+	})
+}
+
+func TestCheckInvalidArrayReduce(t *testing.T) {
 
 	t.Parallel()
 
-	_, err := ParseAndCheck(t, `
-	  fun test(): [Int] {
-	 	  let a = [1, 2]
-		  let b = [3, 4]
-          let c = a.concat(b)
-          return c
-      }
-    `)
+	t.Run("mismatched combine function", func(t *testing.T) {
 
-	require.NoError(t, err)
-}
+		t.Parallel()
 
-func TestCheckInvalidArrayConcat(t *testing.T) {
+		_, err := ParseAndCheck(t, `
+          let xs = [1, 2, 3]
+          let sum = xs.reduce(0, fun (sum: Int, x: String): Int {
+              return sum
+          })
+        `)
 
-	t.Parallel()
+		errs := ExpectCheckerErrors(t, err, 1)
 
-	_, err := ParseAndCheck(t, `
-      fun test(): [Int] {
-		  let a = [1, 2]
-		  let b = ["a", "b"]
-          let c = a.concat(b)
-          return c
-      }
-    `)
+		assert.IsType(t, &sema.TypeMismatchError{}, errs[0])
+	})
 
-	errs := ExpectCheckerErrors(t, err, 1)
+	t.Run("resource elements", func(t *testing.T) {
 
-	assert.IsType(t, &sema.TypeMismatchError{}, errs[0])
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          resource R {}
+
+          fun test(rs: @[R], combine: ((Int, @R): Int)): Int {
+              let count = rs.reduce(0, combine)
+              destroy rs
+              return count
+          }
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.InvalidResourceArrayMemberError{}, errs[0])
+	})
 }
 
-func TestCheckInvalidArrayConcatOfConstantSized(t *testing.T) {
+func TestCheckInvalidArrayMinMaxNotComparable(t *testing.T) {
 
 	t.Parallel()
 
-	_, err := ParseAndCheck(t, `
-	  fun test(): [Int] {
-	 	  let a: [Int; 2] = [1, 2]
-		  let b: [Int; 2] = [3, 4]
-          let c = a.concat(b)
-          return c
-      }
-    `)
+	for _, name := range []string{"min", "max"} {
 
-	errs := ExpectCheckerErrors(t, err, 1)
+		name := name
 
-	assert.IsType(t, &sema.NotDeclaredMemberError{}, errs[0])
-}
+		t.Run(name, func(t *testing.T) {
 
-func TestCheckArrayConcatBound(t *testing.T) {
+			t.Parallel()
 
-	t.Parallel()
+			_, err := ParseAndCheck(t,
+				fmt.Sprintf(
+					`
+                      let xs = [true, false]
+                      let x = xs.%s()
+                    `,
+					name,
+				),
+			)
 
-	_, err := ParseAndCheck(t, `
-      fun test(): [Int] {
-		  let a = [1, 2]
-		  let b = [3, 4]
-		  let c = a.concat
-		  return c(b)
-      }
-    `)
+			errs := ExpectCheckerErrors(t, err, 1)
 
-	require.NoError(t, err)
+			assert.IsType(t, &sema.NotComparableTypeError{}, errs[0])
+		})
+	}
 }
 
-func TestCheckArrayInsert(t *testing.T) {
+func TestCheckInvalidArrayAbstractNumberTypeNotComparable(t *testing.T) {
 
 	t.Parallel()
 
-	_, err := ParseAndCheck(t, `
-      fun test(): [Int] {
-          let x = [1, 2, 3]
-          x.insert(at: 1, 4)
-          return x
-      }
-    `)
+	// Values of an abstract number type may have different concrete types,
+	// e.g. an array of type `[Number]` may contain an `Int` and a `UFix64`,
+	// so they cannot be ordered
+
+	for _, ty := range []sema.Type{
+		&sema.NumberType{},
+		&sema.SignedNumberType{},
+		&sema.IntegerType{},
+		&sema.SignedIntegerType{},
+		&sema.FixedPointType{},
+		&sema.SignedFixedPointType{},
+	} {
 
-	require.NoError(t, err)
+		for _, code := range []string{
+			"let x = xs.min()",
+			"let x = xs.max()",
+			"xs.sort()",
+			"let x = xs.sorted()",
+			"let x = dict.sortedKeys()",
+		} {
+
+			typeName := ty.String()
+			code := code
+
+			t.Run(fmt.Sprintf("%s, %s", typeName, code), func(t *testing.T) {
+
+				t.Parallel()
+
+				_, err := ParseAndCheck(t,
+					fmt.Sprintf(
+						`
+                          fun test(xs: [%[1]s], dict: {%[1]s: Int}) {
+                              %[2]s
+                          }
+                        `,
+						typeName,
+						code,
+					),
+				)
+
+				errs := ExpectCheckerErrors(t, err, 1)
+
+				assert.IsType(t, &sema.NotComparableTypeError{}, errs[0])
+			})
+		}
+	}
 }
 
-func TestCheckInvalidArrayInsert(t *testing.T) {
+func TestCheckInvalidArrayMinMaxResource(t *testing.T) {
 
 	t.Parallel()
 
-	_, err := ParseAndCheck(t, `
-      fun test(): [Int] {
-          let x = [1, 2, 3]
-          x.insert(at: 1, "4")
-          return x
-      }
-    `)
+	for _, name := range []string{"min", "max"} {
 
-	errs := ExpectCheckerErrors(t, err, 1)
+		name := name
 
-	assert.IsType(t, &sema.TypeMismatchError{}, errs[0])
-}
+		t.Run(name, func(t *testing.T) {
 
-func TestCheckInvalidArrayInsertIntoConstantSized(t *testing.T) {
+			t.Parallel()
 
-	t.Parallel()
+			_, err := ParseAndCheck(t,
+				fmt.Sprintf(
+					`
+                      resource R {}
 
-	_, err := ParseAndCheck(t, `
-      fun test(): [Int; 3] {
-          let x: [Int; 3] = [1, 2, 3]
-          x.insert(at: 1, 4)
-          return x
-      }
-    `)
+                      fun test(rs: @[R]) {
+                          rs.%s()
+                          destroy rs
+                      }
+                    `,
+					name,
+				),
+			)
 
-	errs := ExpectCheckerErrors(t, err, 1)
+			errs := ExpectCheckerErrors(t, err, 3)
 
-	assert.IsType(t, &sema.NotDeclaredMemberError{}, errs[0])
+			assert.IsType(t, &sema.InvalidResourceArrayMemberError{}, errs[0])
+			assert.IsType(t, &sema.NotComparableTypeError{}, errs[1])
+			assert.IsType(t, &sema.ResourceLossError{}, errs[2])
+		})
+	}
 }
 
-func TestCheckArrayRemove(t *testing.T) {
+func TestCheckArrayWithExactLength(t *testing.T) {
 
 	t.Parallel()
 
-	_, err := ParseAndCheck(t, `
-      fun test(): [Int] {
-          let x = [1, 2, 3]
-          let old: Int? = x.remove(at: 1)
-          return x
-      }
+	checker, err := ParseAndCheck(t, `
+      let xs = [1, 2, 3]
+      let ys = xs.withExactLength(3)
     `)
 
 	require.NoError(t, err)
+
+	assert.Equal(t,
+		&sema.VariableSizedType{
+			Type: &sema.IntType{},
+		},
+		checker.GlobalValues["ys"].Type,
+	)
 }
 
-func TestCheckInvalidArrayRemove(t *testing.T) {
+func TestCheckInvalidConstantSizedArrayWithExactLength(t *testing.T) {
 
 	t.Parallel()
 
 	_, err := ParseAndCheck(t, `
-      fun test(): [Int] {
-          let x = [1, 2, 3]
-          let old: Int? = x.remove(at: "1")
-          return x
-      }
+      let xs: [Int; 3] = [1, 2, 3]
+      let ys = xs.withExactLength(3)
     `)
 
 	errs := ExpectCheckerErrors(t, err, 1)
 
-	assert.IsType(t, &sema.TypeMismatchError{}, errs[0])
+	assert.IsType(t, &sema.NotDeclaredMemberError{}, errs[0])
 }
 
-func TestCheckInvalidArrayRemoveFromConstantSized(t *testing.T) {
+func TestCheckInvalidResourceArrayWithExactLength(t *testing.T) {
 
 	t.Parallel()
 
 	_, err := ParseAndCheck(t, `
-      fun test(): [Int; 3] {
-          let x: [Int; 3] = [1, 2, 3]
-          let old: Int? = x.remove(at: 1)
-          return x
+      resource R {}
+
+      fun test(rs: @[R]): @[R] {
+          let copies <- rs.withExactLength(1)
+          destroy rs
+          return <-copies
       }
     `)
 
 	errs := ExpectCheckerErrors(t, err, 1)
 
-	assert.IsType(t, &sema.NotDeclaredMemberError{}, errs[0])
+	assert.IsType(t, &sema.InvalidResourceArrayMemberError{}, errs[0])
 }
 
-func TestCheckArrayRemoveFirst(t *testing.T) {
+func TestCheckConstantSizedArrayToVariableSized(t *testing.T) {
 
 	t.Parallel()
 
-	_, err := ParseAndCheck(t, `
-      fun test(): [Int] {
-          let x = [1, 2, 3]
-          let old: Int? = x.removeFirst()
-          return x
-      }
+	checker, err := ParseAndCheck(t, `
+      let xs: [Int; 3] = [1, 2, 3]
+      let ys = xs.toVariableSized()
     `)
 
 	require.NoError(t, err)
+
+	assert.Equal(t,
+		&sema.VariableSizedType{
+			Type: &sema.IntType{},
+		},
+		checker.GlobalValues["ys"].Type,
+	)
 }
 
-func TestCheckInvalidArrayRemoveFirst(t *testing.T) {
+func TestCheckInvalidVariableSizedArrayToVariableSized(t *testing.T) {
 
 	t.Parallel()
 
 	_, err := ParseAndCheck(t, `
-      fun test(): [Int] {
-          let x = [1, 2, 3]
-          let old: Int? = x.removeFirst(1)
-          return x
-      }
-	`)
+      let xs = [1, 2, 3]
+      let ys = xs.toVariableSized()
+    `)
 
 	errs := ExpectCheckerErrors(t, err, 1)
 
-	assert.IsType(t, &sema.ArgumentCountError{}, errs[0])
+	assert.IsType(t, &sema.NotDeclaredMemberError{}, errs[0])
 }
 
-func TestCheckInvalidArrayRemoveFirstFromConstantSized(t *testing.T) {
+func TestCheckInvalidResourceConstantSizedArrayToVariableSized(t *testing.T) {
 
 	t.Parallel()
 
 	_, err := ParseAndCheck(t, `
-      fun test(): [Int; 3] {
-          let x: [Int; 3] = [1, 2, 3]
-          let old: Int? = x.removeFirst()
-          return x
+      resource R {}
+
+      fun test(rs: @[R; 1]): @[R] {
+          let copies <- rs.toVariableSized()
+          destroy rs
+          return <-copies
       }
     `)
 
 	errs := ExpectCheckerErrors(t, err, 1)
 
-	assert.IsType(t, &sema.NotDeclaredMemberError{}, errs[0])
+	assert.IsType(t, &sema.InvalidResourceArrayMemberError{}, errs[0])
 }
 
-func TestCheckArrayRemoveLast(t *testing.T) {
+func TestCheckRemoveAll(t *testing.T) {
 
 	t.Parallel()
 
-	_, err := ParseAndCheck(t, `
-      fun test(): [Int] {
-          let x = [1, 2, 3]
-          let old: Int? = x.removeLast()
-          return x
-      }
-    `)
+	t.Run("array", func(t *testing.T) {
 
-	require.NoError(t, err)
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          fun test() {
+              let xs = [1, 2, 3]
+              xs.removeAll()
+          }
+        `)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("dictionary", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          fun test() {
+              let xs = {"a": 1, "b": 2}
+              xs.removeAll()
+          }
+        `)
+
+		require.NoError(t, err)
+	})
 }
 
-func TestCheckInvalidArrayRemoveLastFromConstantSized(t *testing.T) {
+func TestCheckInvalidConstantSizedArrayRemoveAll(t *testing.T) {
 
 	t.Parallel()
 
 	_, err := ParseAndCheck(t, `
-      fun test(): [Int; 3] {
-          let x: [Int; 3] = [1, 2, 3]
-          let old: Int? = x.removeLast()
-          return x
+      fun test() {
+          let xs: [Int; 3] = [1, 2, 3]
+          xs.removeAll()
       }
     `)
 
@@ -610,50 +1687,45 @@ func TestCheckInvalidArrayRemoveLastFromConstantSized(t *testing.T) {
 	assert.IsType(t, &sema.NotDeclaredMemberError{}, errs[0])
 }
 
-func TestCheckArrayContains(t *testing.T) {
+func TestCheckInvalidResourceRemoveAll(t *testing.T) {
 
 	t.Parallel()
 
-	_, err := ParseAndCheck(t, `
-      fun test(): Bool {
-          let x = [1, 2, 3]
-          return x.contains(2)
-      }
-    `)
+	t.Run("array", func(t *testing.T) {
 
-	require.NoError(t, err)
-}
+		t.Parallel()
 
-func TestCheckInvalidArrayContains(t *testing.T) {
+		_, err := ParseAndCheck(t, `
+          resource R {}
 
-	t.Parallel()
+          fun test(rs: @[R]) {
+              rs.removeAll()
+              destroy rs
+          }
+        `)
 
-	_, err := ParseAndCheck(t, `
-      fun test(): Bool {
-          let x = [1, 2, 3]
-          return x.contains("abc")
-      }
-    `)
+		errs := ExpectCheckerErrors(t, err, 1)
 
-	errs := ExpectCheckerErrors(t, err, 1)
+		assert.IsType(t, &sema.InvalidResourceArrayMemberError{}, errs[0])
+	})
 
-	assert.IsType(t, &sema.TypeMismatchError{}, errs[0])
-}
+	t.Run("dictionary", func(t *testing.T) {
 
-func TestCheckInvalidArrayContainsNotEquatable(t *testing.T) {
+		t.Parallel()
 
-	t.Parallel()
+		_, err := ParseAndCheck(t, `
+          resource R {}
 
-	_, err := ParseAndCheck(t, `
-      fun test(): Bool {
-          let z = [[1], [2], [3]]
-          return z.contains([1, 2])
-      }
-    `)
+          fun test(rs: @{String: R}) {
+              rs.removeAll()
+              destroy rs
+          }
+        `)
 
-	errs := ExpectCheckerErrors(t, err, 1)
+		errs := ExpectCheckerErrors(t, err, 1)
 
-	assert.IsType(t, &sema.NotEquatableTypeError{}, errs[0])
+		assert.IsType(t, &sema.InvalidResourceDictionaryMemberError{}, errs[0])
+	})
 }
 
 func TestCheckEmptyArray(t *testing.T) {
@@ -980,3 +2052,65 @@ func TestCheckDictionaryKeyTypesExpressions(t *testing.T) {
 		})
 	}
 }
+
+func TestCheckArrayEncodeHex(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("byte array", func(t *testing.T) {
+
+		t.Parallel()
+
+		checker, err := ParseAndCheck(t, `
+          let bytes: [UInt8] = []
+          let hex = bytes.encodeHex()
+        `)
+
+		require.NoError(t, err)
+
+		assert.Equal(t,
+			&sema.StringType{},
+			checker.GlobalValues["hex"].Type,
+		)
+	})
+
+	t.Run("decoded bytes", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          let hex = "0102".decodeHex().encodeHex()
+        `)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("integer array", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          let numbers: [Int] = [1, 2, 3]
+          let hex = numbers.encodeHex()
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.NotDeclaredMemberError{}, errs[0])
+	})
+
+	t.Run("constant-sized byte array", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          fun test(bytes: [UInt8; 3]): String {
+              return bytes.encodeHex()
+          }
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.NotDeclaredMemberError{}, errs[0])
+	})
+}
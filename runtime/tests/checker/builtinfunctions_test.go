@@ -19,6 +19,8 @@
 package checker
 
 import (
+	"fmt"
+	"math/big"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -106,4 +108,905 @@ func TestCheckToBigEndianBytes(t *testing.T) {
 			)
 		})
 	}
+
+	t.Run("Address", func(t *testing.T) {
+
+		checker, err := ParseAndCheck(t, `
+          let address: Address = 0x1
+          let res = address.toBigEndianBytes()
+        `)
+
+		require.NoError(t, err)
+
+		assert.Equal(t,
+			&sema.VariableSizedType{
+				Type: &sema.UInt8Type{},
+			},
+			checker.GlobalValues["res"].Type,
+		)
+	})
+}
+
+func TestCheckFromBigEndianBytes(t *testing.T) {
+
+	for _, ty := range sema.AllIntegerTypes {
+
+		switch ty.(type) {
+		case *sema.IntegerType, *sema.SignedIntegerType:
+			continue
+		}
+
+		t.Run(ty.String(), func(t *testing.T) {
+
+			checker, err := ParseAndCheck(t,
+				fmt.Sprintf(
+					`
+                      let res = %s.fromBigEndianBytes([1 as UInt8])
+                    `,
+					ty,
+				),
+			)
+
+			require.NoError(t, err)
+
+			assert.Equal(t,
+				&sema.OptionalType{
+					Type: ty,
+				},
+				checker.GlobalValues["res"].Type,
+			)
+		})
+	}
+
+	for _, ty := range sema.AllFixedPointTypes {
+
+		switch ty.(type) {
+		case *sema.FixedPointType, *sema.SignedFixedPointType:
+			continue
+		}
+
+		t.Run(ty.String(), func(t *testing.T) {
+
+			_, err := ParseAndCheck(t,
+				fmt.Sprintf(
+					`
+                      let res = %s.fromBigEndianBytes([1 as UInt8])
+                    `,
+					ty,
+				),
+			)
+
+			errs := ExpectCheckerErrors(t, err, 1)
+
+			assert.IsType(t, &sema.NotDeclaredMemberError{}, errs[0])
+		})
+	}
+
+	t.Run("invalid argument", func(t *testing.T) {
+
+		_, err := ParseAndCheck(t, `
+          let res = UInt8.fromBigEndianBytes("1")
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.TypeMismatchError{}, errs[0])
+	})
+}
+
+func TestCheckNumberTypeMinMax(t *testing.T) {
+
+	t.Parallel()
+
+	for _, ty := range sema.AllNumberTypes {
+
+		rangedType, ok := ty.(sema.IntegerRangedType)
+		if !ok {
+			continue
+		}
+
+		for name, bound := range map[string]*big.Int{
+			sema.NumberTypeMinFieldName: rangedType.MinInt(),
+			sema.NumberTypeMaxFieldName: rangedType.MaxInt(),
+		} {
+
+			ty := ty
+			name := name
+			bound := bound
+
+			t.Run(fmt.Sprintf("%s.%s", ty, name), func(t *testing.T) {
+
+				t.Parallel()
+
+				checker, err := parseAndCheckWithTestValue(t,
+					fmt.Sprintf(
+						`
+                          let res = test.%s
+                        `,
+						name,
+					),
+					ty,
+				)
+
+				if bound == nil {
+					errs := ExpectCheckerErrors(t, err, 1)
+
+					assert.IsType(t, &sema.NotDeclaredMemberError{}, errs[0])
+
+					return
+				}
+
+				require.NoError(t, err)
+
+				assert.Equal(t,
+					ty,
+					checker.GlobalValues["res"].Type,
+				)
+			})
+		}
+	}
+
+	t.Run("Address", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          let address: Address = 0x1
+          let res = address.max
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.NotDeclaredMemberError{}, errs[0])
+	})
+}
+
+func TestCheckNumberTypeClamp(t *testing.T) {
+
+	t.Parallel()
+
+	for _, ty := range sema.AllNumberTypes {
+
+		ty := ty
+
+		t.Run(ty.String(), func(t *testing.T) {
+
+			t.Parallel()
+
+			checker, err := parseAndCheckWithTestValue(t,
+				`
+                  let res = test.clamp
+                `,
+				ty,
+			)
+
+			switch ty.(type) {
+			case *sema.NumberType, *sema.SignedNumberType,
+				*sema.IntegerType, *sema.SignedIntegerType,
+				*sema.FixedPointType, *sema.SignedFixedPointType:
+
+				errs := ExpectCheckerErrors(t, err, 1)
+
+				assert.IsType(t, &sema.NotDeclaredMemberError{}, errs[0])
+
+				return
+			}
+
+			require.NoError(t, err)
+
+			assert.Equal(t,
+				&sema.FunctionType{
+					Parameters: []*sema.Parameter{
+						{
+							Identifier:     "min",
+							TypeAnnotation: sema.NewTypeAnnotation(ty),
+						},
+						{
+							Identifier:     "max",
+							TypeAnnotation: sema.NewTypeAnnotation(ty),
+						},
+					},
+					ReturnTypeAnnotation: sema.NewTypeAnnotation(ty),
+				},
+				checker.GlobalValues["res"].Type,
+			)
+		})
+	}
+
+	t.Run("invocation", func(t *testing.T) {
+
+		t.Parallel()
+
+		checker, err := ParseAndCheck(t, `
+          let x: UInt8 = 42
+          let res = x.clamp(min: 1, max: 10)
+        `)
+
+		require.NoError(t, err)
+
+		assert.Equal(t,
+			&sema.UInt8Type{},
+			checker.GlobalValues["res"].Type,
+		)
+	})
+
+	t.Run("invalid: non-number", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          let res = "abc".clamp(min: "a", max: "b")
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.NotDeclaredMemberError{}, errs[0])
+	})
+}
+
+func TestCheckNumberTypePow(t *testing.T) {
+
+	t.Parallel()
+
+	for _, ty := range sema.AllNumberTypes {
+
+		ty := ty
+
+		t.Run(ty.String(), func(t *testing.T) {
+
+			t.Parallel()
+
+			checker, err := parseAndCheckWithTestValue(t,
+				`
+                  let res = test.pow
+                `,
+				ty,
+			)
+
+			switch ty.(type) {
+			case *sema.NumberType, *sema.SignedNumberType,
+				*sema.IntegerType, *sema.SignedIntegerType,
+				*sema.FixedPointType, *sema.SignedFixedPointType:
+
+				errs := ExpectCheckerErrors(t, err, 1)
+
+				assert.IsType(t, &sema.NotDeclaredMemberError{}, errs[0])
+
+				return
+			}
+
+			require.NoError(t, err)
+
+			var exponentType sema.Type = &sema.UInt8Type{}
+			if sema.IsSubType(ty, &sema.FixedPointType{}) {
+				exponentType = &sema.IntType{}
+			}
+
+			assert.Equal(t,
+				&sema.FunctionType{
+					Parameters: []*sema.Parameter{
+						{
+							Label:          sema.ArgumentLabelNotRequired,
+							Identifier:     "exponent",
+							TypeAnnotation: sema.NewTypeAnnotation(exponentType),
+						},
+					},
+					ReturnTypeAnnotation: sema.NewTypeAnnotation(ty),
+				},
+				checker.GlobalValues["res"].Type,
+			)
+		})
+	}
+}
+
+func TestCheckSignedNumberTypeAbs(t *testing.T) {
+
+	t.Parallel()
+
+	for _, ty := range sema.AllNumberTypes {
+
+		ty := ty
+
+		t.Run(ty.String(), func(t *testing.T) {
+
+			t.Parallel()
+
+			checker, err := parseAndCheckWithTestValue(t,
+				`
+                  let res = test.abs
+                `,
+				ty,
+			)
+
+			if !sema.IsSubType(ty, &sema.SignedNumberType{}) {
+
+				errs := ExpectCheckerErrors(t, err, 1)
+
+				assert.IsType(t, &sema.NotDeclaredMemberError{}, errs[0])
+
+				return
+			}
+
+			require.NoError(t, err)
+
+			assert.Equal(t,
+				&sema.FunctionType{
+					ReturnTypeAnnotation: sema.NewTypeAnnotation(ty),
+				},
+				checker.GlobalValues["res"].Type,
+			)
+		})
+	}
+
+	for _, ty := range []sema.Type{
+		&sema.IntType{},
+		&sema.Fix64Type{},
+	} {
+
+		ty := ty
+
+		t.Run(fmt.Sprintf("%s, invocation", ty), func(t *testing.T) {
+
+			t.Parallel()
+
+			checker, err := ParseAndCheck(t,
+				fmt.Sprintf(
+					`
+                      let x = %[1]s(1)
+                      let res = x.abs()
+                    `,
+					ty,
+				),
+			)
+
+			require.NoError(t, err)
+
+			assert.Equal(t,
+				ty,
+				checker.GlobalValues["res"].Type,
+			)
+		})
+	}
+
+	for _, ty := range []sema.Type{
+		&sema.UIntType{},
+		&sema.UFix64Type{},
+	} {
+
+		ty := ty
+
+		t.Run(fmt.Sprintf("%s, invalid invocation", ty), func(t *testing.T) {
+
+			t.Parallel()
+
+			_, err := ParseAndCheck(t,
+				fmt.Sprintf(
+					`
+                      let x = %[1]s(1)
+                      let res = x.abs()
+                    `,
+					ty,
+				),
+			)
+
+			errs := ExpectCheckerErrors(t, err, 1)
+
+			assert.IsType(t, &sema.NotDeclaredMemberError{}, errs[0])
+		})
+	}
+}
+
+func TestCheckFixedPointTypeTruncateAndRound(t *testing.T) {
+
+	t.Parallel()
+
+	for _, ty := range sema.AllNumberTypes {
+
+		ty := ty
+
+		for _, name := range []string{"truncate", "round"} {
+
+			name := name
+
+			t.Run(fmt.Sprintf("%s, %s", ty, name), func(t *testing.T) {
+
+				t.Parallel()
+
+				checker, err := parseAndCheckWithTestValue(t,
+					fmt.Sprintf(
+						`
+                          let res = test.%s
+                        `,
+						name,
+					),
+					ty,
+				)
+
+				if !sema.IsSubType(ty, &sema.FixedPointType{}) {
+
+					errs := ExpectCheckerErrors(t, err, 1)
+
+					assert.IsType(t, &sema.NotDeclaredMemberError{}, errs[0])
+
+					return
+				}
+
+				require.NoError(t, err)
+
+				assert.Equal(t,
+					&sema.FunctionType{
+						ReturnTypeAnnotation: sema.NewTypeAnnotation(&sema.IntType{}),
+					},
+					checker.GlobalValues["res"].Type,
+				)
+			})
+		}
+	}
+
+	t.Run("invocation", func(t *testing.T) {
+
+		t.Parallel()
+
+		checker, err := ParseAndCheck(t, `
+          let x: UFix64 = 1.5
+          let truncated = x.truncate()
+          let rounded = x.round()
+        `)
+
+		require.NoError(t, err)
+
+		assert.Equal(t,
+			&sema.IntType{},
+			checker.GlobalValues["truncated"].Type,
+		)
+
+		assert.Equal(t,
+			&sema.IntType{},
+			checker.GlobalValues["rounded"].Type,
+		)
+	})
+}
+
+func TestCheckFixedPointTypeMulDiv(t *testing.T) {
+
+	t.Parallel()
+
+	for _, ty := range sema.AllNumberTypes {
+
+		ty := ty
+
+		t.Run(ty.String(), func(t *testing.T) {
+
+			t.Parallel()
+
+			checker, err := parseAndCheckWithTestValue(t,
+				`
+                  let res = test.mulDiv
+                `,
+				ty,
+			)
+
+			switch ty.(type) {
+			case *sema.FixedPointType, *sema.SignedFixedPointType:
+
+				// The abstract fixed-point types do not have the function
+
+				errs := ExpectCheckerErrors(t, err, 1)
+
+				assert.IsType(t, &sema.NotDeclaredMemberError{}, errs[0])
+
+				return
+			}
+
+			if !sema.IsSubType(ty, &sema.FixedPointType{}) {
+
+				errs := ExpectCheckerErrors(t, err, 1)
+
+				assert.IsType(t, &sema.NotDeclaredMemberError{}, errs[0])
+
+				return
+			}
+
+			require.NoError(t, err)
+
+			assert.Equal(t,
+				&sema.FunctionType{
+					Parameters: []*sema.Parameter{
+						{
+							Label:          sema.ArgumentLabelNotRequired,
+							Identifier:     "a",
+							TypeAnnotation: sema.NewTypeAnnotation(ty),
+						},
+						{
+							Label:          sema.ArgumentLabelNotRequired,
+							Identifier:     "b",
+							TypeAnnotation: sema.NewTypeAnnotation(ty),
+						},
+					},
+					ReturnTypeAnnotation: sema.NewTypeAnnotation(ty),
+				},
+				checker.GlobalValues["res"].Type,
+			)
+		})
+	}
+
+	t.Run("invocation", func(t *testing.T) {
+
+		t.Parallel()
+
+		checker, err := ParseAndCheck(t, `
+          let x: UFix64 = 1.5
+          let res = x.mulDiv(2.0, 3.0)
+        `)
+
+		require.NoError(t, err)
+
+		assert.Equal(t,
+			&sema.UFix64Type{},
+			checker.GlobalValues["res"].Type,
+		)
+	})
+
+	t.Run("invalid argument type", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          let x: UFix64 = 1.5
+          let y: Fix64 = 2.0
+          let res = x.mulDiv(y, 3.0)
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.TypeMismatchError{}, errs[0])
+	})
+}
+
+func TestCheckIntegerTypeGCDAndLCM(t *testing.T) {
+
+	t.Parallel()
+
+	for _, ty := range sema.AllNumberTypes {
+
+		ty := ty
+
+		for _, name := range []string{"gcd", "lcm"} {
+
+			name := name
+
+			t.Run(fmt.Sprintf("%s, %s", ty, name), func(t *testing.T) {
+
+				t.Parallel()
+
+				checker, err := parseAndCheckWithTestValue(t,
+					fmt.Sprintf(
+						`
+                          let res = test.%s
+                        `,
+						name,
+					),
+					ty,
+				)
+
+				switch ty.(type) {
+				case *sema.IntegerType, *sema.SignedIntegerType:
+					// abstract integer types have no gcd and lcm functions
+
+				default:
+					if sema.IsSubType(ty, &sema.IntegerType{}) {
+
+						require.NoError(t, err)
+
+						assert.Equal(t,
+							&sema.FunctionType{
+								Parameters: []*sema.Parameter{
+									{
+										Label:          sema.ArgumentLabelNotRequired,
+										Identifier:     "other",
+										TypeAnnotation: sema.NewTypeAnnotation(ty),
+									},
+								},
+								ReturnTypeAnnotation: sema.NewTypeAnnotation(ty),
+							},
+							checker.GlobalValues["res"].Type,
+						)
+
+						return
+					}
+				}
+
+				errs := ExpectCheckerErrors(t, err, 1)
+
+				assert.IsType(t, &sema.NotDeclaredMemberError{}, errs[0])
+			})
+		}
+	}
+
+	t.Run("invocation", func(t *testing.T) {
+
+		t.Parallel()
+
+		checker, err := ParseAndCheck(t, `
+          let x: UInt64 = 12
+          let gcd = x.gcd(18)
+          let lcm = x.lcm(18)
+        `)
+
+		require.NoError(t, err)
+
+		assert.Equal(t,
+			&sema.UInt64Type{},
+			checker.GlobalValues["gcd"].Type,
+		)
+
+		assert.Equal(t,
+			&sema.UInt64Type{},
+			checker.GlobalValues["lcm"].Type,
+		)
+	})
+
+	t.Run("invalid argument type", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          let x: UInt64 = 12
+          let y: UInt32 = 18
+          let gcd = x.gcd(y)
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.TypeMismatchError{}, errs[0])
+	})
+}
+
+func TestCheckIntegerTypeBitwiseFunctions(t *testing.T) {
+
+	t.Parallel()
+
+	for _, ty := range sema.AllNumberTypes {
+
+		ty := ty
+
+		for name, parameter := range map[string]*sema.Parameter{
+			"bitwiseAnd": {
+				Label:          sema.ArgumentLabelNotRequired,
+				Identifier:     "other",
+				TypeAnnotation: sema.NewTypeAnnotation(ty),
+			},
+			"bitwiseOr": {
+				Label:          sema.ArgumentLabelNotRequired,
+				Identifier:     "other",
+				TypeAnnotation: sema.NewTypeAnnotation(ty),
+			},
+			"bitwiseXor": {
+				Label:          sema.ArgumentLabelNotRequired,
+				Identifier:     "other",
+				TypeAnnotation: sema.NewTypeAnnotation(ty),
+			},
+			"shiftLeft": {
+				Label:          sema.ArgumentLabelNotRequired,
+				Identifier:     "bits",
+				TypeAnnotation: sema.NewTypeAnnotation(&sema.UInt8Type{}),
+			},
+			"shiftRight": {
+				Label:          sema.ArgumentLabelNotRequired,
+				Identifier:     "bits",
+				TypeAnnotation: sema.NewTypeAnnotation(&sema.UInt8Type{}),
+			},
+		} {
+
+			name := name
+			parameter := parameter
+
+			t.Run(fmt.Sprintf("%s, %s", ty, name), func(t *testing.T) {
+
+				t.Parallel()
+
+				checker, err := parseAndCheckWithTestValue(t,
+					fmt.Sprintf(
+						`
+                          let res = test.%s
+                        `,
+						name,
+					),
+					ty,
+				)
+
+				switch ty.(type) {
+				case *sema.IntegerType, *sema.SignedIntegerType:
+					// abstract integer types have no bitwise functions
+
+				default:
+					if sema.IsSubType(ty, &sema.IntegerType{}) {
+
+						require.NoError(t, err)
+
+						assert.Equal(t,
+							&sema.FunctionType{
+								Parameters: []*sema.Parameter{
+									parameter,
+								},
+								ReturnTypeAnnotation: sema.NewTypeAnnotation(ty),
+							},
+							checker.GlobalValues["res"].Type,
+						)
+
+						return
+					}
+				}
+
+				errs := ExpectCheckerErrors(t, err, 1)
+
+				assert.IsType(t, &sema.NotDeclaredMemberError{}, errs[0])
+			})
+		}
+	}
+
+	t.Run("invalid shift amount type", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          let x: UInt64 = 1
+          let y: UInt64 = 2
+          let res = x.shiftLeft(y)
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.TypeMismatchError{}, errs[0])
+	})
+}
+
+func TestCheckUnsignedIntegerTypeWrappingFunctions(t *testing.T) {
+
+	t.Parallel()
+
+	for _, ty := range sema.AllNumberTypes {
+
+		ty := ty
+
+		for _, name := range []string{
+			"wrappingAdd",
+			"wrappingSubtract",
+			"wrappingMultiply",
+		} {
+
+			name := name
+
+			t.Run(fmt.Sprintf("%s, %s", ty, name), func(t *testing.T) {
+
+				t.Parallel()
+
+				checker, err := parseAndCheckWithTestValue(t,
+					fmt.Sprintf(
+						`
+                          let res = test.%s
+                        `,
+						name,
+					),
+					ty,
+				)
+
+				switch ty.(type) {
+				case *sema.UInt8Type, *sema.UInt16Type, *sema.UInt32Type, *sema.UInt64Type:
+
+					require.NoError(t, err)
+
+					assert.Equal(t,
+						&sema.FunctionType{
+							Parameters: []*sema.Parameter{
+								{
+									Label:          sema.ArgumentLabelNotRequired,
+									Identifier:     "other",
+									TypeAnnotation: sema.NewTypeAnnotation(ty),
+								},
+							},
+							ReturnTypeAnnotation: sema.NewTypeAnnotation(ty),
+						},
+						checker.GlobalValues["res"].Type,
+					)
+
+				default:
+					errs := ExpectCheckerErrors(t, err, 1)
+
+					assert.IsType(t, &sema.NotDeclaredMemberError{}, errs[0])
+				}
+			})
+		}
+	}
+}
+
+func TestCheckNumberTypeConversionFunctions(t *testing.T) {
+
+	t.Parallel()
+
+	for _, ty := range sema.AllNumberTypes {
+
+		ty := ty
+
+		for _, targetType := range sema.AllNumberTypes {
+
+			targetType := targetType
+
+			t.Run(fmt.Sprintf("%s to %s", ty, targetType), func(t *testing.T) {
+
+				t.Parallel()
+
+				checker, err := parseAndCheckWithTestValue(t,
+					fmt.Sprintf(
+						`
+                          let res = test.to%s
+                        `,
+						targetType,
+					),
+					ty,
+				)
+
+				switch ty.(type) {
+				case *sema.NumberType, *sema.SignedNumberType,
+					*sema.IntegerType, *sema.SignedIntegerType,
+					*sema.FixedPointType, *sema.SignedFixedPointType:
+
+					errs := ExpectCheckerErrors(t, err, 1)
+
+					assert.IsType(t, &sema.NotDeclaredMemberError{}, errs[0])
+
+					return
+				}
+
+				switch targetType.(type) {
+				case *sema.NumberType, *sema.SignedNumberType,
+					*sema.IntegerType, *sema.SignedIntegerType,
+					*sema.FixedPointType, *sema.SignedFixedPointType:
+
+					errs := ExpectCheckerErrors(t, err, 1)
+
+					assert.IsType(t, &sema.NotDeclaredMemberError{}, errs[0])
+
+					return
+				}
+
+				// There is no conversion function to the type itself
+
+				if targetType.Equal(ty) {
+
+					errs := ExpectCheckerErrors(t, err, 1)
+
+					assert.IsType(t, &sema.NotDeclaredMemberError{}, errs[0])
+
+					return
+				}
+
+				require.NoError(t, err)
+
+				assert.Equal(t,
+					&sema.FunctionType{
+						ReturnTypeAnnotation: sema.NewTypeAnnotation(targetType),
+					},
+					checker.GlobalValues["res"].Type,
+				)
+			})
+		}
+	}
+
+	t.Run("chained invocation", func(t *testing.T) {
+
+		t.Parallel()
+
+		checker, err := ParseAndCheck(t, `
+          let x: UInt8 = 42
+          let res = x.toInt().toUInt64()
+        `)
+
+		require.NoError(t, err)
+
+		assert.Equal(t,
+			&sema.UInt64Type{},
+			checker.GlobalValues["res"].Type,
+		)
+	})
 }
@@ -244,7 +244,7 @@ func TestCheckCapability_borrow(t *testing.T) {
 
 			errs := ExpectCheckerErrors(t, err, 1)
 
-			require.IsType(t, &sema.TypeMismatchError{}, errs[0])
+			require.IsType(t, &sema.InvalidCapabilityLinkTypeError{}, errs[0])
 		})
 
 		t.Run("struct", func(t *testing.T) {
@@ -260,7 +260,176 @@ func TestCheckCapability_borrow(t *testing.T) {
 
 			errs := ExpectCheckerErrors(t, err, 1)
 
-			require.IsType(t, &sema.TypeMismatchError{}, errs[0])
+			require.IsType(t, &sema.InvalidCapabilityLinkTypeError{}, errs[0])
+		})
+	})
+
+	t.Run("typed, authorized reference, explicit type argument", func(t *testing.T) {
+
+		t.Run("same type", func(t *testing.T) {
+
+			checker, err := ParseAndCheckWithPanic(t, `
+
+              resource R {}
+
+              let capability: Capability<auth &R> = panic("")
+
+              let r = capability.borrow<auth &R>()
+            `)
+
+			require.NoError(t, err)
+
+			rType := checker.GlobalTypes["R"].Type
+
+			require.Equal(t,
+				&sema.OptionalType{
+					Type: &sema.ReferenceType{
+						Authorized: true,
+						Type:       rType,
+					},
+				},
+				checker.GlobalValues["r"].Type,
+			)
+		})
+
+		t.Run("subtype", func(t *testing.T) {
+
+			checker, err := ParseAndCheckWithPanic(t, `
+
+              resource R {}
+
+              let capability: Capability<auth &AnyResource> = panic("")
+
+              let r = capability.borrow<auth &R>()
+            `)
+
+			require.NoError(t, err)
+
+			rType := checker.GlobalTypes["R"].Type
+
+			require.Equal(t,
+				&sema.OptionalType{
+					Type: &sema.ReferenceType{
+						Authorized: true,
+						Type:       rType,
+					},
+				},
+				checker.GlobalValues["r"].Type,
+			)
+		})
+
+		t.Run("unrelated type", func(t *testing.T) {
+
+			_, err := ParseAndCheckWithPanic(t, `
+
+              resource R {}
+
+              resource S {}
+
+              let capability: Capability<auth &R> = panic("")
+
+              let s = capability.borrow<auth &S>()
+            `)
+
+			errs := ExpectCheckerErrors(t, err, 1)
+
+			require.IsType(t, &sema.InvalidCapabilityBorrowTypeError{}, errs[0])
+		})
+
+		t.Run("unauthorized", func(t *testing.T) {
+
+			_, err := ParseAndCheckWithPanic(t, `
+
+              resource R {}
+
+              let capability: Capability<auth &R> = panic("")
+
+              let r = capability.borrow<&R>()
+            `)
+
+			errs := ExpectCheckerErrors(t, err, 1)
+
+			require.IsType(t, &sema.InvalidCapabilityBorrowTypeError{}, errs[0])
+		})
+	})
+
+	t.Run("typed, unauthorized reference, explicit type argument", func(t *testing.T) {
+
+		t.Run("same type", func(t *testing.T) {
+
+			checker, err := ParseAndCheckWithPanic(t, `
+
+              resource R {}
+
+              let capability: Capability<&R> = panic("")
+
+              let r = capability.borrow<&R>()
+            `)
+
+			require.NoError(t, err)
+
+			rType := checker.GlobalTypes["R"].Type
+
+			require.Equal(t,
+				&sema.OptionalType{
+					Type: &sema.ReferenceType{
+						Type: rType,
+					},
+				},
+				checker.GlobalValues["r"].Type,
+			)
+		})
+
+		t.Run("subtype", func(t *testing.T) {
+
+			_, err := ParseAndCheckWithPanic(t, `
+
+              resource interface I {}
+
+              resource R: I {}
+
+              let capability: Capability<&R{I}> = panic("")
+
+              let r = capability.borrow<&R>()
+            `)
+
+			errs := ExpectCheckerErrors(t, err, 1)
+
+			require.IsType(t, &sema.InvalidCapabilityBorrowTypeError{}, errs[0])
+		})
+
+		t.Run("unrelated type", func(t *testing.T) {
+
+			_, err := ParseAndCheckWithPanic(t, `
+
+              resource R {}
+
+              resource S {}
+
+              let capability: Capability<&R> = panic("")
+
+              let s = capability.borrow<&S>()
+            `)
+
+			errs := ExpectCheckerErrors(t, err, 1)
+
+			require.IsType(t, &sema.InvalidCapabilityBorrowTypeError{}, errs[0])
+		})
+
+		t.Run("authorized", func(t *testing.T) {
+
+			_, err := ParseAndCheckWithPanic(t, `
+
+              resource R {}
+
+              let capability: Capability<&R> = panic("")
+
+              let r = capability.borrow<auth &R>()
+            `)
+
+			errs := ExpectCheckerErrors(t, err, 1)
+
+			require.IsType(t, &sema.InvalidCapabilityBorrowTypeError{}, errs[0])
 		})
 	})
 }
@@ -406,7 +575,7 @@ func TestCheckCapability_check(t *testing.T) {
 
 			errs := ExpectCheckerErrors(t, err, 1)
 
-			require.IsType(t, &sema.TypeMismatchError{}, errs[0])
+			require.IsType(t, &sema.InvalidCapabilityLinkTypeError{}, errs[0])
 		})
 
 		t.Run("struct", func(t *testing.T) {
@@ -422,7 +591,77 @@ func TestCheckCapability_check(t *testing.T) {
 
 			errs := ExpectCheckerErrors(t, err, 1)
 
-			require.IsType(t, &sema.TypeMismatchError{}, errs[0])
+			require.IsType(t, &sema.InvalidCapabilityLinkTypeError{}, errs[0])
+		})
+	})
+
+	t.Run("typed, explicit type argument", func(t *testing.T) {
+
+		t.Run("same type", func(t *testing.T) {
+
+			checker, err := ParseAndCheckWithPanic(t, `
+
+              resource R {}
+
+              let capability: Capability<&R> = panic("")
+
+              let ok = capability.check<&R>()
+            `)
+
+			require.NoError(t, err)
+
+			require.Equal(t,
+				&sema.BoolType{},
+				checker.GlobalValues["ok"].Type,
+			)
+		})
+
+		t.Run("authorized, subtype", func(t *testing.T) {
+
+			_, err := ParseAndCheckWithPanic(t, `
+
+              resource R {}
+
+              let capability: Capability<auth &AnyResource> = panic("")
+
+              let ok = capability.check<auth &R>()
+            `)
+
+			require.NoError(t, err)
+		})
+
+		t.Run("unauthorized, subtype", func(t *testing.T) {
+
+			_, err := ParseAndCheckWithPanic(t, `
+
+              resource R {}
+
+              let capability: Capability<&AnyResource> = panic("")
+
+              let ok = capability.check<&R>()
+            `)
+
+			errs := ExpectCheckerErrors(t, err, 1)
+
+			require.IsType(t, &sema.InvalidCapabilityBorrowTypeError{}, errs[0])
+		})
+
+		t.Run("unrelated type", func(t *testing.T) {
+
+			_, err := ParseAndCheckWithPanic(t, `
+
+              resource R {}
+
+              resource S {}
+
+              let capability: Capability<auth &R> = panic("")
+
+              let ok = capability.check<auth &S>()
+            `)
+
+			errs := ExpectCheckerErrors(t, err, 1)
+
+			require.IsType(t, &sema.InvalidCapabilityBorrowTypeError{}, errs[0])
 		})
 	})
 }
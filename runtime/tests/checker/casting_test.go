@@ -5892,6 +5892,102 @@ func TestCheckCastUnauthorizedStructReferenceType(t *testing.T) {
 	}
 }
 
+func TestCheckCastAuthorizedReferenceDowncast(t *testing.T) {
+
+	t.Parallel()
+
+	const types = `
+      struct interface I {}
+
+      struct S1: I {}
+
+      struct S2: I {}
+
+      let x = S1()
+    `
+
+	t.Run("AnyStruct -> struct", func(t *testing.T) {
+
+		checker, err := ParseAndCheck(t,
+			types+`
+              let ref = &x as auth &AnyStruct
+              let ref2 = ref as? auth &S1
+            `,
+		)
+
+		require.NoError(t, err)
+
+		assert.Equal(t,
+			&sema.OptionalType{
+				Type: &sema.ReferenceType{
+					Authorized: true,
+					Type:       checker.GlobalTypes["S1"].Type,
+				},
+			},
+			checker.GlobalValues["ref2"].Type,
+		)
+	})
+
+	t.Run("restricted AnyStruct -> conforming struct", func(t *testing.T) {
+
+		_, err := ParseAndCheck(t,
+			types+`
+              let ref = &x as auth &AnyStruct{I}
+              let ref2 = ref as? auth &S1
+            `,
+		)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("struct -> other struct", func(t *testing.T) {
+
+		_, err := ParseAndCheck(t,
+			types+`
+              let ref = &x as auth &S1
+              let ref2 = ref as? auth &S2
+            `,
+		)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.TypeMismatchError{}, errs[0])
+	})
+
+	t.Run("unauthorized AnyStruct -> struct", func(t *testing.T) {
+
+		_, err := ParseAndCheck(t,
+			types+`
+              let ref = &x as &AnyStruct
+              let ref2 = ref as? auth &S1
+            `,
+		)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.TypeMismatchError{}, errs[0])
+	})
+
+	t.Run("type requirement -> conforming composite", func(t *testing.T) {
+
+		_, err := ParseAndCheck(t, `
+          contract interface CI {
+              resource R {}
+          }
+
+          contract C: CI {
+              resource R {}
+
+              fun test(ref: auth &CI.R): auth &R? {
+                  return ref as? auth &R
+              }
+          }
+        `)
+
+		require.NoError(t, err)
+	})
+}
+
 func TestCheckCastAuthorizedNonCompositeReferenceType(t *testing.T) {
 
 	t.Parallel()
@@ -55,3 +55,41 @@ func TestCheckInvalidCharacterLiteral(t *testing.T) {
 
 	assert.IsType(t, &sema.InvalidCharacterLiteralError{}, errs[0])
 }
+
+func TestCheckCharacterMembers(t *testing.T) {
+
+	t.Parallel()
+
+	checker, err := ParseAndCheck(t, `
+        let str = "abc"
+        let a = str[0].toString()
+        let b = str[1].utf8
+    `)
+
+	require.NoError(t, err)
+
+	assert.Equal(t,
+		&sema.StringType{},
+		checker.GlobalValues["a"].Type,
+	)
+
+	assert.Equal(t,
+		&sema.VariableSizedType{
+			Type: &sema.UInt8Type{},
+		},
+		checker.GlobalValues["b"].Type,
+	)
+}
+
+func TestCheckInvalidStringCharacterMembers(t *testing.T) {
+
+	t.Parallel()
+
+	_, err := ParseAndCheck(t, `
+        let a = "abc".utf8
+    `)
+
+	errs := ExpectCheckerErrors(t, err, 1)
+
+	assert.IsType(t, &sema.NotDeclaredMemberError{}, errs[0])
+}
@@ -586,6 +586,48 @@ func TestCheckInvalidCompositeFunction(t *testing.T) {
 	}
 }
 
+func TestCheckCompositeFieldsOrder(t *testing.T) {
+
+	t.Parallel()
+
+	const code = `
+      resource R {
+          let z: Int
+          fun b() {}
+          let y: Int
+          let a: Int
+          fun a2() {}
+          let x: Int
+          let m: Int
+
+          init() {
+              self.z = 1
+              self.y = 2
+              self.a = 3
+              self.x = 4
+              self.m = 5
+          }
+      }
+    `
+
+	expectedFields := []string{
+		sema.ResourceOwnerFieldName,
+		sema.ResourceUUIDFieldName,
+		"z",
+		"y",
+		"a",
+		"x",
+		"m",
+	}
+
+	checker, err := ParseAndCheck(t, code)
+	require.NoError(t, err)
+
+	compositeType := checker.GlobalTypes["R"].Type.(*sema.CompositeType)
+
+	assert.Equal(t, expectedFields, compositeType.Fields)
+}
+
 func TestCheckCompositeInitializerSelfUse(t *testing.T) {
 
 	t.Parallel()
@@ -1946,6 +1988,42 @@ func TestCheckInvalidResourceWithDestructorMissingDefinitiveFieldInvalidation(t
 	assert.IsType(t, &sema.ResourceFieldNotInvalidatedError{}, errs[0])
 }
 
+func TestCheckInvalidResourceWithDestructorMissingMultipleFieldInvalidations(t *testing.T) {
+
+	t.Parallel()
+
+	_, err := ParseAndCheck(t, `
+       resource Test {
+           let a: @Test?
+           let b: @Test?
+           let c: @Test?
+
+           init(a: @Test?, b: @Test?, c: @Test?) {
+               self.a <- a
+               self.b <- b
+               self.c <- c
+           }
+
+           destroy() {
+               destroy self.b
+           }
+       }
+    `)
+
+	errs := ExpectCheckerErrors(t, err, 2)
+
+	// All fields which are not invalidated are reported,
+	// in the order they are declared
+
+	var fieldNames []string
+	for _, err := range errs {
+		require.IsType(t, &sema.ResourceFieldNotInvalidatedError{}, err)
+		fieldNames = append(fieldNames, err.(*sema.ResourceFieldNotInvalidatedError).FieldName)
+	}
+
+	assert.Equal(t, []string{"a", "c"}, fieldNames)
+}
+
 func TestCheckResourceWithDestructorAndStructField(t *testing.T) {
 
 	t.Parallel()
@@ -22,6 +22,7 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
 	"github.com/onflow/cadence/runtime/sema"
@@ -48,6 +49,178 @@ func TestCheckInvalidEventTypeRequirementConformance(t *testing.T) {
 	require.IsType(t, &sema.ConformanceError{}, errs[0])
 }
 
+func TestCheckEventTypeRequirementConformance(t *testing.T) {
+
+	t.Parallel()
+
+	test := func(interfaceCode string, conformanceCode string) error {
+		_, err := ParseAndCheck(t,
+			fmt.Sprintf(
+				`
+                  pub contract interface CI {
+                      %s
+                  }
+
+                  pub contract C: CI {
+                      %s
+                  }
+                `,
+				interfaceCode,
+				conformanceCode,
+			),
+		)
+		return err
+	}
+
+	t.Run("valid", func(t *testing.T) {
+
+		t.Parallel()
+
+		err := test(
+			`pub event E(a: Int, b: String)`,
+			`
+              pub event E(a: Int, b: String)
+
+              pub fun test() {
+                  emit E(a: 1, b: "2")
+              }
+            `,
+		)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("missing", func(t *testing.T) {
+
+		t.Parallel()
+
+		err := test(
+			`pub event E(a: Int)`,
+			``,
+		)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		require.IsType(t, &sema.ConformanceError{}, errs[0])
+
+		assert.Len(t, errs[0].(*sema.ConformanceError).MissingNestedCompositeTypes, 1)
+	})
+
+	t.Run("different parameter identifier", func(t *testing.T) {
+
+		t.Parallel()
+
+		// The parameters of an event define its fields,
+		// so the field of the event type requirement is missing
+
+		err := test(
+			`pub event E(a: Int)`,
+			`pub event E(b: Int)`,
+		)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		require.IsType(t, &sema.ConformanceError{}, errs[0])
+
+		assert.Len(t, errs[0].(*sema.ConformanceError).MissingMembers, 1)
+	})
+
+	t.Run("different argument label", func(t *testing.T) {
+
+		t.Parallel()
+
+		err := test(
+			`pub event E(a: Int)`,
+			`pub event E(_ a: Int)`,
+		)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("different parameter type", func(t *testing.T) {
+
+		t.Parallel()
+
+		err := test(
+			`pub event E(a: Int)`,
+			`pub event E(a: String)`,
+		)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		require.IsType(t, &sema.ConformanceError{}, errs[0])
+
+		assert.NotNil(t, errs[0].(*sema.ConformanceError).InitializerMismatch)
+	})
+
+	t.Run("different parameter count", func(t *testing.T) {
+
+		t.Parallel()
+
+		err := test(
+			`pub event E(a: Int)`,
+			`pub event E(a: Int, b: Int)`,
+		)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		require.IsType(t, &sema.ConformanceError{}, errs[0])
+	})
+
+	t.Run("structure", func(t *testing.T) {
+
+		t.Parallel()
+
+		err := test(
+			`pub event E(a: Int)`,
+			`
+              pub struct E {
+                  pub let a: Int
+
+                  init(a: Int) {
+                      self.a = a
+                  }
+              }
+            `,
+		)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		require.IsType(t, &sema.CompositeKindMismatchError{}, errs[0])
+	})
+
+}
+
+func TestCheckInvalidNestedEventInInterface(t *testing.T) {
+
+	t.Parallel()
+
+	for _, kind := range []string{"struct", "resource"} {
+
+		kind := kind
+
+		t.Run(kind, func(t *testing.T) {
+
+			t.Parallel()
+
+			_, err := ParseAndCheck(t,
+				fmt.Sprintf(
+					`
+                      pub %s interface I {
+                          pub event E(a: Int)
+                      }
+                    `,
+					kind,
+				),
+			)
+
+			errs := ExpectCheckerErrors(t, err, 1)
+
+			require.IsType(t, &sema.InvalidNestedDeclarationError{}, errs[0])
+		})
+	}
+}
+
 func TestCheckTypeRequirementConformance(t *testing.T) {
 
 	t.Parallel()
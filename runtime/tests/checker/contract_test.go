@@ -712,3 +712,81 @@ func TestCheckInvalidContractNestedTypeShadowing(t *testing.T) {
 		})
 	}
 }
+
+func TestCheckContractPublicMutableFieldHint(t *testing.T) {
+
+	t.Parallel()
+
+	const code = `
+      contract C {
+
+          pub var a: Int
+          pub(set) var b: Int
+          pub let c: Int
+          access(contract) var d: Int
+          priv var e: Int
+
+          init() {
+              self.a = 1
+              self.b = 2
+              self.c = 3
+              self.d = 4
+              self.e = 5
+          }
+      }
+
+      struct S {
+
+          pub var f: Int
+
+          init() {
+              self.f = 6
+          }
+      }
+    `
+
+	t.Run("enabled", func(t *testing.T) {
+
+		t.Parallel()
+
+		checker, err := ParseAndCheckWithOptions(t,
+			code,
+			ParseAndCheckOptions{
+				Options: []sema.Option{
+					sema.WithPublicMutableContractFieldHints(true),
+				},
+			},
+		)
+
+		require.NoError(t, err)
+
+		hints := checker.Hints()
+		require.Len(t, hints, 2)
+
+		for i, fieldName := range []string{"a", "b"} {
+			require.IsType(t, &sema.PublicMutableContractFieldHint{}, hints[i])
+
+			hint := hints[i].(*sema.PublicMutableContractFieldHint)
+
+			assert.Equal(t, fieldName, hint.FieldName)
+		}
+
+		assert.Equal(t,
+			"contract field `a` is public and mutable, "+
+				"consider restricting its access, e.g. with `access(contract)`, "+
+				"and exposing its value through a getter function",
+			hints[0].Hint(),
+		)
+	})
+
+	t.Run("disabled", func(t *testing.T) {
+
+		t.Parallel()
+
+		checker, err := ParseAndCheck(t, code)
+
+		require.NoError(t, err)
+
+		assert.Empty(t, checker.Hints())
+	})
+}
@@ -0,0 +1,350 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2020 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package checker
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/cadence/runtime/common"
+	"github.com/onflow/cadence/runtime/sema"
+)
+
+func TestCheckEnum(t *testing.T) {
+
+	t.Parallel()
+
+	checker, err := ParseAndCheck(t, `
+      enum E: UInt8 {
+          case a
+          case b
+      }
+    `)
+
+	require.NoError(t, err)
+
+	enumType := checker.GlobalTypes["E"].Type
+
+	require.IsType(t, &sema.CompositeType{}, enumType)
+	enumCompositeType := enumType.(*sema.CompositeType)
+
+	assert.Equal(t, common.CompositeKindEnum, enumCompositeType.Kind)
+	assert.Equal(t, &sema.UInt8Type{}, enumCompositeType.EnumRawType)
+	assert.Equal(t, []string{sema.EnumRawValueFieldName}, enumCompositeType.Fields)
+	assert.Equal(t,
+		&sema.UInt8Type{},
+		enumCompositeType.Members[sema.EnumRawValueFieldName].TypeAnnotation.Type,
+	)
+	assert.True(t, enumCompositeType.IsEquatable())
+}
+
+func TestCheckEnumCaseRawValue(t *testing.T) {
+
+	t.Parallel()
+
+	checker, err := ParseAndCheck(t, `
+      enum E: UInt8 {
+          case a
+          case b
+      }
+
+      let a = E.a
+      let rawValue = E.b.rawValue
+    `)
+
+	require.NoError(t, err)
+
+	assert.Equal(t,
+		checker.GlobalTypes["E"].Type,
+		checker.GlobalValues["a"].Type,
+	)
+
+	assert.Equal(t,
+		&sema.UInt8Type{},
+		checker.GlobalValues["rawValue"].Type,
+	)
+}
+
+func TestCheckEnumConstructor(t *testing.T) {
+
+	t.Parallel()
+
+	checker, err := ParseAndCheck(t, `
+      enum E: Int64 {
+          case a
+      }
+
+      let e = E(rawValue: 0)
+    `)
+
+	require.NoError(t, err)
+
+	assert.Equal(t,
+		&sema.OptionalType{
+			Type: checker.GlobalTypes["E"].Type,
+		},
+		checker.GlobalValues["e"].Type,
+	)
+}
+
+func TestCheckEnumValues(t *testing.T) {
+
+	t.Parallel()
+
+	checker, err := ParseAndCheck(t, `
+      enum E: UInt8 {
+          case a
+          case b
+      }
+
+      let values = E.values
+    `)
+
+	require.NoError(t, err)
+
+	assert.Equal(t,
+		&sema.VariableSizedType{
+			Type: checker.GlobalTypes["E"].Type,
+		},
+		checker.GlobalValues["values"].Type,
+	)
+}
+
+func TestCheckInvalidEnumCaseValues(t *testing.T) {
+
+	t.Parallel()
+
+	_, err := ParseAndCheck(t, `
+      enum E: UInt8 {
+          case values
+      }
+    `)
+
+	errs := ExpectCheckerErrors(t, err, 1)
+
+	assert.IsType(t, &sema.InvalidDeclarationError{}, errs[0])
+}
+
+func TestCheckEnumEquality(t *testing.T) {
+
+	t.Parallel()
+
+	_, err := ParseAndCheck(t, `
+      enum E: Int {
+          case a
+          case b
+      }
+
+      let equal = E.a == E.b
+      let notEqual = E.a != E(rawValue: 1)
+    `)
+
+	require.NoError(t, err)
+}
+
+func TestCheckEnumNestedInContract(t *testing.T) {
+
+	t.Parallel()
+
+	_, err := ParseAndCheck(t, `
+      contract C {
+          enum E: UInt8 {
+              pub case a
+          }
+
+          fun test(): UInt8 {
+              return E.a.rawValue
+          }
+      }
+    `)
+
+	require.NoError(t, err)
+}
+
+func TestCheckInvalidEnumNestedInContractInterface(t *testing.T) {
+
+	t.Parallel()
+
+	_, err := ParseAndCheck(t, `
+      contract interface CI {
+          enum E: UInt8 {
+              case a
+          }
+      }
+    `)
+
+	errs := ExpectCheckerErrors(t, err, 1)
+
+	assert.IsType(t, &sema.InvalidNestedDeclarationError{}, errs[0])
+}
+
+func TestCheckInvalidEnumRawType(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("missing", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          enum E {
+              case a
+          }
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.MissingEnumRawTypeError{}, errs[0])
+	})
+
+	t.Run("non-integer", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          enum E: String {
+              case a
+          }
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.InvalidEnumRawTypeError{}, errs[0])
+	})
+
+	t.Run("abstract integer", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          enum E: Integer {
+              case a
+          }
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.InvalidEnumRawTypeError{}, errs[0])
+	})
+
+	t.Run("conformances", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          struct interface SI {}
+
+          enum E: UInt8, SI {
+              case a
+          }
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.InvalidEnumConformancesError{}, errs[0])
+	})
+}
+
+func TestCheckInvalidEnumMembers(t *testing.T) {
+
+	t.Parallel()
+
+	_, err := ParseAndCheck(t, `
+      enum E: UInt8 {
+          case a
+          case a
+          fun test() {}
+      }
+    `)
+
+	errs := ExpectCheckerErrors(t, err, 2)
+
+	assert.IsType(t, &sema.RedeclarationError{}, errs[0])
+	assert.IsType(t, &sema.InvalidNonEnumCaseError{}, errs[1])
+}
+
+func TestCheckInvalidEnumCaseOutsideEnum(t *testing.T) {
+
+	t.Parallel()
+
+	_, err := ParseAndCheck(t, `
+      struct S {
+          case a
+      }
+    `)
+
+	errs := ExpectCheckerErrors(t, err, 1)
+
+	assert.IsType(t, &sema.InvalidEnumCaseError{}, errs[0])
+}
+
+func TestCheckInvalidEnumCaseCount(t *testing.T) {
+
+	t.Parallel()
+
+	caseDeclarations := func(count int) string {
+		var builder strings.Builder
+		for i := 0; i < count; i++ {
+			builder.WriteString(fmt.Sprintf("case c%d\n", i))
+		}
+		return builder.String()
+	}
+
+	t.Run("maximum", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t,
+			fmt.Sprintf(
+				`
+                  enum E: UInt8 {
+                      %s
+                  }
+                `,
+				caseDeclarations(256),
+			),
+		)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("too many", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t,
+			fmt.Sprintf(
+				`
+                  enum E: Int8 {
+                      %s
+                  }
+                `,
+				caseDeclarations(130),
+			),
+		)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.InvalidEnumCaseCountError{}, errs[0])
+	})
+}
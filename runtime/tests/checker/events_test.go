@@ -182,6 +182,68 @@ func TestCheckEventDeclaration(t *testing.T) {
 		}
 	})
 
+	t.Run("InvalidNestedParameterTypes", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          struct S {
+              let capability: Capability
+
+              init(capability: Capability) {
+                  self.capability = capability
+              }
+          }
+
+          event Transfer(
+              a: [Capability],
+              b: Int,
+              c: {String: Path}?,
+              d: [S]
+          )
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 3)
+
+		expected := []struct {
+			line            int
+			unsupportedType sema.Type
+		}{
+			{11, &sema.CapabilityType{}},
+			{13, &sema.PathType{}},
+			{14, &sema.CapabilityType{}},
+		}
+
+		for i, expected := range expected {
+			require.IsType(t, &sema.InvalidEventParameterTypeError{}, errs[i])
+			invalidParameterTypeErr := errs[i].(*sema.InvalidEventParameterTypeError)
+
+			assert.Equal(t, expected.line, invalidParameterTypeErr.StartPos.Line)
+			assert.Equal(t, expected.unsupportedType, invalidParameterTypeErr.UnsupportedType)
+		}
+	})
+
+	t.Run("RecursiveStructureParameterType", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          struct Node {
+              let value: Int
+              let children: [Node]
+
+              init(value: Int, children: [Node]) {
+                  self.value = value
+                  self.children = children
+              }
+          }
+
+          event Tree(root: Node)
+        `)
+
+		require.NoError(t, err)
+	})
+
 	t.Run("RedeclaredEvent", func(t *testing.T) {
 
 		t.Parallel()
@@ -257,6 +319,48 @@ func TestCheckEmitEvent(t *testing.T) {
 		assert.IsType(t, &sema.NotDeclaredError{}, errs[0])
 	})
 
+	t.Run("EmitInDestructor", func(t *testing.T) {
+		_, err := ParseAndCheck(t, `
+            event Destroyed(id: UInt64)
+
+            resource R {
+                let id: UInt64
+
+                init(id: UInt64) {
+                    self.id = id
+                }
+
+                destroy() {
+                    emit Destroyed(id: self.id)
+                }
+            }
+        `)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("EmitInDestructorInvalidArgument", func(t *testing.T) {
+		_, err := ParseAndCheck(t, `
+            event Destroyed(id: UInt64)
+
+            resource R {
+                let name: String
+
+                init(name: String) {
+                    self.name = name
+                }
+
+                destroy() {
+                    emit Destroyed(id: self.name)
+                }
+            }
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.TypeMismatchError{}, errs[0])
+	})
+
 	t.Run("EmitImported", func(t *testing.T) {
 
 		importedChecker, err := ParseAndCheckWithOptions(t,
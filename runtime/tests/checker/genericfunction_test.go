@@ -29,6 +29,7 @@ import (
 	"github.com/onflow/cadence/runtime/common"
 	"github.com/onflow/cadence/runtime/sema"
 	"github.com/onflow/cadence/runtime/stdlib"
+	"github.com/onflow/cadence/runtime/tests/utils"
 )
 
 func parseAndCheckWithTestValue(t *testing.T, code string, ty sema.Type) (*sema.Checker, error) {
@@ -899,3 +900,395 @@ func TestCheckBorrowOfCapabilityWithoutTypeArgument(t *testing.T) {
 
 	require.NoError(t, err)
 }
+
+func TestCheckGenericFunctionCapabilityWithoutTypeArgument(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("valid: generic parameter, bare capability argument", func(t *testing.T) {
+
+		t.Parallel()
+
+		typeParameter := &sema.TypeParameter{
+			Name: "T",
+		}
+
+		checker, err := parseAndCheckWithTestValue(t,
+			`
+              fun f(cap: Capability): Capability {
+                  return test(cap)
+              }
+            `,
+			&sema.FunctionType{
+				TypeParameters: []*sema.TypeParameter{
+					typeParameter,
+				},
+				Parameters: []*sema.Parameter{
+					{
+						Label:      sema.ArgumentLabelNotRequired,
+						Identifier: "value",
+						TypeAnnotation: sema.NewTypeAnnotation(
+							&sema.GenericType{
+								TypeParameter: typeParameter,
+							},
+						),
+					},
+				},
+				ReturnTypeAnnotation: sema.NewTypeAnnotation(
+					&sema.GenericType{
+						TypeParameter: typeParameter,
+					},
+				),
+			},
+		)
+
+		require.NoError(t, err)
+
+		// The borrow type of the type argument remains unspecified
+
+		var typeArgument sema.Type
+		for _, typeArguments := range checker.Elaboration.InvocationExpressionTypeArguments {
+			typeArgument = typeArguments[typeParameter]
+		}
+
+		assert.Equal(t, &sema.CapabilityType{}, typeArgument)
+	})
+
+	capabilityParameterTestType := func(typeParameter *sema.TypeParameter) *sema.FunctionType {
+		return &sema.FunctionType{
+			TypeParameters: []*sema.TypeParameter{
+				typeParameter,
+			},
+			Parameters: []*sema.Parameter{
+				{
+					Label:      sema.ArgumentLabelNotRequired,
+					Identifier: "cap",
+					TypeAnnotation: sema.NewTypeAnnotation(
+						&sema.CapabilityType{
+							BorrowType: &sema.GenericType{
+								TypeParameter: typeParameter,
+							},
+						},
+					),
+				},
+			},
+			ReturnTypeAnnotation: sema.NewTypeAnnotation(
+				&sema.CapabilityType{
+					BorrowType: &sema.GenericType{
+						TypeParameter: typeParameter,
+					},
+				},
+			),
+		}
+	}
+
+	t.Run("invalid: capability parameter, bare capability argument", func(t *testing.T) {
+
+		t.Parallel()
+
+		typeParameter := &sema.TypeParameter{
+			Name: "T",
+			TypeBound: &sema.ReferenceType{
+				Type: &sema.AnyType{},
+			},
+		}
+
+		_, err := parseAndCheckWithTestValue(t,
+			`
+              fun f(cap: Capability) {
+                  test(cap)
+              }
+            `,
+			capabilityParameterTestType(typeParameter),
+		)
+
+		errs := ExpectCheckerErrors(t, err, 2)
+
+		assert.IsType(t, &sema.TypeMismatchError{}, errs[0])
+		assert.IsType(t, &sema.TypeParameterTypeInferenceError{}, errs[1])
+	})
+
+	t.Run("valid: capability parameter, capability argument", func(t *testing.T) {
+
+		t.Parallel()
+
+		typeParameter := &sema.TypeParameter{
+			Name: "T",
+			TypeBound: &sema.ReferenceType{
+				Type: &sema.AnyType{},
+			},
+		}
+
+		_, err := parseAndCheckWithTestValue(t,
+			`
+              fun f(cap: Capability<&Int>): Capability<&Int> {
+                  return test(cap)
+              }
+            `,
+			capabilityParameterTestType(typeParameter),
+		)
+
+		require.NoError(t, err)
+	})
+}
+
+func TestCheckGenericFunctionWhereConstraints(t *testing.T) {
+
+	t.Parallel()
+
+	newInterfaceType := func(identifier string) *sema.InterfaceType {
+		return &sema.InterfaceType{
+			Location:      utils.TestLocation,
+			Identifier:    identifier,
+			CompositeKind: common.CompositeKindStructure,
+			Members:       map[string]*sema.Member{},
+		}
+	}
+
+	interfaceType1 := newInterfaceType("I1")
+	interfaceType2 := newInterfaceType("I2")
+
+	newRestrictedType := func(interfaceType *sema.InterfaceType) *sema.RestrictedType {
+		return &sema.RestrictedType{
+			Type:         &sema.AnyStructType{},
+			Restrictions: []*sema.InterfaceType{interfaceType},
+		}
+	}
+
+	// `fun test<T where T: AnyStruct{I1}, T: AnyStruct{I2}>(_ value: T)`
+
+	typeParameter := &sema.TypeParameter{
+		Name: "T",
+		Constraints: []sema.Type{
+			newRestrictedType(interfaceType1),
+			newRestrictedType(interfaceType2),
+		},
+	}
+
+	testFunctionType := &sema.FunctionType{
+		TypeParameters: []*sema.TypeParameter{
+			typeParameter,
+		},
+		Parameters: []*sema.Parameter{
+			{
+				Label:      sema.ArgumentLabelNotRequired,
+				Identifier: "value",
+				TypeAnnotation: sema.NewTypeAnnotation(
+					&sema.GenericType{
+						TypeParameter: typeParameter,
+					},
+				),
+			},
+		},
+		ReturnTypeAnnotation: sema.NewTypeAnnotation(&sema.VoidType{}),
+	}
+
+	assert.Equal(t,
+		"T where T: AnyStruct{I1}, T: AnyStruct{I2}",
+		typeParameter.String(),
+	)
+
+	newCompositeType := func(identifier string, conformances ...*sema.InterfaceType) *sema.CompositeType {
+		return &sema.CompositeType{
+			Location:                      utils.TestLocation,
+			Identifier:                    identifier,
+			Kind:                          common.CompositeKindStructure,
+			ExplicitInterfaceConformances: conformances,
+			Members:                       map[string]*sema.Member{},
+		}
+	}
+
+	parseAndCheck := func(argumentType sema.Type) error {
+		_, err := ParseAndCheckWithOptions(t,
+			`
+              let res = test(value)
+            `,
+			ParseAndCheckOptions{
+				Options: []sema.Option{
+					sema.WithPredeclaredValues(map[string]sema.ValueDeclaration{
+						"test": stdlib.StandardLibraryValue{
+							Name:       "test",
+							Type:       testFunctionType,
+							Kind:       common.DeclarationKindConstant,
+							IsConstant: true,
+						},
+						"value": stdlib.StandardLibraryValue{
+							Name:       "value",
+							Type:       argumentType,
+							Kind:       common.DeclarationKindConstant,
+							IsConstant: true,
+						},
+					}),
+				},
+			},
+		)
+		return err
+	}
+
+	t.Run("valid: both constraints satisfied", func(t *testing.T) {
+
+		t.Parallel()
+
+		err := parseAndCheck(
+			newCompositeType("S", interfaceType1, interfaceType2),
+		)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("invalid: one constraint not satisfied", func(t *testing.T) {
+
+		t.Parallel()
+
+		err := parseAndCheck(
+			newCompositeType("S", interfaceType1),
+		)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.TypeMismatchError{}, errs[0])
+	})
+
+	t.Run("invalid: no constraint satisfied", func(t *testing.T) {
+
+		t.Parallel()
+
+		err := parseAndCheck(&sema.IntType{})
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.TypeMismatchError{}, errs[0])
+	})
+}
+
+func TestCheckGenericFunctionTypeParameterConstraintsEquality(t *testing.T) {
+
+	t.Parallel()
+
+	typeParameter := &sema.TypeParameter{
+		Name:        "T",
+		TypeBound:   &sema.NumberType{},
+		Constraints: []sema.Type{&sema.SignedNumberType{}},
+	}
+
+	assert.True(t,
+		typeParameter.Equal(&sema.TypeParameter{
+			Name:        "T",
+			TypeBound:   &sema.NumberType{},
+			Constraints: []sema.Type{&sema.SignedNumberType{}},
+		}),
+	)
+
+	assert.False(t,
+		typeParameter.Equal(&sema.TypeParameter{
+			Name:      "T",
+			TypeBound: &sema.NumberType{},
+		}),
+	)
+}
+
+func TestCheckGenericFunctionStorableTypeBound(t *testing.T) {
+
+	t.Parallel()
+
+	// `fun test<T: Storable>(_ value: T)`,
+	// and `fun test<T: AnyStruct where T: Storable>(_ value: T)`
+
+	for _, typeParameter := range []*sema.TypeParameter{
+		{
+			Name:      "T",
+			TypeBound: &sema.StorableType{},
+		},
+		{
+			Name:      "T",
+			TypeBound: &sema.AnyStructType{},
+			Constraints: []sema.Type{
+				&sema.StorableType{},
+			},
+		},
+	} {
+
+		testFunctionType := &sema.FunctionType{
+			TypeParameters: []*sema.TypeParameter{
+				typeParameter,
+			},
+			Parameters: []*sema.Parameter{
+				{
+					Label:      sema.ArgumentLabelNotRequired,
+					Identifier: "value",
+					TypeAnnotation: sema.NewTypeAnnotation(
+						&sema.GenericType{
+							TypeParameter: typeParameter,
+						},
+					),
+				},
+			},
+			ReturnTypeAnnotation: sema.NewTypeAnnotation(&sema.VoidType{}),
+		}
+
+		t.Run(typeParameter.String(), func(t *testing.T) {
+
+			t.Run("valid: storable argument", func(t *testing.T) {
+
+				_, err := parseAndCheckWithTestValue(t,
+					`
+                      struct S {
+                          let xs: [Int]
+
+                          init() {
+                              self.xs = [1]
+                          }
+                      }
+
+                      fun run() {
+                          test(1)
+                          test(["a"])
+                          test(S())
+                      }
+                    `,
+					testFunctionType,
+				)
+
+				require.NoError(t, err)
+			})
+
+			t.Run("invalid: non-storable argument", func(t *testing.T) {
+
+				_, err := parseAndCheckWithTestValue(t,
+					`
+                      fun run() {
+                          test(fun (): Int {
+                              return 1
+                          })
+                      }
+                    `,
+					testFunctionType,
+				)
+
+				errs := ExpectCheckerErrors(t, err, 1)
+
+				assert.IsType(t, &sema.TypeMismatchError{}, errs[0])
+			})
+
+			t.Run("invalid: non-storable type argument", func(t *testing.T) {
+
+				_, err := parseAndCheckWithTestValue(t,
+					`
+                      fun f(): Int {
+                          return 1
+                      }
+
+                      fun run() {
+                          test<((): Int)>(f)
+                      }
+                    `,
+					testFunctionType,
+				)
+
+				errs := ExpectCheckerErrors(t, err, 1)
+
+				assert.IsType(t, &sema.TypeMismatchError{}, errs[0])
+			})
+		})
+	}
+}
@@ -131,6 +131,78 @@ func TestCheckInvalidFieldInitializationWithUseBeforeAllFieldsInitialized(t *tes
 	assert.IsType(t, &sema.UninitializedUseError{}, errs[0])
 }
 
+func TestCheckInvalidFieldInitializationWithFunctionCallInNestedFunctionBeforeAllFieldsInitialized(t *testing.T) {
+
+	t.Parallel()
+
+	_, err := ParseAndCheck(t, `
+      struct Test {
+          var foo: Int
+
+          init() {
+              let f = fun () {
+                  self.bar()
+              }
+              f()
+              self.foo = 1
+          }
+
+          fun bar() {}
+      }
+    `)
+
+	errs := ExpectCheckerErrors(t, err, 1)
+
+	assert.IsType(t, &sema.UninitializedUseError{}, errs[0])
+}
+
+func TestCheckInvalidFieldInitializationWithFieldAccessInNestedFunctionBeforeAllFieldsInitialized(t *testing.T) {
+
+	t.Parallel()
+
+	_, err := ParseAndCheck(t, `
+      struct Test {
+          var foo: Int
+          var bar: Int
+
+          init() {
+              self.foo = 1
+              let f = fun (): Int {
+                  return self.foo
+              }
+              self.bar = f()
+          }
+      }
+    `)
+
+	errs := ExpectCheckerErrors(t, err, 1)
+
+	assert.IsType(t, &sema.UninitializedUseError{}, errs[0])
+}
+
+func TestCheckFieldInitializationWithFunctionCallInNestedFunctionAfterAllFieldsInitialized(t *testing.T) {
+
+	t.Parallel()
+
+	_, err := ParseAndCheck(t, `
+      struct Test {
+          var foo: Int
+
+          init() {
+              self.foo = 1
+              let f = fun () {
+                  self.bar()
+              }
+              f()
+          }
+
+          fun bar() {}
+      }
+    `)
+
+	require.NoError(t, err)
+}
+
 func TestCheckConstantFieldInitialization(t *testing.T) {
 
 	t.Parallel()
@@ -969,6 +969,91 @@ func TestCheckInvalidInterfaceConformanceFieldTypeMismatch(t *testing.T) {
 	}
 }
 
+func TestCheckInterfaceConformanceConstantFieldCovariance(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("struct", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          struct interface Test {
+              pub let x: AnyStruct
+          }
+
+          struct TestImpl: Test {
+              pub let x: Int
+
+              init() {
+                  self.x = 1
+              }
+          }
+        `)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("resource", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          resource interface Super {}
+
+          resource Sub: Super {}
+
+          resource interface Test {
+              pub let r: @AnyResource{Super}
+          }
+
+          resource TestImpl: Test {
+              pub let r: @Sub
+
+              init() {
+                  self.r <- create Sub()
+              }
+
+              destroy() {
+                  destroy self.r
+              }
+          }
+        `)
+
+		require.NoError(t, err)
+	})
+}
+
+func TestCheckInvalidInterfaceConformanceVariableFieldCovariance(t *testing.T) {
+
+	t.Parallel()
+
+	_, err := ParseAndCheck(t, `
+      struct interface Test {
+          pub var x: AnyStruct
+      }
+
+      struct TestImpl: Test {
+          pub var x: Int
+
+          init() {
+              self.x = 1
+          }
+      }
+    `)
+
+	errs := ExpectCheckerErrors(t, err, 1)
+
+	require.IsType(t, &sema.ConformanceError{}, errs[0])
+	conformanceErr := errs[0].(*sema.ConformanceError)
+
+	require.Len(t, conformanceErr.MemberMismatches, 1)
+	assert.Equal(t,
+		sema.MemberMismatchReasonFieldType,
+		conformanceErr.MemberMismatches[0].Reason,
+	)
+}
+
 func TestCheckInvalidInterfaceConformanceFieldPrivateAccessModifier(t *testing.T) {
 
 	t.Parallel()
@@ -1281,6 +1366,104 @@ func TestCheckInvalidInterfaceConformanceFunctionArgumentLabelMismatch(t *testin
 	}
 }
 
+func TestCheckInvalidInterfaceConformanceMemberMismatchReason(t *testing.T) {
+
+	t.Parallel()
+
+	type testCase struct {
+		name                string
+		interfaceMember     string
+		compositeMember     string
+		expectedReason      sema.MemberMismatchReason
+		expectedDescription string
+	}
+
+	testCases := []testCase{
+		{
+			name:                "declaration kind",
+			interfaceMember:     "pub let x: Int",
+			compositeMember:     "pub fun x() {}",
+			expectedReason:      sema.MemberMismatchReasonDeclarationKind,
+			expectedDescription: "expected `pub let x: Int` but got `pub fun x()` (declaration kind mismatch)",
+		},
+		{
+			name:                "field type",
+			interfaceMember:     "pub let x: Int",
+			compositeMember:     "pub let x: String\n init() { self.x = \"\" }",
+			expectedReason:      sema.MemberMismatchReasonFieldType,
+			expectedDescription: "expected `pub let x: Int` but got `pub let x: String` (type mismatch)",
+		},
+		{
+			name:                "argument labels",
+			interfaceMember:     "pub fun f(a: Int)",
+			compositeMember:     "pub fun f(b: Int) {}",
+			expectedReason:      sema.MemberMismatchReasonArgumentLabels,
+			expectedDescription: "expected `pub fun f(a: Int)` but got `pub fun f(b: Int)` (argument label mismatch)",
+		},
+		{
+			name:                "parameter types",
+			interfaceMember:     "pub fun f(a: Int)",
+			compositeMember:     "pub fun f(a: String) {}",
+			expectedReason:      sema.MemberMismatchReasonParameterTypes,
+			expectedDescription: "expected `pub fun f(a: Int)` but got `pub fun f(a: String)` (parameter type mismatch)",
+		},
+		{
+			name:                "return type",
+			interfaceMember:     "pub fun f(): Int",
+			compositeMember:     "pub fun f(): String { return \"\" }",
+			expectedReason:      sema.MemberMismatchReasonReturnType,
+			expectedDescription: "expected `pub fun f(): Int` but got `pub fun f(): String` (return type mismatch)",
+		},
+		{
+			name:                "variable kind",
+			interfaceMember:     "pub var x: Int",
+			compositeMember:     "pub let x: Int\n init() { self.x = 1 }",
+			expectedReason:      sema.MemberMismatchReasonVariableKind,
+			expectedDescription: "expected `pub var x: Int` but got `pub let x: Int` (variable kind mismatch)",
+		},
+		{
+			name:                "access",
+			interfaceMember:     "pub fun f()",
+			compositeMember:     "access(contract) fun f() {}",
+			expectedReason:      sema.MemberMismatchReasonAccess,
+			expectedDescription: "expected `pub fun f()` but got `access(contract) fun f()` (access mismatch)",
+		},
+	}
+
+	for _, testCase := range testCases {
+
+		t.Run(testCase.name, func(t *testing.T) {
+
+			_, err := ParseAndCheck(t,
+				fmt.Sprintf(
+					`
+                      struct interface Test {
+                          %s
+                      }
+
+                      struct TestImpl: Test {
+                          %s
+                      }
+                    `,
+					testCase.interfaceMember,
+					testCase.compositeMember,
+				),
+			)
+
+			errs := ExpectCheckerErrors(t, err, 1)
+
+			require.IsType(t, &sema.ConformanceError{}, errs[0])
+			conformanceErr := errs[0].(*sema.ConformanceError)
+
+			require.Len(t, conformanceErr.MemberMismatches, 1)
+			memberMismatch := conformanceErr.MemberMismatches[0]
+
+			assert.Equal(t, testCase.expectedReason, memberMismatch.Reason)
+			assert.Equal(t, testCase.expectedDescription, memberMismatch.Description())
+		})
+	}
+}
+
 func TestCheckInvalidInterfaceConformanceRepetition(t *testing.T) {
 
 	t.Parallel()
@@ -1533,6 +1716,81 @@ func TestCheckInvalidContractInterfaceConformanceMissingTypeRequirement(t *testi
 	assert.IsType(t, &sema.ConformanceError{}, errs[0])
 }
 
+func TestCheckInvalidContractInterfaceConformanceMissingTypeRequirementSuggestion(t *testing.T) {
+
+	t.Parallel()
+
+	_, err := ParseAndCheck(t,
+		`
+          contract interface Token {
+
+              resource interface Provider {}
+
+              resource interface Receiver {}
+
+              resource Vault: Provider, Receiver {}
+          }
+
+          contract TokenImpl: Token {
+              // missing 'Vault'
+          }
+        `,
+	)
+
+	errs := ExpectCheckerErrors(t, err, 1)
+
+	require.IsType(t, &sema.ConformanceError{}, errs[0])
+
+	conformanceErr := errs[0].(*sema.ConformanceError)
+
+	assert.Equal(t,
+		[]string{
+			"pub resource Vault: Token.Provider, Token.Receiver {}",
+		},
+		conformanceErr.MissingNestedCompositeTypeSuggestions(),
+	)
+
+	assert.Equal(t,
+		"missing nested type declarations, consider adding: "+
+			"`pub resource Vault: Token.Provider, Token.Receiver {}`",
+		conformanceErr.SecondaryError(),
+	)
+}
+
+func TestCheckInvalidContractInterfaceConformanceMissingEventTypeRequirementSuggestion(t *testing.T) {
+
+	t.Parallel()
+
+	_, err := ParseAndCheck(t,
+		`
+          contract interface Token {
+
+              event Deposit(amount: UFix64, to: Address?)
+
+              event Reset()
+          }
+
+          contract TokenImpl: Token {
+              // missing 'Deposit' and 'Reset'
+          }
+        `,
+	)
+
+	errs := ExpectCheckerErrors(t, err, 1)
+
+	require.IsType(t, &sema.ConformanceError{}, errs[0])
+
+	conformanceErr := errs[0].(*sema.ConformanceError)
+
+	assert.ElementsMatch(t,
+		[]string{
+			"pub event Deposit(amount: UFix64, to: Address?)",
+			"pub event Reset()",
+		},
+		conformanceErr.MissingNestedCompositeTypeSuggestions(),
+	)
+}
+
 func TestCheckInvalidContractInterfaceConformanceTypeRequirementKindMismatch(t *testing.T) {
 
 	t.Parallel()
@@ -1909,3 +2167,160 @@ func TestCheckInvalidInterfaceUseAsTypeSuggestion(t *testing.T) {
 		errs[0].(*sema.InvalidInterfaceTypeError).ExpectedType,
 	)
 }
+
+func TestCheckContractInterfaceUseAsType(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("not rewritten", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheckWithPanic(t, `
+          contract interface CI {}
+
+          let refs: [&CI] = panic("")
+          let dict: {String: &CI?} = panic("")
+          let fn: ((&CI): &CI) = panic("")
+        `)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("nested reference, subtype", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          contract interface CI {}
+
+          contract C: CI {}
+
+          fun f(_ ref: &CI): &CI {
+              return ref
+          }
+
+          let fn: ((&CI): &CI) = f
+          let ref = fn(&C as &C)
+          let refs: [&CI] = [&C as &C]
+          let dict: {String: &CI} = {"c": &C as &C}
+        `)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("nested reference, non-conforming", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          contract interface CI {}
+
+          contract C {}
+
+          fun f(_ ref: &CI) {}
+
+          let x = f(&C as &C)
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.TypeMismatchError{}, errs[0])
+	})
+
+	t.Run("restricted", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheckWithPanic(t, `
+          contract interface CI {}
+
+          let ref: &AnyStruct{CI} = panic("")
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.InvalidRestrictionTypeError{}, errs[0])
+	})
+}
+
+func TestCheckInvalidContractInterfaceConflictingTypeRequirements(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("conflicting kinds", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          contract interface CI1 {
+              struct Nested {}
+          }
+
+          contract interface CI2 {
+              resource Nested {}
+          }
+
+          contract C: CI1, CI2 {
+              struct Nested {}
+          }
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 2)
+
+		require.IsType(t, &sema.ConflictingTypeRequirementError{}, errs[0])
+
+		conflictError := errs[0].(*sema.ConflictingTypeRequirementError)
+		assert.Equal(t, "Nested", conflictError.Name)
+		assert.Equal(t, "CI1", conflictError.InterfaceType.Identifier)
+		assert.Equal(t, common.CompositeKindStructure, conflictError.TypeRequirement.Kind)
+		assert.Equal(t, "CI2", conflictError.OtherInterfaceType.Identifier)
+		assert.Equal(t, common.CompositeKindResource, conflictError.OtherTypeRequirement.Kind)
+
+		require.IsType(t, &sema.CompositeKindMismatchError{}, errs[1])
+	})
+
+	t.Run("conflicting kinds, missing nested type", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          contract interface CI1 {
+              struct Nested {}
+          }
+
+          contract interface CI2 {
+              resource Nested {}
+          }
+
+          contract C: CI1, CI2 {}
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 3)
+
+		require.IsType(t, &sema.ConflictingTypeRequirementError{}, errs[0])
+		require.IsType(t, &sema.ConformanceError{}, errs[1])
+		require.IsType(t, &sema.ConformanceError{}, errs[2])
+	})
+
+	t.Run("same kinds", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          contract interface CI1 {
+              struct Nested {}
+          }
+
+          contract interface CI2 {
+              struct Nested {}
+          }
+
+          contract C: CI1, CI2 {
+              struct Nested {}
+          }
+        `)
+
+		require.NoError(t, err)
+	})
+}
@@ -343,3 +343,214 @@ func TestCheckInvocationWithOnlyVarargs(t *testing.T) {
 
 	require.NoError(t, err)
 }
+
+func TestCheckInvocationWithOptionalTrailingArguments(t *testing.T) {
+
+	t.Parallel()
+
+	requiredArgumentCount := 1
+
+	functionType := &sema.FunctionType{
+		Parameters: []*sema.Parameter{
+			{
+				Identifier:     "a",
+				TypeAnnotation: sema.NewTypeAnnotation(&sema.IntType{}),
+			},
+			{
+				Identifier:     "b",
+				TypeAnnotation: sema.NewTypeAnnotation(&sema.StringType{}),
+			},
+		},
+		ReturnTypeAnnotation:  sema.NewTypeAnnotation(&sema.VoidType{}),
+		RequiredArgumentCount: &requiredArgumentCount,
+	}
+
+	t.Run("fewer arguments than parameters", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := parseAndCheckWithTestValue(t,
+			`
+              let res = test(a: 1)
+            `,
+			functionType,
+		)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("all arguments", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := parseAndCheckWithTestValue(t,
+			`
+              let res = test(a: 1, b: "2")
+            `,
+			functionType,
+		)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("fewer arguments than required", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := parseAndCheckWithTestValue(t,
+			`
+              let res = test()
+            `,
+			functionType,
+		)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.ArgumentCountError{}, errs[0])
+	})
+
+	t.Run("optional argument with wrong type", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := parseAndCheckWithTestValue(t,
+			`
+              let res = test(a: 1, b: 2)
+            `,
+			functionType,
+		)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.TypeMismatchError{}, errs[0])
+	})
+
+	t.Run("not required", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := parseAndCheckWithTestValue(t,
+			`
+              let res = test(a: 1)
+            `,
+			&sema.FunctionType{
+				Parameters:           functionType.Parameters,
+				ReturnTypeAnnotation: functionType.ReturnTypeAnnotation,
+			},
+		)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.ArgumentCountError{}, errs[0])
+	})
+}
+
+func TestCheckInvocationOfFunctionTypedField(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("transaction", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          transaction {
+
+              let handler: ((Int): Int)
+
+              prepare() {
+                  self.handler = fun (x: Int): Int {
+                      return x * 2
+                  }
+              }
+
+              execute {
+                  let result: Int = self.handler(5)
+              }
+          }
+        `)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("struct", func(t *testing.T) {
+
+		t.Parallel()
+
+		// NOTE: function types are not storable,
+		// but invoking the field is valid
+
+		_, err := ParseAndCheck(t, `
+          struct S {
+
+              let handler: ((Int): Int)
+
+              init(handler: ((Int): Int)) {
+                  self.handler = handler
+              }
+
+              fun run(): Int {
+                  return self.handler(5)
+              }
+          }
+
+          fun test(s: S): Int {
+              return s.handler(3)
+          }
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.FieldTypeNotStorableError{}, errs[0])
+	})
+}
+
+func TestCheckInvalidInvocationOfFunctionTypedField(t *testing.T) {
+
+	t.Parallel()
+
+	_, err := ParseAndCheck(t, `
+      transaction {
+
+          let handler: ((Int): Int)
+
+          prepare() {
+              self.handler = fun (x: Int): Int {
+                  return x * 2
+              }
+          }
+
+          execute {
+              let result = self.handler("5")
+          }
+      }
+    `)
+
+	errs := ExpectCheckerErrors(t, err, 1)
+
+	assert.IsType(t, &sema.TypeMismatchError{}, errs[0])
+}
+
+func TestCheckInvalidInvocationOfNonFunctionField(t *testing.T) {
+
+	t.Parallel()
+
+	_, err := ParseAndCheck(t, `
+      struct S {
+
+          let n: Int
+
+          init() {
+              self.n = 1
+          }
+
+          fun run(): Int {
+              return self.n(5)
+          }
+      }
+    `)
+
+	errs := ExpectCheckerErrors(t, err, 1)
+
+	assert.IsType(t, &sema.NotCallableError{}, errs[0])
+}
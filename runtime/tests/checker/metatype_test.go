@@ -148,3 +148,209 @@ func TestCheckIsInstance_Redeclaration(t *testing.T) {
 
 	assert.IsType(t, &sema.InvalidDeclarationError{}, errs[0])
 }
+
+func TestCheckGetType(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("AnyStruct", func(t *testing.T) {
+
+		t.Parallel()
+
+		checker, err := ParseAndCheck(t, `
+          let value: AnyStruct = 1
+          let result = value.getType()
+          let isInt = result == Type<Int>()
+        `)
+
+		require.NoError(t, err)
+
+		assert.Equal(t,
+			&sema.MetaType{},
+			checker.GlobalValues["result"].Type,
+		)
+	})
+
+	t.Run("AnyResource", func(t *testing.T) {
+
+		t.Parallel()
+
+		checker, err := ParseAndCheck(t, `
+          resource R {}
+
+          let r: @AnyResource <- create R()
+          let result = r.getType()
+        `)
+
+		require.NoError(t, err)
+
+		assert.Equal(t,
+			&sema.MetaType{},
+			checker.GlobalValues["result"].Type,
+		)
+	})
+
+	t.Run("concrete type", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          let value: Int = 1
+          let result = value.getType()
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.NotDeclaredMemberError{}, errs[0])
+	})
+}
+
+func TestCheckGetType_UserDefinedMember(t *testing.T) {
+
+	t.Parallel()
+
+	// A user-defined member named `getType` is allowed.
+	// It is used when the value is accessed through its own type,
+	// and the built-in function is used when it is accessed as a top type
+
+	checker, err := ParseAndCheck(t, `
+      struct S {
+          fun getType(): Int {
+              return 1
+          }
+      }
+
+      let s = S()
+      let x = s.getType()
+      let y = (s as AnyStruct).getType()
+    `)
+
+	require.NoError(t, err)
+
+	assert.Equal(t,
+		&sema.IntType{},
+		checker.GlobalValues["x"].Type,
+	)
+
+	assert.Equal(t,
+		&sema.MetaType{},
+		checker.GlobalValues["y"].Type,
+	)
+}
+
+func TestCheckDowncast(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("AnyStruct", func(t *testing.T) {
+
+		t.Parallel()
+
+		checker, err := ParseAndCheck(t, `
+          let x = 1
+          let result = (x as AnyStruct).downcast<Int>()
+        `)
+
+		require.NoError(t, err)
+
+		assert.Equal(t,
+			&sema.OptionalType{
+				Type: &sema.IntType{},
+			},
+			checker.GlobalValues["result"].Type,
+		)
+	})
+
+	t.Run("missing type argument", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          let value: AnyStruct = 1
+          let result = value.downcast()
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.TypeParameterTypeInferenceError{}, errs[0])
+	})
+
+	t.Run("resource type argument", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          resource R {}
+
+          let value: AnyStruct = 1
+          let result <- value.downcast<@R>()
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.TypeMismatchError{}, errs[0])
+	})
+
+	t.Run("AnyResource", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          resource R {}
+
+          let r: @AnyResource <- create R()
+          let result <- r.downcast<@R>()
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.NotDeclaredMemberError{}, errs[0])
+	})
+
+	t.Run("concrete type", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          let value: Int = 1
+          let result = value.downcast<Int>()
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.NotDeclaredMemberError{}, errs[0])
+	})
+}
+
+func TestCheckDowncast_UserDefinedMember(t *testing.T) {
+
+	t.Parallel()
+
+	// A user-defined member named `downcast` is allowed.
+	// It is used when the value is accessed through its own type,
+	// and the built-in function is used when it is accessed as `AnyStruct`
+
+	checker, err := ParseAndCheck(t, `
+      struct S {
+          fun downcast(): Int {
+              return 1
+          }
+      }
+
+      let s = S()
+      let x = s.downcast()
+      let y = (s as AnyStruct).downcast<S>()
+    `)
+
+	require.NoError(t, err)
+
+	assert.Equal(t,
+		&sema.IntType{},
+		checker.GlobalValues["x"].Type,
+	)
+
+	assert.IsType(t,
+		&sema.OptionalType{},
+		checker.GlobalValues["y"].Type,
+	)
+}
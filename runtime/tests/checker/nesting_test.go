@@ -270,3 +270,54 @@ func TestCheckInvalidNestedType(t *testing.T) {
 
 	assert.IsType(t, &sema.InvalidNestedTypeError{}, errs[0])
 }
+
+func TestCheckAllCompositeAndInterfaceTypes(t *testing.T) {
+
+	t.Parallel()
+
+	checker, err := ParseAndCheck(t, `
+      contract interface CI {
+          resource interface RI {}
+      }
+
+      contract C: CI {
+          resource interface RI {}
+
+          resource R: RI {}
+
+          struct S {}
+
+          enum E: UInt8 {
+              case a
+          }
+      }
+
+      struct S2 {}
+    `)
+
+	require.NoError(t, err)
+
+	compositeTypes := checker.AllCompositeTypes()
+
+	compositeTypeIdentifiers := make([]string, len(compositeTypes))
+	for i, compositeType := range compositeTypes {
+		compositeTypeIdentifiers[i] = compositeType.QualifiedIdentifier()
+	}
+
+	assert.Equal(t,
+		[]string{"C", "C.R", "C.S", "C.E", "S2"},
+		compositeTypeIdentifiers,
+	)
+
+	interfaceTypes := checker.AllInterfaceTypes()
+
+	interfaceTypeIdentifiers := make([]string, len(interfaceTypes))
+	for i, interfaceType := range interfaceTypes {
+		interfaceTypeIdentifiers[i] = interfaceType.QualifiedIdentifier()
+	}
+
+	assert.Equal(t,
+		[]string{"CI", "CI.RI", "C.RI"},
+		interfaceTypeIdentifiers,
+	)
+}
@@ -21,7 +21,10 @@ package checker
 import (
 	"testing"
 
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/cadence/runtime/sema"
 )
 
 func TestCheckNever(t *testing.T) {
@@ -38,3 +41,90 @@ func TestCheckNever(t *testing.T) {
 
 	require.NoError(t, err)
 }
+
+func TestCheckNeverNilCoalescing(t *testing.T) {
+
+	t.Parallel()
+
+	checker, err := ParseAndCheckWithPanic(t,
+		`
+            let opt: Int? = nil
+            let x = opt ?? panic("XXX")
+        `,
+	)
+
+	require.NoError(t, err)
+
+	assert.Equal(t,
+		&sema.IntType{},
+		checker.GlobalValues["x"].Type,
+	)
+}
+
+func TestCheckNeverBranchResourceInvalidation(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("halting branch", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheckWithPanic(t,
+			`
+              resource R {}
+
+              fun test(r: @R, b: Bool): @R {
+                  if b {
+                      destroy r
+                      panic("XXX")
+                  }
+                  return <-r
+              }
+            `,
+		)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("returning and halting branches", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheckWithPanic(t,
+			`
+              resource R {}
+
+              fun test(r: @R, b: Bool): @R {
+                  if b {
+                      return <-r
+                  } else {
+                      panic("XXX")
+                  }
+              }
+            `,
+		)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("invalidating and halting branches", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheckWithPanic(t,
+			`
+              resource R {}
+
+              fun test(r: @R, b: Bool) {
+                  if b {
+                      destroy r
+                  } else {
+                      panic("XXX")
+                  }
+              }
+            `,
+		)
+
+		require.NoError(t, err)
+	})
+}
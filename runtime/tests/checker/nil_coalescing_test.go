@@ -224,3 +224,39 @@ func TestCheckNilCoalescingWithNever(t *testing.T) {
 
 	require.NoError(t, err)
 }
+
+func TestCheckInvalidNilCoalescingNonOptionalHint(t *testing.T) {
+
+	t.Parallel()
+
+	checker, err := ParseAndCheck(t, `
+      let x = 5 ?? 0
+    `)
+
+	errs := ExpectCheckerErrors(t, err, 1)
+
+	assert.IsType(t, &sema.InvalidBinaryOperandError{}, errs[0])
+
+	hints := checker.Hints()
+	require.Len(t, hints, 1)
+	require.IsType(t, &sema.ReplacementHint{}, hints[0])
+
+	assert.Equal(t,
+		"consider replacing with: `5`",
+		hints[0].(*sema.ReplacementHint).Hint(),
+	)
+}
+
+func TestCheckNilCoalescingOptionalNoHint(t *testing.T) {
+
+	t.Parallel()
+
+	checker, err := ParseAndCheck(t, `
+      let optInt: Int? = 5
+      let x = optInt ?? 0
+    `)
+
+	require.NoError(t, err)
+
+	assert.Empty(t, checker.Hints())
+}
@@ -341,3 +341,71 @@ func TestCheckInvalidCompositeEquality(t *testing.T) {
 		})
 	}
 }
+
+func TestCheckAddressComparison(t *testing.T) {
+
+	t.Parallel()
+
+	for _, operation := range []ast.Operation{
+		ast.OperationLess,
+		ast.OperationLessEqual,
+		ast.OperationGreater,
+		ast.OperationGreaterEqual,
+	} {
+
+		t.Run(operation.String(), func(t *testing.T) {
+
+			checker, err := ParseAndCheck(t,
+				fmt.Sprintf(
+					`
+                      let addr1: Address = 0x1
+                      let addr2: Address = 0x2
+                      let result = addr1 %s addr2
+                    `,
+					operation.Symbol(),
+				),
+			)
+
+			require.NoError(t, err)
+
+			assert.Equal(t,
+				&sema.BoolType{},
+				checker.GlobalValues["result"].Type,
+			)
+		})
+	}
+}
+
+func TestCheckInvalidAddressComparison(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("number", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          let addr: Address = 0x1
+          let result = addr < 2
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.InvalidBinaryOperandsError{}, errs[0])
+	})
+
+	t.Run("arithmetic", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          let addr1: Address = 0x1
+          let addr2: Address = 0x2
+          let result = addr1 + addr2
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.InvalidBinaryOperandsError{}, errs[0])
+	})
+}
@@ -184,6 +184,20 @@ func TestCheckNestedOptionalNilComparisonSwapped(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestCheckNestedOptionalNestedNilComparison(t *testing.T) {
+
+	t.Parallel()
+
+	_, err := ParseAndCheck(t, `
+     let x: Int?? = 1
+     let n: Never?? = nil
+     let y = x == n
+     let z = n == x
+   `)
+
+	require.NoError(t, err)
+}
+
 func TestCheckNestedOptionalComparison(t *testing.T) {
 
 	t.Parallel()
@@ -411,3 +425,154 @@ func TestCheckOptionalMap(t *testing.T) {
 		assert.IsType(t, &sema.TypeMismatchError{}, errs[0])
 	})
 }
+
+func TestCheckOptionalMapRef(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("resource", func(t *testing.T) {
+
+		_, err := ParseAndCheckWithPanic(t, `
+          resource R {
+              let n: Int
+
+              init(n: Int) {
+                  self.n = n
+              }
+          }
+
+          fun getN(_ ref: &R): Int {
+              return ref.n
+          }
+
+          fun test(r: @R?): Int? {
+              let n = r.mapRef(getN)
+              destroy r
+              return n
+          }
+        `)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("resource, map", func(t *testing.T) {
+
+		_, err := ParseAndCheckWithPanic(t, `
+          resource R {
+              let n: Int
+
+              init(n: Int) {
+                  self.n = n
+              }
+          }
+
+          fun getN(_ r: @R): Int {
+              let n = r.n
+              destroy r
+              return n
+          }
+
+          fun test(r: @R?): Int? {
+              let n = r.map(getN)
+              destroy r
+              return n
+          }
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.InvalidResourceOptionalMemberError{}, errs[0])
+	})
+
+	t.Run("struct", func(t *testing.T) {
+
+		_, err := ParseAndCheckWithPanic(t, `
+          struct S {
+              let n: Int
+
+              init(n: Int) {
+                  self.n = n
+              }
+          }
+
+          fun test(): Int? {
+              let s: S? = S(n: 1)
+              return s.mapRef(fun (_ ref: &S): Int {
+                  return ref.n
+              })
+          }
+        `)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("invalid element parameter type", func(t *testing.T) {
+
+		_, err := ParseAndCheckWithPanic(t, `
+          resource R {}
+
+          fun getOne(_ r: @R): Int {
+              destroy r
+              return 1
+          }
+
+          fun test(r: @R?): Int? {
+              let n = r.mapRef(getOne)
+              destroy r
+              return n
+          }
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.TypeMismatchError{}, errs[0])
+	})
+}
+
+func TestCheckOptionalOrElse(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("valid", func(t *testing.T) {
+
+		checker, err := ParseAndCheckWithPanic(t, `
+          let x = (nil as Int?).orElse(5)
+        `)
+
+		require.NoError(t, err)
+
+		assert.Equal(t,
+			&sema.IntType{},
+			checker.GlobalValues["x"].Type,
+		)
+	})
+
+	t.Run("invalid default type", func(t *testing.T) {
+
+		_, err := ParseAndCheckWithPanic(t, `
+          let x: Int? = 1
+          let y = x.orElse("2")
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.TypeMismatchError{}, errs[0])
+	})
+
+	t.Run("resource", func(t *testing.T) {
+
+		_, err := ParseAndCheckWithPanic(t, `
+          resource R {}
+
+          fun test(r: @R?): @R {
+              let r2 <- r.orElse(<-create R())
+              destroy r
+              return <-r2
+          }
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.InvalidResourceOptionalMemberError{}, errs[0])
+	})
+}
@@ -23,12 +23,13 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	"github.com/onflow/cadence/runtime/common"
 	"github.com/onflow/cadence/runtime/sema"
 )
 
-func TestCheckInvalidCompositeInitializerOverloading(t *testing.T) {
+func TestCheckCompositeInitializerOverloading(t *testing.T) {
 
 	t.Parallel()
 
@@ -37,6 +38,12 @@ func TestCheckInvalidCompositeInitializerOverloading(t *testing.T) {
 	for _, kind := range common.CompositeKindsWithBody {
 		for _, isInterface := range interfacePossibilities {
 
+			// Only the initializers of structures and resources can be overloaded
+
+			supported := !isInterface &&
+				(kind == common.CompositeKindStructure ||
+					kind == common.CompositeKindResource)
+
 			interfaceKeyword := ""
 			body := ""
 			if isInterface {
@@ -66,14 +73,263 @@ func TestCheckInvalidCompositeInitializerOverloading(t *testing.T) {
 					),
 				)
 
-				errs := ExpectCheckerErrors(t, err, 1)
+				if supported {
+					require.NoError(t, err)
+				} else {
+					errs := ExpectCheckerErrors(t, err, 1)
 
-				assert.IsType(t, &sema.UnsupportedOverloadingError{}, errs[0])
+					assert.IsType(t, &sema.UnsupportedOverloadingError{}, errs[0])
+				}
 			})
 		}
 	}
 }
 
+func TestCheckCompositeInitializerOverloadingInvocation(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("distinct argument labels", func(t *testing.T) {
+
+		t.Parallel()
+
+		checker, err := ParseAndCheck(t, `
+          struct X {
+              let value: Int
+
+              init(value: Int) {
+                  self.value = value
+              }
+
+              init(double value: Int) {
+                  self.value = value * 2
+              }
+
+              init() {
+                  self.value = 0
+              }
+          }
+
+          let x1 = X(value: 1)
+          let x2 = X(double: 2)
+          let x3 = X()
+        `)
+
+		require.NoError(t, err)
+
+		xType := checker.GlobalTypes["X"].Type
+
+		for _, name := range []string{"x1", "x2", "x3"} {
+			assert.Same(t, xType, checker.GlobalValues[name].Type)
+		}
+	})
+
+	t.Run("unlabeled arguments", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          resource R {
+              let value: String
+
+              init(_ value: Int) {
+                  self.value = value.toString()
+              }
+
+              init(_ first: String, _ second: String) {
+                  self.value = first.concat(second)
+              }
+          }
+
+          fun test() {
+              let r1 <- create R(1)
+              let r2 <- create R("a", "b")
+              destroy r1
+              destroy r2
+          }
+        `)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("overload selected by labels is type checked", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          struct X {
+              init(int: Int) {}
+              init(string: String) {}
+          }
+
+          let x = X(string: 1)
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.TypeMismatchError{}, errs[0])
+	})
+
+	t.Run("no matching overload", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          struct X {
+              init(int: Int) {}
+              init(string: String, count: Int) {}
+          }
+
+          let x = X(string: "a", number: 1)
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.IncorrectArgumentLabelError{}, errs[0])
+	})
+
+	t.Run("each initializer must initialize all fields", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          struct X {
+              let value: Int
+
+              init(value: Int) {
+                  self.value = value
+              }
+
+              init() {}
+          }
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.FieldUninitializedError{}, errs[0])
+	})
+}
+
+func TestCheckInvalidCompositeInitializerOverloadingAmbiguous(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("same argument labels", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          struct X {
+              init(value: Int) {}
+              init(value: String) {}
+          }
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.AmbiguousOverloadingError{}, errs[0])
+	})
+
+	t.Run("same explicit and implicit argument labels", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          resource R {
+              init(value: Int) {}
+              init(value number: Int) {}
+          }
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.AmbiguousOverloadingError{}, errs[0])
+	})
+
+	t.Run("no parameters", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          struct X {
+              init() {}
+              init() {}
+          }
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.AmbiguousOverloadingError{}, errs[0])
+	})
+}
+
+func TestCheckInvalidCompositeInitializerOverloadingConformance(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("interface initializer requirement", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          resource interface I {
+              init(x: Int) {
+                  pre { x > 0 }
+              }
+          }
+
+          resource R: I {
+              init(x: Int) {}
+              init(y: Int) {}
+          }
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.OverloadedInitializerConformanceError{}, errs[0])
+	})
+
+	t.Run("type requirement initializer", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          contract interface CI {
+              struct S {
+                  init(x: Int)
+              }
+          }
+
+          contract C: CI {
+              struct S {
+                  init(x: Int) {}
+                  init(y: Int) {}
+              }
+          }
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.OverloadedInitializerConformanceError{}, errs[0])
+	})
+
+	t.Run("interface without initializer requirement", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          struct interface I {}
+
+          struct S: I {
+              init(x: Int) {}
+              init(y: Int) {}
+          }
+        `)
+
+		require.NoError(t, err)
+	})
+}
+
 func TestCheckInvalidResourceDestructorOverloading(t *testing.T) {
 
 	t.Parallel()
@@ -107,7 +363,24 @@ func TestCheckInvalidResourceDestructorOverloading(t *testing.T) {
 
 			errs := ExpectCheckerErrors(t, err, 1)
 
-			assert.IsType(t, &sema.UnsupportedOverloadingError{}, errs[0])
+			require.IsType(t, &sema.MultipleDestructorsError{}, errs[0])
+
+			multipleDestructorsError := errs[0].(*sema.MultipleDestructorsError)
+
+			// NOTE: offsets depend on the interface keyword,
+			// so only compare lines and columns
+
+			previousPos := multipleDestructorsError.PreviousPos
+			assert.Equal(t, 3, previousPos.Line)
+			assert.Equal(t, 26, previousPos.Column)
+
+			startPos := multipleDestructorsError.StartPos
+			assert.Equal(t, 4, startPos.Line)
+			assert.Equal(t, 26, startPos.Column)
+
+			endPos := multipleDestructorsError.EndPos
+			assert.Equal(t, 4, endPos.Line)
+			assert.Equal(t, 32, endPos.Column)
 		})
 	}
 }
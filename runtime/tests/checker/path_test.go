@@ -67,3 +67,55 @@ func TestCheckPath(t *testing.T) {
 		assert.IsType(t, &sema.InvalidPathDomainError{}, errs[0])
 	})
 }
+
+func TestCheckPathConstructor(t *testing.T) {
+
+	t.Parallel()
+
+	for domain, functionName := range sema.PathConstructorFunctionNames {
+
+		t.Run(domain.Name(), func(t *testing.T) {
+
+			checker, err := ParseAndCheck(t,
+				fmt.Sprintf(
+					`
+                      let identifier = "foo"
+                      let x = %s(identifier: identifier)
+                    `,
+					functionName,
+				),
+			)
+
+			require.NoError(t, err)
+
+			assert.Equal(t,
+				&sema.OptionalType{
+					Type: &sema.PathType{},
+				},
+				checker.GlobalValues["x"].Type,
+			)
+		})
+	}
+
+	t.Run("invalid: literal identifier", func(t *testing.T) {
+
+		_, err := ParseAndCheck(t, `
+          let x = PublicPath(identifier: "foo/bar")
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.InvalidPathIdentifierError{}, errs[0])
+	})
+
+	t.Run("invalid: missing argument label", func(t *testing.T) {
+
+		_, err := ParseAndCheck(t, `
+          let x = StoragePath("foo")
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.MissingArgumentLabelError{}, errs[0])
+	})
+}
@@ -1045,3 +1045,161 @@ func TestCheckInvalidReferenceExpressionNonReferenceAnyStruct(t *testing.T) {
 	assert.IsType(t, &sema.NotDeclaredError{}, errs[0])
 	assert.IsType(t, &sema.NonReferenceTypeReferenceError{}, errs[1])
 }
+
+func TestCheckInvalidReferenceField(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("reference", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          struct S {
+              let ref: &Int
+
+              init(ref: &Int) {
+                  self.ref = ref
+              }
+          }
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		require.IsType(t, &sema.ReferenceFieldError{}, errs[0])
+
+		referenceFieldError := errs[0].(*sema.ReferenceFieldError)
+		assert.Equal(t, "ref", referenceFieldError.Name)
+		assert.Equal(t,
+			&sema.ReferenceType{Type: &sema.IntType{}},
+			referenceFieldError.Type,
+		)
+	})
+
+	t.Run("optional reference", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          struct S {
+              let ref: &Int?
+
+              init() {
+                  self.ref = nil
+              }
+          }
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.ReferenceFieldError{}, errs[0])
+	})
+
+	t.Run("nested reference", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          struct S {
+              let refs: [&Int]
+
+              init() {
+                  self.refs = []
+              }
+          }
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.FieldTypeNotStorableError{}, errs[0])
+	})
+}
+
+func TestCheckInvalidContainerReferenceCovariance(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("array", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          fun test() {
+              let ints: [Int] = [1]
+              let ref = &ints as &[AnyStruct]
+              ref.append("x")
+          }
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.TypeMismatchError{}, errs[0])
+	})
+
+	t.Run("constant-sized array", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          fun test() {
+              let ints: [Int; 1] = [1]
+              let ref = &ints as &[AnyStruct; 1]
+              ref[0] = "x"
+          }
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.TypeMismatchError{}, errs[0])
+	})
+
+	t.Run("dictionary", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          fun test() {
+              let ints: {String: Int} = {"a": 1}
+              let ref = &ints as &{String: AnyStruct}
+              ref["b"] = "x"
+          }
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.TypeMismatchError{}, errs[0])
+	})
+
+	t.Run("authorized reference upcast", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          fun test() {
+              let ints: [Int] = [1]
+              let intsRef = &ints as auth &[Int]
+              let ref: &[AnyStruct] = intsRef
+          }
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.TypeMismatchError{}, errs[0])
+	})
+
+	t.Run("same element type", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          fun test() {
+              let ints: [Int] = [1]
+              let ref = &ints as auth &[Int]
+              ref.append(2)
+              let anyRef: &AnyStruct = ref
+          }
+        `)
+
+		require.NoError(t, err)
+	})
+}
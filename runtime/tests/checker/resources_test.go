@@ -3634,6 +3634,95 @@ func TestCheckInvalidResourceMethodBinding(t *testing.T) {
 	assert.IsType(t, &sema.ResourceMethodBindingError{}, errs[0])
 }
 
+func TestCheckResourceReferenceMethodBinding(t *testing.T) {
+
+	t.Parallel()
+
+	// Binding a function of a reference to a resource is valid,
+	// only binding a function of a resource is invalid
+
+	t.Run("resource", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          resource R {
+              fun foo() {}
+          }
+
+          fun test(): ((): Void) {
+              let r <- create R()
+              let ref = &r as &R
+              let foo = ref.foo
+              destroy r
+              return foo
+          }
+        `)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("optional resource", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          resource R {
+              fun foo() {}
+          }
+
+          fun test(): ((): Void)? {
+              let r <- create R()
+              let ref: &R? = &r as &R
+              let foo = ref?.foo
+              destroy r
+              return foo
+          }
+        `)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("struct", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          struct S {
+              fun foo() {}
+          }
+
+          fun test(): ((): Void) {
+              let s = S()
+              let ref = &s as &S
+              return ref.foo
+          }
+        `)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("resource, invocation", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          resource R {
+              fun foo() {}
+          }
+
+          fun test() {
+              let r <- create R()
+              let ref = &r as &R
+              ref.foo()
+              destroy r
+          }
+        `)
+
+		require.NoError(t, err)
+	})
+}
+
 func TestCheckInvalidResourceMethodCall(t *testing.T) {
 
 	t.Parallel()
@@ -19,6 +19,7 @@
 package checker
 
 import (
+	"fmt"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -485,6 +486,126 @@ func TestCheckRestrictedTypeMemberAccess(t *testing.T) {
 		assert.IsType(t, &sema.ConformanceError{}, errs[0])
 		assert.IsType(t, &sema.RestrictionMemberClashError{}, errs[1])
 	})
+
+	t.Run("restrictions with clashing members: AnyStruct", func(t *testing.T) {
+
+		_, err := ParseAndCheck(t, `
+
+            struct interface A {
+                let x: Int
+            }
+
+            struct interface B {
+                let x: String
+            }
+
+            fun test(s: AnyStruct{A, B}) {}
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		require.IsType(t, &sema.RestrictionMemberClashError{}, errs[0])
+		clashErr := errs[0].(*sema.RestrictionMemberClashError)
+
+		assert.Equal(t, "x", clashErr.Name)
+		assert.Equal(t, "A", clashErr.OriginalDeclaringType.Identifier)
+		assert.Equal(t, "B", clashErr.RedeclaringType.Identifier)
+	})
+
+	for _, restrictions := range []string{"I1, I2", "I2, I1"} {
+
+		t.Run(fmt.Sprintf("restrictions with differing member access: %s", restrictions), func(t *testing.T) {
+
+			_, err := ParseAndCheck(t,
+				fmt.Sprintf(
+					`
+                      struct interface I1 {
+                          pub(set) var n: Int
+                      }
+
+                      struct interface I2 {
+                          pub var n: Int
+                      }
+
+                      struct S: I1, I2 {
+                          pub(set) var n: Int
+
+                          init(n: Int) {
+                              self.n = n
+                          }
+                      }
+
+                      fun test() {
+                          let s: S{%s} = S(n: 1)
+                          s.n = 2
+                      }
+                    `,
+					restrictions,
+				),
+			)
+
+			require.NoError(t, err)
+		})
+	}
+
+	t.Run("restrictions with differing member access, insufficient", func(t *testing.T) {
+
+		_, err := ParseAndCheck(t, `
+
+            struct interface I1 {
+                pub var n: Int
+            }
+
+            struct interface I2 {
+                pub var n: Int
+            }
+
+            struct S: I1, I2 {
+                pub(set) var n: Int
+
+                init(n: Int) {
+                    self.n = n
+                }
+            }
+
+            fun test() {
+                let s: S{I1, I2} = S(n: 1)
+                s.n = 2
+            }
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.InvalidAssignmentAccessError{}, errs[0])
+	})
+
+	t.Run("restrictions with conflicting member declarations", func(t *testing.T) {
+
+		_, err := ParseAndCheck(t, `
+
+            struct interface I1 {
+                pub fun f()
+            }
+
+            struct interface I2 {
+                pub let f: ((): Void)
+            }
+
+            fun test(s: S{I1, I2}) {
+                s.f()
+            }
+
+            struct S: I1 {
+                pub fun f() {}
+            }
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 3)
+
+		assert.IsType(t, &sema.FieldTypeNotStorableError{}, errs[0])
+		assert.IsType(t, &sema.InvalidNonConformanceRestrictionError{}, errs[1])
+		assert.IsType(t, &sema.RestrictionMemberKindConflictError{}, errs[2])
+	})
 }
 
 func TestCheckRestrictedTypeSubtyping(t *testing.T) {
@@ -1101,3 +1222,176 @@ func TestCheckRestrictedTypeConformanceOrder(t *testing.T) {
 	})
 
 }
+
+func TestCheckRestrictedTypeMissingRestrictions(t *testing.T) {
+
+	t.Parallel()
+
+	missingRestrictionIdentifiers := func(t *testing.T, err error) []string {
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		require.IsType(t, &sema.TypeMismatchError{}, errs[0])
+		typeMismatchError := errs[0].(*sema.TypeMismatchError)
+
+		var identifiers []string
+		for _, restriction := range typeMismatchError.MissingRestrictions {
+			identifiers = append(identifiers, restriction.Identifier)
+		}
+		return identifiers
+	}
+
+	t.Run("composite to restricted, invocation", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          struct interface I1 {}
+
+          struct interface I2 {}
+
+          struct interface I3 {}
+
+          struct S: I2 {}
+
+          fun test(_ s: AnyStruct{I1, I2, I3}) {}
+
+          let x = test(S())
+        `)
+
+		assert.Equal(t,
+			[]string{"I1", "I3"},
+			missingRestrictionIdentifiers(t, err),
+		)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.Equal(t,
+			"expected `AnyStruct{I1, I2, I3}`, got `S`, missing restrictions: `I1`, `I3`",
+			errs[0].(*sema.TypeMismatchError).SecondaryError(),
+		)
+	})
+
+	t.Run("composite to restricted, static cast", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          struct interface I1 {}
+
+          struct interface I2 {}
+
+          struct S: I1 {}
+
+          let s = S() as AnyStruct{I1, I2}
+        `)
+
+		assert.Equal(t,
+			[]string{"I2"},
+			missingRestrictionIdentifiers(t, err),
+		)
+	})
+
+	t.Run("restricted to restricted", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          resource interface I1 {}
+
+          resource interface I2 {}
+
+          fun test(_ r: @AnyResource{I1, I2}) {
+              destroy r
+          }
+
+          fun foo(_ r: @AnyResource{I2}) {
+              test(<-r)
+          }
+        `)
+
+		assert.Equal(t,
+			[]string{"I1"},
+			missingRestrictionIdentifiers(t, err),
+		)
+	})
+
+	t.Run("restricted composite to restricted", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          resource interface I1 {}
+
+          resource interface I2 {}
+
+          resource R: I1 {}
+
+          fun test(_ r: @AnyResource{I1, I2}) {
+              destroy r
+          }
+
+          fun foo(_ r: @R{I1}) {
+              test(<-r)
+          }
+        `)
+
+		assert.Equal(t,
+			[]string{"I2"},
+			missingRestrictionIdentifiers(t, err),
+		)
+	})
+
+	t.Run("reference", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          struct interface I {}
+
+          struct S {}
+
+          fun test(_ ref: &AnyStruct{I}) {}
+
+          let s = S()
+
+          let x = test(&s as &S)
+        `)
+
+		assert.Equal(t,
+			[]string{"I"},
+			missingRestrictionIdentifiers(t, err),
+		)
+	})
+
+	t.Run("unrelated kind", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          resource interface I {}
+
+          struct S {}
+
+          fun test(_ r: @AnyResource{I}) {
+              destroy r
+          }
+
+          let x = test(S())
+        `)
+
+		assert.Empty(t, missingRestrictionIdentifiers(t, err))
+	})
+
+	t.Run("not restricted", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          fun test(_ x: Int) {}
+
+          let x = test("1")
+        `)
+
+		assert.Empty(t, missingRestrictionIdentifiers(t, err))
+	})
+}
@@ -458,6 +458,37 @@ func TestCheckNeverInvocationExits(t *testing.T) {
 			{
 				body: `
                   false || panic("")
+                `,
+				exits:             false,
+				valueDeclarations: valueDeclarations,
+			},
+			{
+				body: `
+                  if true {
+                      return 1
+                  } else {
+                      panic("")
+                  }
+                `,
+				exits:             true,
+				valueDeclarations: valueDeclarations,
+			},
+			{
+				body: `
+                  if true {
+                      panic("")
+                  } else {
+                      return 1
+                  }
+                `,
+				exits:             true,
+				valueDeclarations: valueDeclarations,
+			},
+			{
+				body: `
+                  if true {
+                      panic("")
+                  }
                 `,
 				exits:             false,
 				valueDeclarations: valueDeclarations,
@@ -157,12 +157,25 @@ func TestCheckStorable(t *testing.T) {
 	}
 
 	for _, nonStorableType := range nonStorableTypes {
+
+		var expectedError error = &sema.FieldTypeNotStorableError{}
+
+		// Reference fields are reported with a dedicated error
+
+		fieldType := nonStorableType
+		if optionalType, ok := fieldType.(*sema.OptionalType); ok {
+			fieldType = optionalType.Type
+		}
+		if _, ok := fieldType.(*sema.ReferenceType); ok {
+			expectedError = &sema.ReferenceFieldError{}
+		}
+
 		testCases = append(testCases,
 			testCase{
 				Type: nonStorableType,
 				ErrorTypes: func(_ common.CompositeKind, _ bool) []error {
 					return []error{
-						&sema.FieldTypeNotStorableError{},
+						expectedError,
 					}
 				},
 			},
@@ -356,3 +369,273 @@ func TestCheckStorableCycle(t *testing.T) {
 		assert.IsType(t, &sema.MissingInitializerError{}, errs[4])
 	})
 }
+
+func TestCheckFieldTypeNotStorableErrorNonStorableType(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("reference", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          struct S {
+              let refs: {String: [&Int]}
+
+              init() {
+                  self.refs = {}
+              }
+          }
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		require.IsType(t, &sema.FieldTypeNotStorableError{}, errs[0])
+
+		notStorableError := errs[0].(*sema.FieldTypeNotStorableError)
+
+		assert.Equal(t,
+			&sema.ReferenceType{Type: &sema.IntType{}},
+			notStorableError.NonStorableType,
+		)
+
+		assert.Equal(t,
+			"contains reference type `&Int`",
+			notStorableError.SecondaryError(),
+		)
+	})
+
+	t.Run("function", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          struct S {
+              let f: ((): Int)
+
+              init() {
+                  self.f = fun (): Int { return 1 }
+              }
+          }
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		require.IsType(t, &sema.FieldTypeNotStorableError{}, errs[0])
+
+		notStorableError := errs[0].(*sema.FieldTypeNotStorableError)
+
+		assert.Equal(t,
+			&sema.FunctionType{
+				ReturnTypeAnnotation: sema.NewTypeAnnotation(&sema.IntType{}),
+			},
+			notStorableError.NonStorableType,
+		)
+
+		assert.Equal(t,
+			"`((): Int)` is a function type",
+			notStorableError.SecondaryError(),
+		)
+	})
+
+	t.Run("nested", func(t *testing.T) {
+
+		t.Parallel()
+
+		checker, err := ParseAndCheck(t, `
+          struct Inner {
+              let f: ((): Int)
+
+              init() {
+                  self.f = fun (): Int { return 1 }
+              }
+          }
+
+          struct Outer {
+              let inners: {String: Inner}
+
+              init() {
+                  self.inners = {}
+              }
+          }
+
+          struct S {
+              let outers: [Outer]
+
+              init() {
+                  self.outers = []
+              }
+          }
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 3)
+
+		require.IsType(t, &sema.FieldTypeNotStorableError{}, errs[2])
+
+		notStorableError := errs[2].(*sema.FieldTypeNotStorableError)
+		require.Equal(t, "outers", notStorableError.Name)
+
+		innerType := checker.GlobalTypes["Inner"].Type
+		outerType := checker.GlobalTypes["Outer"].Type
+
+		functionType := &sema.FunctionType{
+			ReturnTypeAnnotation: sema.NewTypeAnnotation(&sema.IntType{}),
+		}
+
+		assert.Equal(t,
+			[]sema.Type{
+				&sema.VariableSizedType{Type: outerType},
+				outerType,
+				&sema.DictionaryType{
+					KeyType:   &sema.StringType{},
+					ValueType: innerType,
+				},
+				innerType,
+				functionType,
+			},
+			notStorableError.NonStorablePath,
+		)
+
+		assert.Equal(t,
+			functionType,
+			notStorableError.NonStorableType,
+		)
+
+		assert.Equal(t,
+			"contains function type `((): Int)` (through `Outer`, `Inner`)",
+			notStorableError.SecondaryError(),
+		)
+	})
+
+	t.Run("AnyStruct", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheck(t, `
+          struct S {
+              let value: AnyStruct
+
+              init() {
+                  self.value = 1
+              }
+          }
+        `)
+
+		require.NoError(t, err)
+	})
+}
+
+func TestCheckNonStorablePath(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("storable", func(t *testing.T) {
+
+		t.Parallel()
+
+		assert.Nil(t,
+			sema.NonStorablePath(
+				&sema.VariableSizedType{
+					Type: &sema.IntType{},
+				},
+			),
+		)
+	})
+
+	t.Run("cyclic", func(t *testing.T) {
+
+		t.Parallel()
+
+		checker, err := ParseAndCheck(t, `
+          struct S {
+              let next: S?
+              let ref: &Int
+
+              init(ref: &Int) {
+                  self.next = nil
+                  self.ref = ref
+              }
+          }
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 1)
+
+		assert.IsType(t, &sema.ReferenceFieldError{}, errs[0])
+
+		structType := checker.GlobalTypes["S"].Type
+
+		assert.Equal(t,
+			[]sema.Type{
+				structType,
+				&sema.ReferenceType{Type: &sema.IntType{}},
+			},
+			sema.NonStorablePath(structType),
+		)
+	})
+
+	t.Run("shared type", func(t *testing.T) {
+
+		t.Parallel()
+
+		checker, err := ParseAndCheck(t, `
+          struct T {
+              let s: S?
+              let f: ((): Int)
+
+              init() {
+                  self.s = nil
+                  self.f = fun (): Int { return 1 }
+              }
+          }
+
+          struct S {
+              let t1: T?
+              let t2: [T]
+
+              init() {
+                  self.t1 = nil
+                  self.t2 = []
+              }
+          }
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 3)
+
+		for _, err := range errs {
+			assert.IsType(t, &sema.FieldTypeNotStorableError{}, err)
+		}
+
+		structType := checker.GlobalTypes["S"].Type
+		sharedType := checker.GlobalTypes["T"].Type
+		functionType := &sema.FunctionType{
+			ReturnTypeAnnotation: sema.NewTypeAnnotation(&sema.IntType{}),
+		}
+
+		assert.Equal(t,
+			[]sema.Type{
+				structType,
+				&sema.OptionalType{Type: sharedType},
+				sharedType,
+				functionType,
+			},
+			sema.NonStorablePath(structType),
+		)
+
+		assert.Equal(t,
+			[]sema.Type{
+				&sema.VariableSizedType{Type: sharedType},
+				sharedType,
+				functionType,
+			},
+			sema.NonStorablePath(&sema.VariableSizedType{Type: sharedType}),
+		)
+
+		assert.Equal(t,
+			[]sema.Type{
+				sharedType,
+				functionType,
+			},
+			sema.NonStorablePath(sharedType),
+		)
+	})
+}
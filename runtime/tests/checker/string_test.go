@@ -125,6 +125,116 @@ func TestCheckStringConcatBound(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestCheckStringCompare(t *testing.T) {
+
+	t.Parallel()
+
+	checker, err := ParseAndCheck(t, `
+      let a = "abc"
+      let result = a.compare("def")
+    `)
+
+	require.NoError(t, err)
+
+	assert.IsType(t,
+		&sema.IntType{},
+		checker.GlobalValues["result"].Type,
+	)
+}
+
+func TestCheckInvalidStringCompare(t *testing.T) {
+
+	t.Parallel()
+
+	_, err := ParseAndCheck(t, `
+      let a = "abc"
+      let result = a.compare(1)
+    `)
+
+	errs := ExpectCheckerErrors(t, err, 1)
+
+	assert.IsType(t, &sema.TypeMismatchError{}, errs[0])
+}
+
+func TestCheckStringReplaceAll(t *testing.T) {
+
+	t.Parallel()
+
+	checker, err := ParseAndCheck(t, `
+      let a = "abcabc"
+      let result = a.replaceAll(of: "b", with: "x")
+    `)
+
+	require.NoError(t, err)
+
+	assert.Equal(t,
+		&sema.StringType{},
+		checker.GlobalValues["result"].Type,
+	)
+}
+
+func TestCheckInvalidStringReplaceAll(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("missing argument labels", func(t *testing.T) {
+
+		_, err := ParseAndCheck(t, `
+          let a = "abc"
+          let result = a.replaceAll("b", "x")
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 2)
+
+		assert.IsType(t, &sema.MissingArgumentLabelError{}, errs[0])
+		assert.IsType(t, &sema.MissingArgumentLabelError{}, errs[1])
+	})
+
+	t.Run("invalid argument types", func(t *testing.T) {
+
+		_, err := ParseAndCheck(t, `
+          let a = "abc"
+          let result = a.replaceAll(of: 1, with: 2)
+        `)
+
+		errs := ExpectCheckerErrors(t, err, 2)
+
+		assert.IsType(t, &sema.TypeMismatchError{}, errs[0])
+		assert.IsType(t, &sema.TypeMismatchError{}, errs[1])
+	})
+}
+
+func TestCheckStringCount(t *testing.T) {
+
+	t.Parallel()
+
+	checker, err := ParseAndCheck(t, `
+      let a = "abcabc"
+      let result = a.count("b")
+    `)
+
+	require.NoError(t, err)
+
+	assert.Equal(t,
+		&sema.IntType{},
+		checker.GlobalValues["result"].Type,
+	)
+}
+
+func TestCheckInvalidStringCount(t *testing.T) {
+
+	t.Parallel()
+
+	_, err := ParseAndCheck(t, `
+      let a = "abc"
+      let result = a.count(1)
+    `)
+
+	errs := ExpectCheckerErrors(t, err, 1)
+
+	assert.IsType(t, &sema.TypeMismatchError{}, errs[0])
+}
+
 func TestCheckStringSlice(t *testing.T) {
 
 	t.Parallel()
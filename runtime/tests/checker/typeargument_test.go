@@ -107,7 +107,7 @@ func TestCheckTypeArguments(t *testing.T) {
 
 		errs := ExpectCheckerErrors(t, err, 1)
 
-		assert.IsType(t, &sema.TypeMismatchError{}, errs[0])
+		assert.IsType(t, &sema.InvalidCapabilityLinkTypeError{}, errs[0])
 	})
 
 	t.Run("capability, instantiation with two arguments", func(t *testing.T) {
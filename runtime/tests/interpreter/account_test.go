@@ -20,6 +20,7 @@ package interpreter_test
 
 import (
 	"fmt"
+	"sort"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -108,17 +109,28 @@ func testAccount(t *testing.T, auth bool, code string) (*interpreter.Interpreter
 		return value
 	}
 
+	storageKeys := func(_ *interpreter.Interpreter, _ common.Address) []string {
+		keys := make([]string, 0, len(storedValues))
+		for key := range storedValues {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		return keys
+	}
+
 	inter := parseCheckAndInterpretWithOptions(t,
 		code,
 		ParseCheckAndInterpretOptions{
 			CheckerOptions: []sema.Option{
 				sema.WithPredeclaredValues(valueDeclarations),
+				sema.WithStorageIteration(true),
 			},
 			Options: []interpreter.Option{
 				interpreter.WithPredefinedValues(values),
 				interpreter.WithStorageExistenceHandler(storageChecker),
 				interpreter.WithStorageReadHandler(storageGetter),
 				interpreter.WithStorageWriteHandler(storageSetter),
+				interpreter.WithStorageKeysHandler(storageKeys),
 			},
 		},
 	)
@@ -295,6 +307,113 @@ func TestInterpretAuthAccount_save(t *testing.T) {
 	})
 }
 
+func TestInterpretAuthAccount_trySave(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("valid", func(t *testing.T) {
+
+		inter, storedValues := testAccount(
+			t,
+			true,
+			`
+              struct S {
+                  let id: Int
+
+                  init(id: Int) {
+                      self.id = id
+                  }
+              }
+
+              fun test(id: Int): Bool {
+                  return account.trySave(S(id: id), to: /storage/s)
+              }
+            `,
+		)
+
+		// Save first value
+
+		t.Run("initial save", func(t *testing.T) {
+
+			value, err := inter.Invoke("test", interpreter.NewIntValueFromInt64(1))
+			require.NoError(t, err)
+
+			assert.Equal(t, interpreter.BoolValue(true), value)
+
+			require.Len(t, storedValues, 1)
+			for _, value := range storedValues {
+
+				require.IsType(t, &interpreter.SomeValue{}, value)
+
+				innerValue := value.(*interpreter.SomeValue).Value
+
+				require.IsType(t, &interpreter.CompositeValue{}, innerValue)
+
+				assert.Equal(t,
+					interpreter.NewIntValueFromInt64(1),
+					innerValue.(*interpreter.CompositeValue).Fields["id"],
+				)
+			}
+		})
+
+		// Attempt to save again, the stored value should be left unchanged
+
+		t.Run("second save", func(t *testing.T) {
+
+			value, err := inter.Invoke("test", interpreter.NewIntValueFromInt64(2))
+			require.NoError(t, err)
+
+			assert.Equal(t, interpreter.BoolValue(false), value)
+
+			require.Len(t, storedValues, 1)
+			for _, value := range storedValues {
+
+				require.IsType(t, &interpreter.SomeValue{}, value)
+
+				innerValue := value.(*interpreter.SomeValue).Value
+
+				require.IsType(t, &interpreter.CompositeValue{}, innerValue)
+
+				assert.Equal(t,
+					interpreter.NewIntValueFromInt64(1),
+					innerValue.(*interpreter.CompositeValue).Fields["id"],
+				)
+			}
+		})
+	})
+
+	for _, domain := range common.AllPathDomainsByIdentifier {
+
+		if domain == common.PathDomainStorage {
+			continue
+		}
+
+		t.Run(fmt.Sprintf("invalid: %s domain", domain), func(t *testing.T) {
+
+			inter, _ := testAccount(
+				t,
+				true,
+				fmt.Sprintf(
+					`
+                      struct S {}
+
+                      fun test(): Bool {
+                          return account.trySave(S(), to: /%s/s)
+                      }
+                    `,
+					domain.Identifier(),
+				),
+			)
+
+			_, err := inter.Invoke("test")
+
+			require.Error(t, err)
+
+			require.IsType(t, &interpreter.InvalidPathDomainError{}, err)
+		})
+	}
+}
+
 func TestInterpretAuthAccount_load(t *testing.T) {
 
 	t.Parallel()
@@ -522,6 +641,80 @@ func TestInterpretAuthAccount_load(t *testing.T) {
 	})
 }
 
+func TestInterpretAuthAccount_loadStrict(t *testing.T) {
+
+	t.Parallel()
+
+	inter, storedValues := testAccount(
+		t,
+		true,
+		`
+          resource R {}
+
+          resource R2 {}
+
+          fun save() {
+              let r <- create R()
+              account.save(<-r, to: /storage/r)
+          }
+
+          fun loadR(): @R? {
+              return <-account.loadStrict<@R>(from: /storage/r)
+          }
+
+          fun loadR2(): @R2? {
+              return <-account.loadStrict<@R2>(from: /storage/r)
+          }
+        `,
+	)
+
+	t.Run("empty", func(t *testing.T) {
+
+		value, err := inter.Invoke("loadR")
+		require.NoError(t, err)
+
+		require.IsType(t, interpreter.NilValue{}, value)
+	})
+
+	t.Run("mismatching", func(t *testing.T) {
+
+		_, err := inter.Invoke("save")
+		require.NoError(t, err)
+
+		require.Len(t, storedValues, 1)
+
+		_, err = inter.Invoke("loadR2")
+		require.Error(t, err)
+
+		require.IsType(t, &interpreter.StoredValueTypeMismatchError{}, err)
+
+		assert.Equal(t,
+			"stored value has type `R`, which is not a subtype of `R2`",
+			err.Error(),
+		)
+
+		// NOTE: check value was *not* removed from storage
+		require.Len(t, storedValues, 1)
+	})
+
+	t.Run("matching", func(t *testing.T) {
+
+		require.Len(t, storedValues, 1)
+
+		value, err := inter.Invoke("loadR")
+		require.NoError(t, err)
+
+		require.IsType(t, &interpreter.SomeValue{}, value)
+
+		innerValue := value.(*interpreter.SomeValue).Value
+
+		assert.IsType(t, &interpreter.CompositeValue{}, innerValue)
+
+		// NOTE: check loaded value was removed from storage
+		require.Len(t, storedValues, 0)
+	})
+}
+
 func TestInterpretAuthAccount_copy(t *testing.T) {
 
 	t.Parallel()
@@ -642,6 +835,223 @@ func TestInterpretAuthAccount_copy(t *testing.T) {
 
 }
 
+func TestInterpretAuthAccount_type(t *testing.T) {
+
+	t.Parallel()
+
+	const code = `
+      resource R {}
+
+      struct S {}
+
+      let rType = Type<@R>()
+
+      let sType = Type<S>()
+
+      fun saveR() {
+          account.save(<-create R(), to: /storage/r)
+      }
+
+      fun saveS() {
+          account.save(S(), to: /storage/s)
+      }
+
+      fun typeR(): Type? {
+          return account.type(at: /storage/r)
+      }
+
+      fun typeS(): Type? {
+          return account.type(at: /storage/s)
+      }
+    `
+
+	t.Run("present", func(t *testing.T) {
+
+		inter, storedValues := testAccount(t, true, code)
+
+		_, err := inter.Invoke("saveR")
+		require.NoError(t, err)
+
+		_, err = inter.Invoke("saveS")
+		require.NoError(t, err)
+
+		require.Len(t, storedValues, 2)
+
+		value, err := inter.Invoke("typeR")
+		require.NoError(t, err)
+
+		assert.Equal(t,
+			interpreter.NewSomeValueOwningNonCopying(
+				inter.Globals["rType"].Value,
+			),
+			value,
+		)
+
+		value, err = inter.Invoke("typeS")
+		require.NoError(t, err)
+
+		assert.Equal(t,
+			interpreter.NewSomeValueOwningNonCopying(
+				inter.Globals["sType"].Value,
+			),
+			value,
+		)
+
+		// NOTE: check the values were *not* removed from storage
+		require.Len(t, storedValues, 2)
+	})
+
+	t.Run("absent", func(t *testing.T) {
+
+		inter, _ := testAccount(t, true, code)
+
+		value, err := inter.Invoke("typeR")
+		require.NoError(t, err)
+
+		require.IsType(t, interpreter.NilValue{}, value)
+	})
+
+	for _, domain := range common.AllPathDomainsByIdentifier {
+
+		if domain == common.PathDomainStorage {
+			continue
+		}
+
+		t.Run(fmt.Sprintf("invalid: %s domain", domain), func(t *testing.T) {
+
+			inter, _ := testAccount(
+				t,
+				true,
+				fmt.Sprintf(
+					`
+                      fun test(): Type? {
+                          return account.type(at: /%s/s)
+                      }
+                    `,
+					domain.Identifier(),
+				),
+			)
+
+			_, err := inter.Invoke("test")
+
+			require.Error(t, err)
+
+			require.IsType(t, &interpreter.InvalidPathDomainError{}, err)
+		})
+	}
+}
+
+func TestInterpretAuthAccount_forEachStored(t *testing.T) {
+
+	t.Parallel()
+
+	const code = `
+      resource R {}
+
+      struct S {}
+
+      pub var paths: [Path] = []
+
+      pub var types: [Type] = []
+
+      fun save() {
+          account.save(<-create R(), to: /storage/r)
+          account.save(S(), to: /storage/s)
+          account.link<&R>(/public/r, target: /storage/r)
+      }
+
+      fun collect(_ path: Path, _ type: Type): Bool {
+          paths.append(path)
+          types.append(type)
+          return true
+      }
+
+      fun collectFirst(_ path: Path, _ type: Type): Bool {
+          paths.append(path)
+          types.append(type)
+          return false
+      }
+
+      fun forEachStored() {
+          account.forEachStored(collect)
+      }
+
+      fun forEachStoredFirst() {
+          account.forEachStored(collectFirst)
+      }
+
+      fun testTypes(): Bool {
+          return types[0] == Type<@R>() && types[1] == Type<S>()
+      }
+    `
+
+	t.Run("all", func(t *testing.T) {
+
+		inter, storedValues := testAccount(t, true, code)
+
+		_, err := inter.Invoke("save")
+		require.NoError(t, err)
+
+		require.Len(t, storedValues, 3)
+
+		_, err = inter.Invoke("forEachStored")
+		require.NoError(t, err)
+
+		assert.Equal(t,
+			interpreter.NewArrayValueUnownedNonCopying(
+				interpreter.PathValue{
+					Domain:     common.PathDomainStorage,
+					Identifier: "r",
+				},
+				interpreter.PathValue{
+					Domain:     common.PathDomainStorage,
+					Identifier: "s",
+				},
+			),
+			inter.Globals["paths"].Value,
+		)
+
+		value, err := inter.Invoke("testTypes")
+		require.NoError(t, err)
+
+		assert.Equal(t, interpreter.BoolValue(true), value)
+	})
+
+	t.Run("stop", func(t *testing.T) {
+
+		inter, _ := testAccount(t, true, code)
+
+		_, err := inter.Invoke("save")
+		require.NoError(t, err)
+
+		_, err = inter.Invoke("forEachStoredFirst")
+		require.NoError(t, err)
+
+		assert.Equal(t,
+			interpreter.NewArrayValueUnownedNonCopying(
+				interpreter.PathValue{
+					Domain:     common.PathDomainStorage,
+					Identifier: "r",
+				},
+			),
+			inter.Globals["paths"].Value,
+		)
+	})
+
+	t.Run("empty", func(t *testing.T) {
+
+		inter, _ := testAccount(t, true, code)
+
+		_, err := inter.Invoke("forEachStored")
+		require.NoError(t, err)
+
+		assert.Equal(t,
+			interpreter.NewArrayValueUnownedNonCopying(),
+			inter.Globals["paths"].Value,
+		)
+	})
+}
+
 func TestInterpretAuthAccount_borrow(t *testing.T) {
 
 	t.Parallel()
@@ -1694,3 +2104,77 @@ func TestInterpretAccount_getCapability(t *testing.T) {
 		}
 	}
 }
+
+func TestInterpretAccount_capability(t *testing.T) {
+
+	t.Parallel()
+
+	tests := map[bool][]common.PathDomain{
+		true: {
+			common.PathDomainPublic,
+			common.PathDomainPrivate,
+		},
+		false: {
+			common.PathDomainPublic,
+		},
+	}
+
+	for auth, validDomains := range tests {
+
+		for _, domain := range common.AllPathDomainsByIdentifier {
+
+			testName := fmt.Sprintf(
+				"auth: %v, domain: %s",
+				auth, domain,
+			)
+
+			auth := auth
+			validDomains := validDomains
+			domain := domain
+
+			t.Run(testName, func(t *testing.T) {
+
+				inter, _ := testAccount(
+					t,
+					auth,
+					fmt.Sprintf(
+						`
+                          fun test(): Capability<&Int> {
+                              return account.capability<&Int>(/%s/r)
+                          }
+                        `,
+						domain.Identifier(),
+					),
+				)
+
+				value, err := inter.Invoke("test")
+
+				for _, validDomain := range validDomains {
+
+					if domain != validDomain {
+						continue
+					}
+
+					require.NoError(t, err)
+
+					require.IsType(t, interpreter.CapabilityValue{}, value)
+
+					assert.Equal(t,
+						interpreter.ConvertSemaToStaticType(
+							&sema.ReferenceType{
+								Type: &sema.IntType{},
+							},
+						),
+						value.(interpreter.CapabilityValue).BorrowType,
+					)
+
+					return
+				}
+
+				require.Error(t, err)
+
+				require.IsType(t, &interpreter.InvalidPathDomainError{}, err)
+			})
+		}
+	}
+}
@@ -20,9 +20,12 @@ package interpreter_test
 
 import (
 	"fmt"
+	"math"
+	"math/big"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	"github.com/onflow/cadence/runtime/interpreter"
 	"github.com/onflow/cadence/runtime/sema"
@@ -111,6 +114,34 @@ func TestInterpretToBytes(t *testing.T) {
 	})
 }
 
+func TestInterpretAddressToBigEndianBytes(t *testing.T) {
+
+	inter := parseCheckAndInterpret(t, `
+      let x: Address = 0x123456
+      let y = x.toBigEndianBytes()
+      let z = x.toBytes()
+    `)
+
+	assert.Equal(t,
+		interpreter.NewArrayValueUnownedNonCopying(
+			interpreter.UInt8Value(0x0),
+			interpreter.UInt8Value(0x0),
+			interpreter.UInt8Value(0x0),
+			interpreter.UInt8Value(0x0),
+			interpreter.UInt8Value(0x0),
+			interpreter.UInt8Value(0x12),
+			interpreter.UInt8Value(0x34),
+			interpreter.UInt8Value(0x56),
+		),
+		inter.Globals["y"].Value,
+	)
+
+	assert.Equal(t,
+		inter.Globals["z"].Value,
+		inter.Globals["y"].Value,
+	)
+}
+
 func TestInterpretToBigEndianBytes(t *testing.T) {
 
 	typeTests := map[string]map[string][]byte{
@@ -311,3 +342,1080 @@ func TestInterpretToBigEndianBytes(t *testing.T) {
 		}
 	}
 }
+
+func TestInterpretFromBigEndianBytes(t *testing.T) {
+
+	t.Run("round trip", func(t *testing.T) {
+
+		typeTests := map[string][]string{
+			"Int":     {"0", "42", "128", "-1", "-200", "-10000000000000000", "100000000000000000000000"},
+			"Int8":    {"0", "42", "127", "-1", "-128"},
+			"Int16":   {"0", "42", "32767", "-1", "-32768"},
+			"Int32":   {"0", "42", "2147483647", "-1", "-2147483648"},
+			"Int64":   {"0", "42", "9223372036854775807", "-1", "-9223372036854775808"},
+			"Int128":  {"0", "42", "128", "-1", "-200", "-10000000000000000"},
+			"Int256":  {"0", "42", "128", "-1", "-200", "-10000000000000000"},
+			"UInt":    {"0", "42", "128", "255", "100000000000000000000000"},
+			"UInt8":   {"0", "42", "128", "255"},
+			"UInt16":  {"0", "42", "32768", "65535"},
+			"UInt32":  {"0", "42", "2147483648", "4294967295"},
+			"UInt64":  {"0", "42", "9223372036854775808", "18446744073709551615"},
+			"UInt128": {"0", "42", "128", "200"},
+			"UInt256": {"0", "42", "128", "200"},
+			"Word8":   {"0", "42", "128", "255"},
+			"Word16":  {"0", "42", "32768", "65535"},
+			"Word32":  {"0", "42", "2147483648", "4294967295"},
+			"Word64":  {"0", "42", "9223372036854775808", "18446744073709551615"},
+		}
+
+		// Ensure the test cases are complete
+
+		for _, integerType := range sema.AllIntegerTypes {
+			switch integerType.(type) {
+			case *sema.IntegerType, *sema.SignedIntegerType:
+				continue
+			}
+
+			if _, ok := typeTests[integerType.String()]; !ok {
+				panic(fmt.Sprintf("broken test: missing %s", integerType))
+			}
+		}
+
+		for ty, values := range typeTests {
+
+			for _, value := range values {
+
+				t.Run(fmt.Sprintf("%s: %s", ty, value), func(t *testing.T) {
+
+					inter := parseCheckAndInterpret(t,
+						fmt.Sprintf(
+							`
+	                          let value: %[1]s = %[2]s
+	                          let result = %[1]s.fromBigEndianBytes(value.toBigEndianBytes())
+	                        `,
+							ty,
+							value,
+						),
+					)
+
+					assert.Equal(t,
+						interpreter.NewSomeValueOwningNonCopying(
+							inter.Globals["value"].Value,
+						),
+						inter.Globals["result"].Value,
+					)
+				})
+			}
+		}
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+
+		typeTests := map[string][]string{
+			// too few bytes
+			"Int64":  {"[1 as UInt8]"},
+			"UInt16": {"[]"},
+			"Word32": {"[1 as UInt8, 2 as UInt8]"},
+			// too many bytes
+			"Int8":    {"[0 as UInt8, 1 as UInt8]"},
+			"UInt8":   {"[0 as UInt8, 1 as UInt8]"},
+			"UInt128": {"UInt256(340282366920938463463374607431768211456).toBigEndianBytes()"},
+			"Int128":  {"Int256(170141183460469231731687303715884105728).toBigEndianBytes()"},
+		}
+
+		for ty, values := range typeTests {
+
+			for _, value := range values {
+
+				t.Run(fmt.Sprintf("%s: %s", ty, value), func(t *testing.T) {
+
+					inter := parseCheckAndInterpret(t,
+						fmt.Sprintf(
+							`
+	                          let bytes: [UInt8] = %[2]s
+	                          let result = %[1]s.fromBigEndianBytes(bytes)
+	                        `,
+							ty,
+							value,
+						),
+					)
+
+					assert.Equal(t,
+						interpreter.NilValue{},
+						inter.Globals["result"].Value,
+					)
+				})
+			}
+		}
+	})
+}
+
+func TestInterpretNumberTypeMinMax(t *testing.T) {
+
+	type bounds struct {
+		min interpreter.Value
+		max interpreter.Value
+	}
+
+	typeTests := map[string]bounds{
+		"Int": {},
+		"Int8": {
+			min: interpreter.Int8Value(math.MinInt8),
+			max: interpreter.Int8Value(math.MaxInt8),
+		},
+		"Int16": {
+			min: interpreter.Int16Value(math.MinInt16),
+			max: interpreter.Int16Value(math.MaxInt16),
+		},
+		"Int32": {
+			min: interpreter.Int32Value(math.MinInt32),
+			max: interpreter.Int32Value(math.MaxInt32),
+		},
+		"Int64": {
+			min: interpreter.Int64Value(math.MinInt64),
+			max: interpreter.Int64Value(math.MaxInt64),
+		},
+		"Int128": {
+			min: interpreter.NewInt128ValueFromBigInt(sema.Int128TypeMinIntBig),
+			max: interpreter.NewInt128ValueFromBigInt(sema.Int128TypeMaxIntBig),
+		},
+		"Int256": {
+			min: interpreter.NewInt256ValueFromBigInt(sema.Int256TypeMinIntBig),
+			max: interpreter.NewInt256ValueFromBigInt(sema.Int256TypeMaxIntBig),
+		},
+		"UInt": {
+			min: interpreter.NewUIntValueFromUint64(0),
+		},
+		"UInt8": {
+			min: interpreter.UInt8Value(0),
+			max: interpreter.UInt8Value(math.MaxUint8),
+		},
+		"UInt16": {
+			min: interpreter.UInt16Value(0),
+			max: interpreter.UInt16Value(math.MaxUint16),
+		},
+		"UInt32": {
+			min: interpreter.UInt32Value(0),
+			max: interpreter.UInt32Value(math.MaxUint32),
+		},
+		"UInt64": {
+			min: interpreter.UInt64Value(0),
+			max: interpreter.UInt64Value(math.MaxUint64),
+		},
+		"UInt128": {
+			min: interpreter.NewUInt128ValueFromUint64(0),
+			max: interpreter.NewUInt128ValueFromBigInt(sema.UInt128TypeMaxIntBig),
+		},
+		"UInt256": {
+			min: interpreter.NewUInt256ValueFromUint64(0),
+			max: interpreter.NewUInt256ValueFromBigInt(sema.UInt256TypeMaxIntBig),
+		},
+		"Word8": {
+			min: interpreter.Word8Value(0),
+			max: interpreter.Word8Value(math.MaxUint8),
+		},
+		"Word16": {
+			min: interpreter.Word16Value(0),
+			max: interpreter.Word16Value(math.MaxUint16),
+		},
+		"Word32": {
+			min: interpreter.Word32Value(0),
+			max: interpreter.Word32Value(math.MaxUint32),
+		},
+		"Word64": {
+			min: interpreter.Word64Value(0),
+			max: interpreter.Word64Value(math.MaxUint64),
+		},
+		"Fix64": {
+			min: interpreter.Fix64Value(math.MinInt64),
+			max: interpreter.Fix64Value(math.MaxInt64),
+		},
+		"UFix64": {
+			min: interpreter.UFix64Value(0),
+			max: interpreter.UFix64Value(math.MaxUint64),
+		},
+	}
+
+	// Ensure the test cases are complete
+
+	for _, numberType := range sema.AllNumberTypes {
+		switch numberType.(type) {
+		case *sema.NumberType, *sema.SignedNumberType,
+			*sema.IntegerType, *sema.SignedIntegerType,
+			*sema.FixedPointType, *sema.SignedFixedPointType:
+			continue
+		}
+
+		if _, ok := typeTests[numberType.String()]; !ok {
+			panic(fmt.Sprintf("broken test: missing %s", numberType))
+		}
+	}
+
+	for ty, test := range typeTests {
+
+		for name, expected := range map[string]interpreter.Value{
+			sema.NumberTypeMinFieldName: test.min,
+			sema.NumberTypeMaxFieldName: test.max,
+		} {
+
+			if expected == nil {
+				continue
+			}
+
+			t.Run(fmt.Sprintf("%s.%s", ty, name), func(t *testing.T) {
+
+				inter := parseCheckAndInterpret(t,
+					fmt.Sprintf(
+						`
+                          let value = %s(1)
+                          let result = value.%s
+                        `,
+						ty,
+						name,
+					),
+				)
+
+				assert.Equal(t,
+					expected,
+					inter.Globals["result"].Value,
+				)
+			})
+		}
+	}
+}
+
+func TestInterpretNumberTypeClamp(t *testing.T) {
+
+	for _, ty := range sema.AllIntegerTypes {
+
+		switch ty.(type) {
+		case *sema.IntegerType, *sema.SignedIntegerType:
+			continue
+		}
+
+		t.Run(ty.String(), func(t *testing.T) {
+
+			inter := parseCheckAndInterpret(t,
+				fmt.Sprintf(
+					`
+                      let below = %[1]s(1).clamp(min: 2, max: 4)
+                      let within = %[1]s(3).clamp(min: 2, max: 4)
+                      let above = %[1]s(5).clamp(min: 2, max: 4)
+                    `,
+					ty,
+				),
+			)
+
+			for name, expected := range map[string]int{
+				"below":  2,
+				"within": 3,
+				"above":  4,
+			} {
+				assert.Equal(t,
+					expected,
+					inter.Globals[name].Value.(interpreter.NumberValue).ToInt(),
+				)
+			}
+		})
+	}
+
+	t.Run("Fix64", func(t *testing.T) {
+
+		inter := parseCheckAndInterpret(t, `
+          let x: Fix64 = -1.5
+          let y = x.clamp(min: -1.25, max: 2.0)
+        `)
+
+		assert.Equal(t,
+			interpreter.Fix64Value(-125_000_000),
+			inter.Globals["y"].Value,
+		)
+	})
+
+	t.Run("invalid range", func(t *testing.T) {
+
+		inter := parseCheckAndInterpret(t, `
+          fun test(): Int {
+              let x = 3
+              return x.clamp(min: 4, max: 2)
+          }
+        `)
+
+		_, err := inter.Invoke("test")
+		require.Error(t, err)
+
+		assert.IsType(t, &interpreter.InvalidClampRangeError{}, err)
+	})
+}
+
+func TestInterpretNumberTypePow(t *testing.T) {
+
+	for _, ty := range sema.AllIntegerTypes {
+
+		switch ty.(type) {
+		case *sema.IntegerType, *sema.SignedIntegerType:
+			continue
+		}
+
+		t.Run(ty.String(), func(t *testing.T) {
+
+			inter := parseCheckAndInterpret(t,
+				fmt.Sprintf(
+					`
+                      let zero = %[1]s(3).pow(0)
+                      let one = %[1]s(3).pow(1)
+                      let four = %[1]s(3).pow(4)
+                    `,
+					ty,
+				),
+			)
+
+			for name, expected := range map[string]int{
+				"zero": 1,
+				"one":  3,
+				"four": 81,
+			} {
+				assert.Equal(t,
+					expected,
+					inter.Globals[name].Value.(interpreter.NumberValue).ToInt(),
+				)
+			}
+		})
+	}
+
+	t.Run("Int, large", func(t *testing.T) {
+
+		inter := parseCheckAndInterpret(t, `
+          let x = 2
+          let y = x.pow(200)
+        `)
+
+		assert.Equal(t,
+			interpreter.NewIntValueFromBigInt(
+				new(big.Int).Lsh(big.NewInt(1), 200),
+			),
+			inter.Globals["y"].Value,
+		)
+	})
+
+	t.Run("Int8, negative base", func(t *testing.T) {
+
+		inter := parseCheckAndInterpret(t, `
+          let x: Int8 = -2
+          let y = x.pow(7)
+        `)
+
+		assert.Equal(t,
+			interpreter.Int8Value(-128),
+			inter.Globals["y"].Value,
+		)
+	})
+
+	t.Run("Word8, wrap around", func(t *testing.T) {
+
+		inter := parseCheckAndInterpret(t, `
+          let x: Word8 = 2
+          let y = x.pow(9)
+        `)
+
+		assert.Equal(t,
+			interpreter.Word8Value(0),
+			inter.Globals["y"].Value,
+		)
+	})
+
+	t.Run("Fix64", func(t *testing.T) {
+
+		inter := parseCheckAndInterpret(t, `
+          let x: Fix64 = 1.5
+          let y = x.pow(3)
+          let z = x.pow(-2)
+        `)
+
+		assert.Equal(t,
+			interpreter.Fix64Value(337_500_000),
+			inter.Globals["y"].Value,
+		)
+
+		assert.Equal(t,
+			interpreter.Fix64Value(44_444_444),
+			inter.Globals["z"].Value,
+		)
+	})
+
+	for _, ty := range []sema.Type{
+		&sema.UInt8Type{},
+		&sema.Int64Type{},
+		&sema.UInt64Type{},
+		&sema.Int128Type{},
+	} {
+
+		t.Run(fmt.Sprintf("%s, overflow", ty), func(t *testing.T) {
+
+			inter := parseCheckAndInterpret(t,
+				fmt.Sprintf(
+					`
+                      fun test(): %[1]s {
+                          let x: %[1]s = 2
+                          return x.pow(255)
+                      }
+                    `,
+					ty,
+				),
+			)
+
+			_, err := inter.Invoke("test")
+			require.Error(t, err)
+
+			assert.IsType(t, interpreter.OverflowError{}, err)
+		})
+	}
+
+	t.Run("UFix64, overflow", func(t *testing.T) {
+
+		inter := parseCheckAndInterpret(t, `
+          fun test(): UFix64 {
+              let x: UFix64 = 10.0
+              return x.pow(12)
+          }
+        `)
+
+		_, err := inter.Invoke("test")
+		require.Error(t, err)
+
+		assert.IsType(t, interpreter.OverflowError{}, err)
+	})
+}
+
+func TestInterpretSignedNumberTypeAbs(t *testing.T) {
+
+	for _, ty := range sema.AllSignedIntegerTypes {
+
+		switch ty.(type) {
+		case *sema.SignedIntegerType:
+			continue
+		}
+
+		t.Run(ty.String(), func(t *testing.T) {
+
+			inter := parseCheckAndInterpret(t,
+				fmt.Sprintf(
+					`
+                      let x: %[1]s = -42
+                      let y: %[1]s = 42
+                      let negative = x.abs()
+                      let positive = y.abs()
+                    `,
+					ty,
+				),
+			)
+
+			for _, name := range []string{"negative", "positive"} {
+				assert.Equal(t,
+					42,
+					inter.Globals[name].Value.(interpreter.NumberValue).ToInt(),
+				)
+			}
+		})
+	}
+
+	t.Run("Fix64", func(t *testing.T) {
+
+		inter := parseCheckAndInterpret(t, `
+          let x: Fix64 = -1.5
+          let y = x.abs()
+        `)
+
+		assert.Equal(t,
+			interpreter.Fix64Value(150_000_000),
+			inter.Globals["y"].Value,
+		)
+	})
+
+	t.Run("Int8, overflow", func(t *testing.T) {
+
+		inter := parseCheckAndInterpret(t, `
+          fun test(): Int8 {
+              let x: Int8 = -128
+              return x.abs()
+          }
+        `)
+
+		_, err := inter.Invoke("test")
+		require.Error(t, err)
+
+		assert.IsType(t, interpreter.OverflowError{}, err)
+	})
+}
+
+func TestInterpretFixedPointTypeTruncateAndRound(t *testing.T) {
+
+	t.Run("UFix64", func(t *testing.T) {
+
+		inter := parseCheckAndInterpret(t, `
+          let a: UFix64 = 1.5
+          let b: UFix64 = 1.49999999
+          let c: UFix64 = 2.0
+
+          let truncatedA = a.truncate()
+          let roundedA = a.round()
+          let truncatedB = b.truncate()
+          let roundedB = b.round()
+          let truncatedC = c.truncate()
+          let roundedC = c.round()
+        `)
+
+		for name, expected := range map[string]int64{
+			"truncatedA": 1,
+			"roundedA":   2,
+			"truncatedB": 1,
+			"roundedB":   1,
+			"truncatedC": 2,
+			"roundedC":   2,
+		} {
+			assert.Equal(t,
+				interpreter.NewIntValueFromInt64(expected),
+				inter.Globals[name].Value,
+				name,
+			)
+		}
+	})
+
+	t.Run("Fix64", func(t *testing.T) {
+
+		inter := parseCheckAndInterpret(t, `
+          let a: Fix64 = -1.5
+          let b: Fix64 = -1.7
+          let c: Fix64 = -1.2
+
+          let truncatedA = a.truncate()
+          let roundedA = a.round()
+          let truncatedB = b.truncate()
+          let roundedB = b.round()
+          let truncatedC = c.truncate()
+          let roundedC = c.round()
+        `)
+
+		for name, expected := range map[string]int64{
+			"truncatedA": -1,
+			"roundedA":   -2,
+			"truncatedB": -1,
+			"roundedB":   -2,
+			"truncatedC": -1,
+			"roundedC":   -1,
+		} {
+			assert.Equal(t,
+				interpreter.NewIntValueFromInt64(expected),
+				inter.Globals[name].Value,
+				name,
+			)
+		}
+	})
+
+	t.Run("UFix64, max", func(t *testing.T) {
+
+		inter := parseCheckAndInterpret(t, `
+          let x = UFix64(0).max
+          let y = x.round()
+        `)
+
+		assert.Equal(t,
+			interpreter.NewIntValueFromInt64(184467440737),
+			inter.Globals["y"].Value,
+		)
+	})
+}
+
+func TestInterpretFixedPointTypeMulDiv(t *testing.T) {
+
+	t.Run("UFix64", func(t *testing.T) {
+
+		inter := parseCheckAndInterpret(t, `
+          let tiny: UFix64 = 0.00000001
+          let large: UFix64 = 100000000000.0
+
+          // The intermediate product underflows to zero
+          let naiveTiny = (tiny * tiny) / tiny
+          let mulDivTiny = tiny.mulDiv(tiny, tiny)
+
+          let naiveThird = (1.0 * 1.0) / 3.0
+          let mulDivThird = UFix64(1.0).mulDiv(1.0, 3.0)
+
+          let mulDivLarge = large.mulDiv(10.0, 20.0)
+        `)
+
+		for name, expected := range map[string]interpreter.UFix64Value{
+			"naiveTiny":   0,
+			"mulDivTiny":  1,
+			"naiveThird":  33333333,
+			"mulDivThird": 33333333,
+			"mulDivLarge": 50000000000 * sema.Fix64Factor,
+		} {
+			assert.Equal(t,
+				expected,
+				inter.Globals[name].Value,
+				name,
+			)
+		}
+	})
+
+	t.Run("Fix64", func(t *testing.T) {
+
+		inter := parseCheckAndInterpret(t, `
+          let tiny: Fix64 = -0.00000001
+
+          let naiveTiny = (tiny * tiny) / tiny
+          let mulDivTiny = tiny.mulDiv(tiny, tiny)
+
+          // The result is truncated toward zero
+          let mulDivThird = Fix64(-1.0).mulDiv(1.0, 3.0)
+          let mulDivNegatives = Fix64(-1.0).mulDiv(-2.0, -3.0)
+        `)
+
+		for name, expected := range map[string]interpreter.Fix64Value{
+			"naiveTiny":       0,
+			"mulDivTiny":      -1,
+			"mulDivThird":     -33333333,
+			"mulDivNegatives": -66666666,
+		} {
+			assert.Equal(t,
+				expected,
+				inter.Globals[name].Value,
+				name,
+			)
+		}
+	})
+
+	t.Run("naive overflow", func(t *testing.T) {
+
+		inter := parseCheckAndInterpret(t, `
+          fun naive(): UFix64 {
+              let large: UFix64 = 100000000000.0
+              return (large * 10.0) / 20.0
+          }
+        `)
+
+		_, err := inter.Invoke("naive")
+		require.Error(t, err)
+
+		assert.IsType(t, interpreter.OverflowError{}, err)
+	})
+
+	t.Run("division by zero", func(t *testing.T) {
+
+		inter := parseCheckAndInterpret(t, `
+          fun test(): UFix64 {
+              return UFix64(1.0).mulDiv(1.0, 0.0)
+          }
+        `)
+
+		_, err := inter.Invoke("test")
+		require.Error(t, err)
+
+		assert.IsType(t, interpreter.DivisionByZeroError{}, err)
+	})
+
+	t.Run("overflow", func(t *testing.T) {
+
+		inter := parseCheckAndInterpret(t, `
+          fun test(): UFix64 {
+              return UFix64(100000000000.0).mulDiv(10.0, 1.0)
+          }
+        `)
+
+		_, err := inter.Invoke("test")
+		require.Error(t, err)
+
+		assert.IsType(t, interpreter.OverflowError{}, err)
+	})
+
+	t.Run("underflow", func(t *testing.T) {
+
+		inter := parseCheckAndInterpret(t, `
+          fun test(): Fix64 {
+              return Fix64(-90000000000.0).mulDiv(10.0, 1.0)
+          }
+        `)
+
+		_, err := inter.Invoke("test")
+		require.Error(t, err)
+
+		assert.IsType(t, interpreter.UnderflowError{}, err)
+	})
+}
+
+func TestInterpretIntegerTypeGCDAndLCM(t *testing.T) {
+
+	for _, ty := range sema.AllIntegerTypes {
+
+		switch ty.(type) {
+		case *sema.IntegerType, *sema.SignedIntegerType:
+			continue
+		}
+
+		t.Run(ty.String(), func(t *testing.T) {
+
+			inter := parseCheckAndInterpret(t,
+				fmt.Sprintf(
+					`
+                      let x: %[1]s = 12
+                      let y: %[1]s = 18
+                      let z: %[1]s = 0
+                      let gcd = x.gcd(y)
+                      let lcm = x.lcm(y)
+                      let gcdZero = x.gcd(z)
+                      let lcmZero = x.lcm(z)
+                    `,
+					ty,
+				),
+			)
+
+			for name, expected := range map[string]int{
+				"gcd":     6,
+				"lcm":     36,
+				"gcdZero": 12,
+				"lcmZero": 0,
+			} {
+				assert.Equal(t,
+					expected,
+					inter.Globals[name].Value.(interpreter.NumberValue).ToInt(),
+					name,
+				)
+			}
+		})
+	}
+
+	t.Run("Int, negative", func(t *testing.T) {
+
+		inter := parseCheckAndInterpret(t, `
+          let x = -12
+          let gcd = x.gcd(-18)
+          let lcm = x.lcm(18)
+        `)
+
+		assert.Equal(t,
+			interpreter.NewIntValueFromInt64(6),
+			inter.Globals["gcd"].Value,
+		)
+
+		assert.Equal(t,
+			interpreter.NewIntValueFromInt64(36),
+			inter.Globals["lcm"].Value,
+		)
+	})
+
+	t.Run("UInt8, overflow", func(t *testing.T) {
+
+		inter := parseCheckAndInterpret(t, `
+          fun test(): UInt8 {
+              let x: UInt8 = 16
+              return x.lcm(17)
+          }
+        `)
+
+		_, err := inter.Invoke("test")
+		require.Error(t, err)
+
+		assert.IsType(t, interpreter.OverflowError{}, err)
+	})
+
+	t.Run("Word8, overflow", func(t *testing.T) {
+
+		inter := parseCheckAndInterpret(t, `
+          let x: Word8 = 16
+          let lcm = x.lcm(17)
+        `)
+
+		assert.Equal(t,
+			interpreter.Word8Value(16),
+			inter.Globals["lcm"].Value,
+		)
+	})
+}
+
+func TestInterpretIntegerTypeBitwiseFunctions(t *testing.T) {
+
+	for _, ty := range sema.AllIntegerTypes {
+
+		switch ty.(type) {
+		case *sema.IntegerType, *sema.SignedIntegerType:
+			continue
+		}
+
+		t.Run(ty.String(), func(t *testing.T) {
+
+			inter := parseCheckAndInterpret(t,
+				fmt.Sprintf(
+					`
+                      let x: %[1]s = 12
+                      let y: %[1]s = 10
+                      let and = x.bitwiseAnd(y)
+                      let or = x.bitwiseOr(y)
+                      let xor = x.bitwiseXor(y)
+                      let left = x.shiftLeft(2)
+                      let right = x.shiftRight(2)
+                    `,
+					ty,
+				),
+			)
+
+			for name, expected := range map[string]int{
+				"and":   8,
+				"or":    14,
+				"xor":   6,
+				"left":  48,
+				"right": 3,
+			} {
+				assert.Equal(t,
+					expected,
+					inter.Globals[name].Value.(interpreter.NumberValue).ToInt(),
+					name,
+				)
+			}
+		})
+	}
+
+	t.Run("Int, large shift", func(t *testing.T) {
+
+		inter := parseCheckAndInterpret(t, `
+          let x = 1
+          let left = x.shiftLeft(200)
+          let right = left.shiftRight(199)
+        `)
+
+		assert.Equal(t,
+			interpreter.NewIntValueFromBigInt(new(big.Int).Lsh(big.NewInt(1), 200)),
+			inter.Globals["left"].Value,
+		)
+
+		assert.Equal(t,
+			interpreter.NewIntValueFromInt64(2),
+			inter.Globals["right"].Value,
+		)
+	})
+
+	t.Run("Int, negative", func(t *testing.T) {
+
+		inter := parseCheckAndInterpret(t, `
+          let x = -5
+          let left = x.shiftLeft(1)
+          let right = x.shiftRight(1)
+        `)
+
+		assert.Equal(t,
+			interpreter.NewIntValueFromInt64(-10),
+			inter.Globals["left"].Value,
+		)
+
+		assert.Equal(t,
+			interpreter.NewIntValueFromInt64(-3),
+			inter.Globals["right"].Value,
+		)
+	})
+
+	t.Run("UInt8, overflow", func(t *testing.T) {
+
+		inter := parseCheckAndInterpret(t, `
+          fun test(): UInt8 {
+              let x: UInt8 = 0x81
+              return x.shiftLeft(1)
+          }
+        `)
+
+		_, err := inter.Invoke("test")
+		require.Error(t, err)
+
+		assert.IsType(t, interpreter.OverflowError{}, err)
+	})
+
+	t.Run("Word8, overflow", func(t *testing.T) {
+
+		inter := parseCheckAndInterpret(t, `
+          let x: Word8 = 0x81
+          let left = x.shiftLeft(1)
+        `)
+
+		assert.Equal(t,
+			interpreter.Word8Value(2),
+			inter.Globals["left"].Value,
+		)
+	})
+
+	t.Run("Word64, overflow", func(t *testing.T) {
+
+		inter := parseCheckAndInterpret(t, `
+          let x: Word64 = 0xffffffffffffffff
+          let left = x.shiftLeft(4)
+        `)
+
+		assert.Equal(t,
+			interpreter.Word64Value(0xfffffffffffffff0),
+			inter.Globals["left"].Value,
+		)
+	})
+}
+
+func TestInterpretUnsignedIntegerTypeWrappingFunctions(t *testing.T) {
+
+	for ty, max := range map[sema.Type]string{
+		&sema.UInt8Type{}:  "0xff",
+		&sema.UInt16Type{}: "0xffff",
+		&sema.UInt32Type{}: "0xffffffff",
+		&sema.UInt64Type{}: "0xffffffffffffffff",
+	} {
+
+		t.Run(ty.String(), func(t *testing.T) {
+
+			inter := parseCheckAndInterpret(t,
+				fmt.Sprintf(
+					`
+                      let zero: %[1]s = 0
+                      let one: %[1]s = 1
+                      let two: %[1]s = 2
+                      let max: %[1]s = %[2]s
+
+                      let sum = one.wrappingAdd(two) == (3 as %[1]s)
+                      let difference = two.wrappingSubtract(one) == one
+                      let product = two.wrappingMultiply(two) == (4 as %[1]s)
+
+                      let sumOverflow = max.wrappingAdd(two) == one
+                      let differenceUnderflow = zero.wrappingSubtract(one) == max
+                      let productOverflow = max.wrappingMultiply(two) == max - one
+                      let productOverflowMax = max.wrappingMultiply(max) == one
+                    `,
+					ty,
+					max,
+				),
+			)
+
+			for _, name := range []string{
+				"sum",
+				"difference",
+				"product",
+				"sumOverflow",
+				"differenceUnderflow",
+				"productOverflow",
+				"productOverflowMax",
+			} {
+				assert.Equal(t,
+					interpreter.BoolValue(true),
+					inter.Globals[name].Value,
+					name,
+				)
+			}
+		})
+	}
+
+	t.Run("UInt8, exact values", func(t *testing.T) {
+
+		inter := parseCheckAndInterpret(t, `
+          let x: UInt8 = 200
+          let y: UInt8 = 100
+          let sum = x.wrappingAdd(y)
+          let difference = y.wrappingSubtract(x)
+          let product = x.wrappingMultiply(y)
+        `)
+
+		assert.Equal(t,
+			interpreter.UInt8Value(44),
+			inter.Globals["sum"].Value,
+		)
+
+		assert.Equal(t,
+			interpreter.UInt8Value(156),
+			inter.Globals["difference"].Value,
+		)
+
+		assert.Equal(t,
+			interpreter.UInt8Value(32),
+			inter.Globals["product"].Value,
+		)
+	})
+}
+
+func TestInterpretNumberTypeConversionFunctions(t *testing.T) {
+
+	t.Run("valid", func(t *testing.T) {
+
+		inter := parseCheckAndInterpret(t, `
+          let x: UInt8 = 200
+          let a = x.toInt()
+          let b = x.toInt64().toWord8()
+          let c = x.toFix64()
+
+          let y: Fix64 = 1.5
+          let d = y.toUFix64()
+          let e = y.toInt()
+        `)
+
+		assert.Equal(t,
+			interpreter.NewIntValueFromInt64(200),
+			inter.Globals["a"].Value,
+		)
+
+		assert.Equal(t,
+			interpreter.Word8Value(200),
+			inter.Globals["b"].Value,
+		)
+
+		assert.Equal(t,
+			interpreter.Fix64Value(200_00000000),
+			inter.Globals["c"].Value,
+		)
+
+		assert.Equal(t,
+			interpreter.UFix64Value(1_50000000),
+			inter.Globals["d"].Value,
+		)
+
+		assert.Equal(t,
+			interpreter.NewIntValueFromInt64(1),
+			inter.Globals["e"].Value,
+		)
+	})
+
+	t.Run("overflow", func(t *testing.T) {
+
+		inter := parseCheckAndInterpret(t, `
+          fun test(): UInt8 {
+              let x = 300
+              return x.toUInt8()
+          }
+        `)
+
+		_, err := inter.Invoke("test")
+		require.Error(t, err)
+
+		assert.IsType(t, interpreter.OverflowError{}, err)
+	})
+
+	t.Run("underflow", func(t *testing.T) {
+
+		inter := parseCheckAndInterpret(t, `
+          fun test(): UInt8 {
+              let x: Int8 = -1
+              return x.toUInt8()
+          }
+        `)
+
+		_, err := inter.Invoke("test")
+		require.Error(t, err)
+
+		assert.IsType(t, interpreter.UnderflowError{}, err)
+	})
+
+	t.Run("fixed-point underflow", func(t *testing.T) {
+
+		inter := parseCheckAndInterpret(t, `
+          fun test(): UFix64 {
+              let x: Fix64 = -1.5
+              return x.toUFix64()
+          }
+        `)
+
+		_, err := inter.Invoke("test")
+		require.Error(t, err)
+
+		assert.IsType(t, interpreter.UnderflowError{}, err)
+	})
+}
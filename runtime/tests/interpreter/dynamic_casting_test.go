@@ -3052,6 +3052,73 @@ func TestInterpretDynamicCastingAuthorizedStructReferenceType(t *testing.T) {
 	}
 }
 
+func TestInterpretDynamicCastingAuthorizedReferenceDowncast(t *testing.T) {
+
+	t.Parallel()
+
+	for operation := range dynamicCastingOperations {
+
+		t.Run(operation.Symbol(), func(t *testing.T) {
+
+			t.Run("AnyStruct -> struct", func(t *testing.T) {
+
+				testReferenceCastValid(t,
+					`
+                      struct S {}
+                    `,
+					"auth &AnyStruct",
+					"auth &S",
+					operation,
+					false,
+				)
+			})
+
+			t.Run("restricted AnyStruct -> conforming struct", func(t *testing.T) {
+
+				testReferenceCastValid(t,
+					`
+                      struct interface SI {}
+
+                      struct S: SI {}
+                    `,
+					"auth &AnyStruct{SI}",
+					"auth &S",
+					operation,
+					false,
+				)
+			})
+
+			t.Run("AnyStruct -> other struct", func(t *testing.T) {
+
+				testReferenceCastInvalid(t,
+					`
+                      struct S {}
+
+                      struct T {}
+                    `,
+					"auth &AnyStruct",
+					"auth &T",
+					operation,
+					false,
+				)
+			})
+
+			t.Run("AnyResource -> resource", func(t *testing.T) {
+
+				testReferenceCastValid(t,
+					`
+                      resource R {}
+                    `,
+					"auth &AnyResource",
+					"auth &R",
+					operation,
+					true,
+				)
+			})
+		})
+	}
+}
+
 func TestInterpretDynamicCastingUnauthorizedResourceReferenceType(t *testing.T) {
 
 	t.Parallel()
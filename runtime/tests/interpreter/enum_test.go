@@ -0,0 +1,198 @@
+/*
+ * Cadence - The resource-oriented smart contract programming language
+ *
+ * Copyright 2019-2020 Dapper Labs, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package interpreter_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/cadence/runtime/interpreter"
+)
+
+func TestInterpretEnum(t *testing.T) {
+
+	t.Parallel()
+
+	inter := parseCheckAndInterpret(t, `
+      enum E: UInt8 {
+          case a
+          case b
+          case c
+      }
+
+      let a = E.a.rawValue
+      let c = E.c.rawValue
+      let b = E(rawValue: 1)
+      let invalid = E(rawValue: 3)
+      let equal = E.b == E(rawValue: 1)
+      let notEqual = E.a == E.b
+    `)
+
+	assert.Equal(t,
+		interpreter.UInt8Value(0),
+		inter.Globals["a"].Value,
+	)
+
+	assert.Equal(t,
+		interpreter.UInt8Value(2),
+		inter.Globals["c"].Value,
+	)
+
+	require.IsType(t,
+		&interpreter.SomeValue{},
+		inter.Globals["b"].Value,
+	)
+
+	b := inter.Globals["b"].Value.(*interpreter.SomeValue).Value
+
+	require.IsType(t,
+		&interpreter.CompositeValue{},
+		b,
+	)
+
+	assert.Equal(t,
+		interpreter.UInt8Value(1),
+		b.(*interpreter.CompositeValue).Fields["rawValue"],
+	)
+
+	assert.Equal(t,
+		interpreter.NilValue{},
+		inter.Globals["invalid"].Value,
+	)
+
+	assert.Equal(t,
+		interpreter.BoolValue(true),
+		inter.Globals["equal"].Value,
+	)
+
+	assert.Equal(t,
+		interpreter.BoolValue(false),
+		inter.Globals["notEqual"].Value,
+	)
+}
+
+func TestInterpretEnumValues(t *testing.T) {
+
+	t.Parallel()
+
+	inter := parseCheckAndInterpret(t, `
+      enum E: Int16 {
+          case c
+          case a
+          case b
+      }
+
+      fun test(): [Int16] {
+          let rawValues: [Int16] = []
+          for value in E.values {
+              rawValues.append(value.rawValue)
+          }
+          return rawValues
+      }
+
+      let equal = E.values[1] == E.a
+    `)
+
+	value, err := inter.Invoke("test")
+	require.NoError(t, err)
+
+	assert.Equal(t,
+		interpreter.NewArrayValueUnownedNonCopying(
+			interpreter.Int16Value(0),
+			interpreter.Int16Value(1),
+			interpreter.Int16Value(2),
+		),
+		value,
+	)
+
+	assert.Equal(t,
+		interpreter.BoolValue(true),
+		inter.Globals["equal"].Value,
+	)
+}
+
+func TestInterpretEnumInContainer(t *testing.T) {
+
+	t.Parallel()
+
+	inter := parseCheckAndInterpret(t, `
+      enum E: UInt8 {
+          case a
+          case b
+          case c
+      }
+
+      let cases = [E.a, E.b, E.a]
+      let containsA = cases.contains(E.a)
+      let containsC = cases.contains(E.c)
+      let lastIndexOfA = cases.lastIndexOf(of: E.a)
+      let lastIndexOfC = cases.lastIndexOf(of: E.c)
+    `)
+
+	assert.Equal(t,
+		interpreter.BoolValue(true),
+		inter.Globals["containsA"].Value,
+	)
+
+	assert.Equal(t,
+		interpreter.BoolValue(false),
+		inter.Globals["containsC"].Value,
+	)
+
+	assert.Equal(t,
+		interpreter.NewSomeValueOwningNonCopying(
+			interpreter.NewIntValueFromInt64(2),
+		),
+		inter.Globals["lastIndexOfA"].Value,
+	)
+
+	assert.Equal(t,
+		interpreter.NilValue{},
+		inter.Globals["lastIndexOfC"].Value,
+	)
+}
+
+func TestInterpretEnumValuesMutation(t *testing.T) {
+
+	t.Parallel()
+
+	inter := parseCheckAndInterpret(t, `
+      enum E: UInt8 {
+          case a
+          case b
+      }
+
+      fun test(): Int {
+          E.values.removeLast()
+          let values = E.values
+          values.append(E.a)
+          return E.values.length
+      }
+    `)
+
+	value, err := inter.Invoke("test")
+	require.NoError(t, err)
+
+	assert.Equal(t,
+		interpreter.NewIntValueFromInt64(2),
+		value,
+	)
+}
@@ -129,6 +129,34 @@ func TestInterpretAddressConversion(t *testing.T) {
 	)
 }
 
+func TestInterpretAddressComparison(t *testing.T) {
+
+	t.Parallel()
+
+	inter := parseCheckAndInterpret(t, `
+      let small: Address = 0xff
+      let large: Address = 0x0100
+
+      let less = small < large
+      let lessEqual = small <= small
+      let greater = small > large
+      let greaterEqual = large >= small
+    `)
+
+	for name, expected := range map[string]interpreter.BoolValue{
+		"less":         true,
+		"lessEqual":    true,
+		"greater":      false,
+		"greaterEqual": true,
+	} {
+		assert.Equal(t,
+			expected,
+			inter.Globals[name].Value,
+			name,
+		)
+	}
+}
+
 func TestInterpretIntegerLiteralTypeConversionInVariableDeclaration(t *testing.T) {
 
 	t.Parallel()
@@ -571,6 +571,38 @@ func TestInterpretStringIndexingUnicode(t *testing.T) {
 	)
 }
 
+func TestInterpretCharacterMembers(t *testing.T) {
+
+	t.Parallel()
+
+	inter := parseCheckAndInterpret(t, `
+      let str = "caf\u{E9}"
+      let a = str[3].toString()
+      let b = str[3].utf8
+      let c = str[0].utf8
+    `)
+
+	assert.Equal(t,
+		interpreter.NewStringValue("\u00e9"),
+		inter.Globals["a"].Value,
+	)
+
+	assert.Equal(t,
+		interpreter.NewArrayValueUnownedNonCopying(
+			interpreter.UInt8Value(0xc3),
+			interpreter.UInt8Value(0xa9),
+		),
+		inter.Globals["b"].Value,
+	)
+
+	assert.Equal(t,
+		interpreter.NewArrayValueUnownedNonCopying(
+			interpreter.UInt8Value('c'),
+		),
+		inter.Globals["c"].Value,
+	)
+}
+
 type stringSliceTest struct {
 	str           string
 	from          int
@@ -1678,6 +1710,88 @@ func TestInterpretStructureConstructorUseInInitializerAndFunction(t *testing.T)
 	)
 }
 
+func TestInterpretCompositeInitializerOverloading(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("struct", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := parseCheckAndInterpret(t, `
+          struct X {
+              let value: Int
+
+              init(value: Int) {
+                  self.value = value
+              }
+
+              init(double value: Int) {
+                  self.value = value * 2
+              }
+
+              init() {
+                  self.value = 0
+              }
+          }
+
+          let x1 = X(value: 1)
+          let x2 = X(double: 2)
+          let x3 = X()
+        `)
+
+		for name, expected := range map[string]interpreter.Value{
+			"x1": interpreter.NewIntValueFromInt64(1),
+			"x2": interpreter.NewIntValueFromInt64(4),
+			"x3": interpreter.NewIntValueFromInt64(0),
+		} {
+			assert.Equal(t,
+				expected,
+				inter.Globals[name].Value.(*interpreter.CompositeValue).Fields["value"],
+			)
+		}
+	})
+
+	t.Run("resource", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := parseCheckAndInterpret(t, `
+          resource R {
+              let value: String
+
+              init(_ value: Int) {
+                  self.value = value.toString()
+              }
+
+              init(_ first: String, _ second: String) {
+                  self.value = first.concat(second)
+              }
+          }
+
+          fun test(): [String] {
+              let r1 <- create R(1)
+              let r2 <- create R("a", "b")
+              let values = [r1.value, r2.value]
+              destroy r1
+              destroy r2
+              return values
+          }
+        `)
+
+		value, err := inter.Invoke("test")
+		require.NoError(t, err)
+
+		assert.Equal(t,
+			interpreter.NewArrayValueUnownedNonCopying(
+				interpreter.NewStringValue("1"),
+				interpreter.NewStringValue("ab"),
+			),
+			value,
+		)
+	})
+}
+
 func TestInterpretStructureSelfUseInFunction(t *testing.T) {
 
 	t.Parallel()
@@ -3270,6 +3384,113 @@ func TestInterpretOptionalMap(t *testing.T) {
 	})
 }
 
+func TestInterpretOptionalMapRef(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("some", func(t *testing.T) {
+
+		inter := parseCheckAndInterpret(t, `
+          resource R {
+              let n: Int
+
+              init(n: Int) {
+                  self.n = n
+              }
+          }
+
+          fun getN(_ ref: &R): Int {
+              return ref.n
+          }
+
+          fun mapRef(_ r: @R?): Int? {
+              let n = r.mapRef(getN)
+              destroy r
+              return n
+          }
+
+          fun test(): Int? {
+              return mapRef(<-create R(n: 42))
+          }
+        `)
+
+		value, err := inter.Invoke("test")
+		require.NoError(t, err)
+
+		assert.Equal(t,
+			interpreter.NewSomeValueOwningNonCopying(
+				interpreter.NewIntValueFromInt64(42),
+			),
+			value,
+		)
+	})
+
+	t.Run("nil", func(t *testing.T) {
+
+		inter := parseCheckAndInterpret(t, `
+          resource R {
+              let n: Int
+
+              init(n: Int) {
+                  self.n = n
+              }
+          }
+
+          fun getN(_ ref: &R): Int {
+              return ref.n
+          }
+
+          fun mapRef(_ r: @R?): Int? {
+              let n = r.mapRef(getN)
+              destroy r
+              return n
+          }
+
+          fun test(): Int? {
+              return mapRef(nil)
+          }
+        `)
+
+		value, err := inter.Invoke("test")
+		require.NoError(t, err)
+
+		assert.Equal(t,
+			interpreter.NilValue{},
+			value,
+		)
+	})
+}
+
+func TestInterpretOptionalOrElse(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("some", func(t *testing.T) {
+
+		inter := parseCheckAndInterpret(t, `
+          let one: Int? = 42
+          let result = one.orElse(5)
+        `)
+
+		assert.Equal(t,
+			interpreter.NewIntValueFromInt64(42),
+			inter.Globals["result"].Value,
+		)
+	})
+
+	t.Run("nil", func(t *testing.T) {
+
+		inter := parseCheckAndInterpret(t, `
+          let result = (nil as Int?).orElse(5)
+        `)
+
+		assert.Equal(t,
+			interpreter.NewIntValueFromInt64(5),
+			inter.Globals["result"].Value,
+		)
+	})
+}
+
 func TestInterpretCompositeNilEquality(t *testing.T) {
 
 	t.Parallel()
@@ -4225,6 +4446,51 @@ func TestInterpretDictionaryInsertionOrder(t *testing.T) {
 	assert.True(t, actualDict.IsModified())
 }
 
+func TestInterpretDictionaryKeysAndValuesInsertionOrder(t *testing.T) {
+
+	t.Parallel()
+
+	inter := parseCheckAndInterpret(t, `
+      fun test(): {String: Int} {
+          let xs = {"c": 3, "a": 1}
+
+          // A new key is appended
+          xs["b"] = 2
+
+          // An existing key keeps its position
+          xs["c"] = 30
+
+          // A removed and re-inserted key is appended
+          xs.remove(key: "a")
+          xs.insert(key: "a", 10)
+
+          return xs
+      }
+
+      let xs = test()
+      let keys = xs.keys
+      let values = xs.values
+    `)
+
+	assert.Equal(t,
+		interpreter.NewArrayValueUnownedNonCopying(
+			interpreter.NewStringValue("c"),
+			interpreter.NewStringValue("b"),
+			interpreter.NewStringValue("a"),
+		).Values,
+		inter.Globals["keys"].Value.(*interpreter.ArrayValue).Values,
+	)
+
+	assert.Equal(t,
+		interpreter.NewArrayValueUnownedNonCopying(
+			interpreter.NewIntValueFromInt64(30),
+			interpreter.NewIntValueFromInt64(2),
+			interpreter.NewIntValueFromInt64(10),
+		).Values,
+		inter.Globals["values"].Value.(*interpreter.ArrayValue).Values,
+	)
+}
+
 func TestInterpretDictionaryIndexingString(t *testing.T) {
 
 	t.Parallel()
@@ -4990,115 +5256,642 @@ func TestInterpretArrayContains(t *testing.T) {
 	)
 }
 
-func TestInterpretStringConcat(t *testing.T) {
+func TestInterpretArrayLastIndexOf(t *testing.T) {
 
 	t.Parallel()
 
 	inter := parseCheckAndInterpret(t, `
-      fun test(): String {
-          let a = "abc"
-          return a.concat("def")
-      }
+      let xs = [1, 2, 1, 3]
+      let duplicate = xs.lastIndexOf(of: 1)
+      let unique = xs.lastIndexOf(of: 3)
+      let missing = xs.lastIndexOf(of: 4)
     `)
 
-	value, err := inter.Invoke("test")
-	require.NoError(t, err)
+	assert.Equal(t,
+		interpreter.NewSomeValueOwningNonCopying(interpreter.NewIntValueFromInt64(2)),
+		inter.Globals["duplicate"].Value,
+	)
 
 	assert.Equal(t,
-		interpreter.NewStringValue("abcdef"),
-		value,
+		interpreter.NewSomeValueOwningNonCopying(interpreter.NewIntValueFromInt64(3)),
+		inter.Globals["unique"].Value,
+	)
+
+	assert.Equal(t,
+		interpreter.NilValue{},
+		inter.Globals["missing"].Value,
 	)
 }
 
-func TestInterpretStringConcatBound(t *testing.T) {
+func TestInterpretArrayOfTypes(t *testing.T) {
 
 	t.Parallel()
 
+	// Comparing restricted types requires converting them to sema types,
+	// which requires the interpreter
+
 	inter := parseCheckAndInterpret(t, `
-      fun test(): String {
-          let a = "abc"
-          let b = a.concat
-          return b("def")
-      }
+      struct interface SI {}
+
+      let types = [Type<Int>(), Type<AnyStruct{SI}>(), Type<String>()]
+      let contains = types.contains(Type<AnyStruct{SI}>())
+      let doesNotContain = types.contains(Type<@AnyResource>())
+      let index = types.lastIndexOf(of: Type<AnyStruct{SI}>())
+      let missing = types.lastIndexOf(of: Type<@AnyResource>())
     `)
 
-	value, err := inter.Invoke("test")
-	require.NoError(t, err)
+	assert.Equal(t,
+		interpreter.BoolValue(true),
+		inter.Globals["contains"].Value,
+	)
 
 	assert.Equal(t,
-		interpreter.NewStringValue("abcdef"),
-		value,
+		interpreter.BoolValue(false),
+		inter.Globals["doesNotContain"].Value,
+	)
+
+	assert.Equal(t,
+		interpreter.NewSomeValueOwningNonCopying(interpreter.NewIntValueFromInt64(1)),
+		inter.Globals["index"].Value,
+	)
+
+	assert.Equal(t,
+		interpreter.NilValue{},
+		inter.Globals["missing"].Value,
 	)
 }
 
-func TestInterpretDictionaryRemove(t *testing.T) {
+func TestInterpretArrayMinMax(t *testing.T) {
 
 	t.Parallel()
 
 	inter := parseCheckAndInterpret(t, `
-      let xs = {"abc": 1, "def": 2}
-      let removed = xs.remove(key: "abc")
-    `)
-
-	expectedDict := interpreter.NewDictionaryValueUnownedNonCopying(
-		interpreter.NewStringValue("abc"), interpreter.NewIntValueFromInt64(1),
-		interpreter.NewStringValue("def"), interpreter.NewIntValueFromInt64(2),
-	).Copy().(*interpreter.DictionaryValue)
-	expectedDict.Remove(nil, interpreter.LocationRange{}, interpreter.NewStringValue("abc"))
+      let xs = [3, -1, 5, 2]
+      let min = xs.min()
+      let max = xs.max()
 
-	actualDict := inter.Globals["xs"].Value.(*interpreter.DictionaryValue)
+      let empty: [Int8] = []
+      let emptyMin = empty.min()
+      let emptyMax = empty.max()
+    `)
 
 	assert.Equal(t,
-		expectedDict,
-		actualDict,
+		interpreter.NewSomeValueOwningNonCopying(interpreter.NewIntValueFromInt64(-1)),
+		inter.Globals["min"].Value,
 	)
 
 	assert.Equal(t,
-		map[string]interpreter.Value{
-			"def": interpreter.NewIntValueFromInt64(2),
-		},
-		actualDict.Entries,
+		interpreter.NewSomeValueOwningNonCopying(interpreter.NewIntValueFromInt64(5)),
+		inter.Globals["max"].Value,
 	)
 
 	assert.Equal(t,
-		[]interpreter.Value{
-			interpreter.NewStringValue("def"),
-		},
-		actualDict.Keys.Values,
+		interpreter.NilValue{},
+		inter.Globals["emptyMin"].Value,
 	)
 
-	assert.True(t, actualDict.IsModified())
-
 	assert.Equal(t,
-		interpreter.NewSomeValueOwningNonCopying(
-			interpreter.NewIntValueFromInt64(1),
-		),
-		inter.Globals["removed"].Value,
+		interpreter.NilValue{},
+		inter.Globals["emptyMax"].Value,
 	)
 }
 
-func TestInterpretDictionaryInsert(t *testing.T) {
+func TestInterpretArrayFilter(t *testing.T) {
 
 	t.Parallel()
 
 	inter := parseCheckAndInterpret(t, `
-      let xs = {"abc": 1, "def": 2}
-      let inserted = xs.insert(key: "abc", 3)
+      let xs: [Int; 5] = [1, 2, 3, 4, 5]
+      let evens = xs.filter(fun (x: Int): Bool {
+          return x % 2 == 0
+      })
+      let none = xs.filter(fun (x: Int): Bool {
+          return false
+      })
     `)
 
-	expectedDict := interpreter.NewDictionaryValueUnownedNonCopying(
-		interpreter.NewStringValue("abc"), interpreter.NewIntValueFromInt64(1),
-		interpreter.NewStringValue("def"), interpreter.NewIntValueFromInt64(2),
-	).Copy().(*interpreter.DictionaryValue)
-	expectedDict.Insert(
-		nil,
-		interpreter.LocationRange{},
-		interpreter.NewStringValue("abc"),
-		interpreter.NewIntValueFromInt64(3),
+	assert.Equal(t,
+		interpreter.NewArrayValueUnownedNonCopying(
+			interpreter.NewIntValueFromInt64(2),
+			interpreter.NewIntValueFromInt64(4),
+		),
+		inter.Globals["evens"].Value,
 	)
 
-	actualDict := inter.Globals["xs"].Value.(*interpreter.DictionaryValue)
-
+	assert.Equal(t,
+		interpreter.NewArrayValueUnownedNonCopying(),
+		inter.Globals["none"].Value,
+	)
+
+	// the receiver is not modified
+
+	assert.Equal(t,
+		5,
+		inter.Globals["xs"].Value.(*interpreter.ArrayValue).Count(),
+	)
+}
+
+func TestInterpretArraySort(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("sort", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := parseCheckAndInterpret(t, `
+          let xs = [3, -1, 5, 2, -1]
+
+          fun test() {
+              xs.sort()
+          }
+        `)
+
+		_, err := inter.Invoke("test")
+		require.NoError(t, err)
+
+		actualArray := inter.Globals["xs"].Value.(*interpreter.ArrayValue)
+
+		assert.Equal(t,
+			[]interpreter.Value{
+				interpreter.NewIntValueFromInt64(-1),
+				interpreter.NewIntValueFromInt64(-1),
+				interpreter.NewIntValueFromInt64(2),
+				interpreter.NewIntValueFromInt64(3),
+				interpreter.NewIntValueFromInt64(5),
+			},
+			actualArray.Values,
+		)
+
+		assert.True(t, actualArray.IsModified())
+	})
+
+	t.Run("sorted", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := parseCheckAndInterpret(t, `
+          let xs = ["def", "a", "abc"]
+          let ys = xs.sorted()
+        `)
+
+		assert.Equal(t,
+			interpreter.NewArrayValueUnownedNonCopying(
+				interpreter.NewStringValue("a"),
+				interpreter.NewStringValue("abc"),
+				interpreter.NewStringValue("def"),
+			),
+			inter.Globals["ys"].Value,
+		)
+
+		// the receiver is not modified
+
+		assert.Equal(t,
+			[]interpreter.Value{
+				interpreter.NewStringValue("def"),
+				interpreter.NewStringValue("a"),
+				interpreter.NewStringValue("abc"),
+			},
+			inter.Globals["xs"].Value.(*interpreter.ArrayValue).Values,
+		)
+	})
+}
+
+func TestInterpretArrayReduce(t *testing.T) {
+
+	t.Parallel()
+
+	inter := parseCheckAndInterpret(t, `
+      let xs = [1, 2, 3, 4]
+      let sum = xs.reduce(0, fun (sum: Int, x: Int): Int {
+          return sum + x
+      })
+      let digits = xs.reduce("", fun (s: String, x: Int): String {
+          return s.concat(x.toString())
+      })
+
+      let empty: [Int] = []
+      let emptySum = empty.reduce(42, fun (sum: Int, x: Int): Int {
+          return sum + x
+      })
+    `)
+
+	assert.Equal(t,
+		interpreter.NewIntValueFromInt64(10),
+		inter.Globals["sum"].Value,
+	)
+
+	assert.Equal(t,
+		interpreter.NewStringValue("1234"),
+		inter.Globals["digits"].Value,
+	)
+
+	assert.Equal(t,
+		interpreter.NewIntValueFromInt64(42),
+		inter.Globals["emptySum"].Value,
+	)
+}
+
+func TestInterpretArrayWithExactLength(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("matching length", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := parseCheckAndInterpret(t, `
+          let xs = [1, 2, 3]
+          let ys = xs.withExactLength(3)
+        `)
+
+		assert.Equal(t,
+			interpreter.NewArrayValueUnownedNonCopying(
+				interpreter.NewIntValueFromInt64(1),
+				interpreter.NewIntValueFromInt64(2),
+				interpreter.NewIntValueFromInt64(3),
+			),
+			inter.Globals["ys"].Value,
+		)
+	})
+
+	t.Run("mismatching length", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := parseCheckAndInterpret(t, `
+          fun test(): [Int] {
+              let xs = [1, 2, 3]
+              return xs.withExactLength(2)
+          }
+        `)
+
+		_, err := inter.Invoke("test")
+		require.Error(t, err)
+
+		require.IsType(t, &interpreter.ArrayLengthMismatchError{}, err)
+
+		lengthMismatchError := err.(*interpreter.ArrayLengthMismatchError)
+		assert.Equal(t, 2, lengthMismatchError.ExpectedLength)
+		assert.Equal(t, 3, lengthMismatchError.ActualLength)
+	})
+}
+
+func TestInterpretConstantSizedArrayToVariableSized(t *testing.T) {
+
+	t.Parallel()
+
+	inter := parseCheckAndInterpret(t, `
+      let xs: [Int; 3] = [1, 2, 3]
+      let ys = xs.toVariableSized()
+
+      fun test(): [Int; 3] {
+          let ys = xs.toVariableSized()
+          ys[0] = 4
+          return xs
+      }
+    `)
+
+	assert.Equal(t,
+		interpreter.NewArrayValueUnownedNonCopying(
+			interpreter.NewIntValueFromInt64(1),
+			interpreter.NewIntValueFromInt64(2),
+			interpreter.NewIntValueFromInt64(3),
+		),
+		inter.Globals["ys"].Value,
+	)
+
+	// The original array is not modified
+
+	value, err := inter.Invoke("test")
+	require.NoError(t, err)
+
+	assert.Equal(t,
+		interpreter.NewArrayValueUnownedNonCopying(
+			interpreter.NewIntValueFromInt64(1),
+			interpreter.NewIntValueFromInt64(2),
+			interpreter.NewIntValueFromInt64(3),
+		),
+		value,
+	)
+}
+
+func TestInterpretStringConcat(t *testing.T) {
+
+	t.Parallel()
+
+	inter := parseCheckAndInterpret(t, `
+      fun test(): String {
+          let a = "abc"
+          return a.concat("def")
+      }
+    `)
+
+	value, err := inter.Invoke("test")
+	require.NoError(t, err)
+
+	assert.Equal(t,
+		interpreter.NewStringValue("abcdef"),
+		value,
+	)
+}
+
+func TestInterpretStringConcatBound(t *testing.T) {
+
+	t.Parallel()
+
+	inter := parseCheckAndInterpret(t, `
+      fun test(): String {
+          let a = "abc"
+          let b = a.concat
+          return b("def")
+      }
+    `)
+
+	value, err := inter.Invoke("test")
+	require.NoError(t, err)
+
+	assert.Equal(t,
+		interpreter.NewStringValue("abcdef"),
+		value,
+	)
+}
+
+func TestInterpretStringCompare(t *testing.T) {
+
+	t.Parallel()
+
+	for _, testCase := range []struct {
+		a, b     string
+		expected int64
+	}{
+		{"abc", "abc", 0},
+		{"abc", "abd", -1},
+		{"abd", "abc", 1},
+		{"ab", "abc", -1},
+		{"", "a", -1},
+		{"a", "", 1},
+		// code points U+00E9 and U+1F600
+		{"\\u{e9}", "\\u{1F600}", -1},
+		{"\\u{1F600}", "z", 1},
+		// decomposed and precomposed forms are equal
+		{"e\\u{301}", "\\u{e9}", 0},
+	} {
+
+		inter := parseCheckAndInterpret(t,
+			fmt.Sprintf(
+				`
+                  let result = "%s".compare("%s")
+                `,
+				testCase.a,
+				testCase.b,
+			),
+		)
+
+		assert.Equal(t,
+			interpreter.NewIntValueFromInt64(testCase.expected),
+			inter.Globals["result"].Value,
+			fmt.Sprintf("%s, %s", testCase.a, testCase.b),
+		)
+	}
+}
+
+func TestInterpretStringReplaceAll(t *testing.T) {
+
+	t.Parallel()
+
+	for _, testCase := range []struct {
+		s, of, with string
+		expected    string
+	}{
+		{"abcabc", "b", "x", "axcaxc"},
+		{"abc", "d", "x", "abc"},
+		{"abc", "b", "", "ac"},
+		{"", "a", "x", ""},
+		// occurrences are non-overlapping
+		{"aaa", "aa", "b", "ba"},
+	} {
+
+		inter := parseCheckAndInterpret(t,
+			fmt.Sprintf(
+				`
+                  let result = "%s".replaceAll(of: "%s", with: "%s")
+                `,
+				testCase.s,
+				testCase.of,
+				testCase.with,
+			),
+		)
+
+		assert.Equal(t,
+			interpreter.NewStringValue(testCase.expected),
+			inter.Globals["result"].Value,
+			fmt.Sprintf("%s, %s, %s", testCase.s, testCase.of, testCase.with),
+		)
+	}
+
+	t.Run("empty substring", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := parseCheckAndInterpret(t, `
+          fun test(): String {
+              return "abc".replaceAll(of: "", with: "x")
+          }
+        `)
+
+		_, err := inter.Invoke("test")
+		require.Error(t, err)
+
+		require.IsType(t, &interpreter.EmptySubstringError{}, err)
+	})
+}
+
+func TestInterpretStringCount(t *testing.T) {
+
+	t.Parallel()
+
+	for _, testCase := range []struct {
+		s, substring string
+		expected     int64
+	}{
+		{"abcabc", "b", 2},
+		{"abcabc", "abc", 2},
+		{"abc", "d", 0},
+		{"", "a", 0},
+		// occurrences are non-overlapping
+		{"aaaa", "aa", 2},
+	} {
+
+		inter := parseCheckAndInterpret(t,
+			fmt.Sprintf(
+				`
+                  let result = "%s".count("%s")
+                `,
+				testCase.s,
+				testCase.substring,
+			),
+		)
+
+		assert.Equal(t,
+			interpreter.NewIntValueFromInt64(testCase.expected),
+			inter.Globals["result"].Value,
+			fmt.Sprintf("%s, %s", testCase.s, testCase.substring),
+		)
+	}
+
+	t.Run("empty substring", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := parseCheckAndInterpret(t, `
+          fun test(): Int {
+              return "abc".count("")
+          }
+        `)
+
+		_, err := inter.Invoke("test")
+		require.Error(t, err)
+
+		require.IsType(t, &interpreter.EmptySubstringError{}, err)
+	})
+}
+
+func TestInterpretRemoveAll(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("array", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := parseCheckAndInterpret(t, `
+          let xs = [1, 2, 3]
+
+          fun test(): Int {
+              xs.removeAll()
+              xs.append(4)
+              return xs.length
+          }
+        `)
+
+		value, err := inter.Invoke("test")
+		require.NoError(t, err)
+
+		assert.Equal(t,
+			interpreter.NewIntValueFromInt64(1),
+			value,
+		)
+
+		actualArray := inter.Globals["xs"].Value.(*interpreter.ArrayValue)
+
+		assert.Equal(t,
+			[]interpreter.Value{
+				interpreter.NewIntValueFromInt64(4),
+			},
+			actualArray.Values,
+		)
+
+		assert.True(t, actualArray.IsModified())
+	})
+
+	t.Run("dictionary", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := parseCheckAndInterpret(t, `
+          let xs = {"abc": 1, "def": 2}
+
+          fun test(): Int {
+              xs.removeAll()
+              return xs.length
+          }
+        `)
+
+		value, err := inter.Invoke("test")
+		require.NoError(t, err)
+
+		assert.Equal(t,
+			interpreter.NewIntValueFromInt64(0),
+			value,
+		)
+
+		actualDict := inter.Globals["xs"].Value.(*interpreter.DictionaryValue)
+
+		assert.Empty(t, actualDict.Entries)
+		assert.Empty(t, actualDict.Keys.Values)
+		assert.True(t, actualDict.IsModified())
+	})
+}
+
+func TestInterpretDictionaryRemove(t *testing.T) {
+
+	t.Parallel()
+
+	inter := parseCheckAndInterpret(t, `
+      let xs = {"abc": 1, "def": 2}
+      let removed = xs.remove(key: "abc")
+    `)
+
+	expectedDict := interpreter.NewDictionaryValueUnownedNonCopying(
+		interpreter.NewStringValue("abc"), interpreter.NewIntValueFromInt64(1),
+		interpreter.NewStringValue("def"), interpreter.NewIntValueFromInt64(2),
+	).Copy().(*interpreter.DictionaryValue)
+	expectedDict.Remove(nil, interpreter.LocationRange{}, interpreter.NewStringValue("abc"))
+
+	actualDict := inter.Globals["xs"].Value.(*interpreter.DictionaryValue)
+
+	assert.Equal(t,
+		expectedDict,
+		actualDict,
+	)
+
+	assert.Equal(t,
+		map[string]interpreter.Value{
+			"def": interpreter.NewIntValueFromInt64(2),
+		},
+		actualDict.Entries,
+	)
+
+	assert.Equal(t,
+		[]interpreter.Value{
+			interpreter.NewStringValue("def"),
+		},
+		actualDict.Keys.Values,
+	)
+
+	assert.True(t, actualDict.IsModified())
+
+	assert.Equal(t,
+		interpreter.NewSomeValueOwningNonCopying(
+			interpreter.NewIntValueFromInt64(1),
+		),
+		inter.Globals["removed"].Value,
+	)
+}
+
+func TestInterpretDictionaryInsert(t *testing.T) {
+
+	t.Parallel()
+
+	inter := parseCheckAndInterpret(t, `
+      let xs = {"abc": 1, "def": 2}
+      let inserted = xs.insert(key: "abc", 3)
+    `)
+
+	expectedDict := interpreter.NewDictionaryValueUnownedNonCopying(
+		interpreter.NewStringValue("abc"), interpreter.NewIntValueFromInt64(1),
+		interpreter.NewStringValue("def"), interpreter.NewIntValueFromInt64(2),
+	).Copy().(*interpreter.DictionaryValue)
+	expectedDict.Insert(
+		nil,
+		interpreter.LocationRange{},
+		interpreter.NewStringValue("abc"),
+		interpreter.NewIntValueFromInt64(3),
+	)
+
+	actualDict := inter.Globals["xs"].Value.(*interpreter.DictionaryValue)
+
 	require.Equal(t,
 		expectedDict,
 		actualDict,
@@ -5180,6 +5973,207 @@ func TestInterpretDictionaryValues(t *testing.T) {
 	)
 }
 
+func TestInterpretDictionaryForEachValue(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("struct values", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := parseCheckAndInterpret(t, `
+          struct S {
+              let n: Int
+
+              init(n: Int) {
+                  self.n = n
+              }
+          }
+
+          fun test(): [Int] {
+              let dict = {"def": S(n: 2), "abc": S(n: 1), "a": S(n: 3)}
+              let values: [Int] = []
+              dict.forEachValue(fun (s: &S): Bool {
+                  values.append(s.n)
+                  return true
+              })
+              return values
+          }
+        `)
+
+		value, err := inter.Invoke("test")
+		require.NoError(t, err)
+
+		assert.Equal(t,
+			interpreter.NewArrayValueUnownedNonCopying(
+				interpreter.NewIntValueFromInt64(2),
+				interpreter.NewIntValueFromInt64(1),
+				interpreter.NewIntValueFromInt64(3),
+			),
+			value,
+		)
+	})
+
+	t.Run("stop", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := parseCheckAndInterpret(t, `
+          fun test(): Int {
+              let dict = {"def": 2, "abc": 1, "a": 3}
+              var count = 0
+              dict.forEachValue(fun (value: &Int): Bool {
+                  count = count + 1
+                  return count < 2
+              })
+              return count
+          }
+        `)
+
+		value, err := inter.Invoke("test")
+		require.NoError(t, err)
+
+		assert.Equal(t,
+			interpreter.NewIntValueFromInt64(2),
+			value,
+		)
+	})
+
+	t.Run("resource values", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := parseCheckAndInterpret(t, `
+          resource R {
+              let n: Int
+
+              init(n: Int) {
+                  self.n = n
+              }
+          }
+
+          fun sum(_ rs: @{String: R}): Int {
+              var sum = 0
+              rs.forEachValue(fun (r: &R): Bool {
+                  sum = sum + r.n
+                  return true
+              })
+              destroy rs
+              return sum
+          }
+
+          fun test(): Int {
+              return sum(<-{"a": <-create R(n: 1), "b": <-create R(n: 2)})
+          }
+        `)
+
+		value, err := inter.Invoke("test")
+		require.NoError(t, err)
+
+		assert.Equal(t,
+			interpreter.NewIntValueFromInt64(3),
+			value,
+		)
+	})
+}
+
+func TestInterpretDictionaryFilter(t *testing.T) {
+
+	t.Parallel()
+
+	inter := parseCheckAndInterpret(t, `
+      let xs = {"a": 1, "b": 2, "c": 3, "d": 4}
+      let ys = xs.filter(fun (key: String, value: Int): Bool {
+          return key != "d" && value % 2 == 0 || key == "c"
+      })
+    `)
+
+	assert.Equal(t,
+		interpreter.NewDictionaryValueUnownedNonCopying(
+			interpreter.NewStringValue("b"), interpreter.NewIntValueFromInt64(2),
+			interpreter.NewStringValue("c"), interpreter.NewIntValueFromInt64(3),
+		),
+		inter.Globals["ys"].Value,
+	)
+
+	// the receiver is not modified
+
+	assert.Equal(t,
+		4,
+		inter.Globals["xs"].Value.(*interpreter.DictionaryValue).Count(),
+	)
+}
+
+func TestInterpretDictionaryReduce(t *testing.T) {
+
+	t.Parallel()
+
+	inter := parseCheckAndInterpret(t, `
+      let xs = {"a": 1, "b": 2, "c": 3}
+      let sum = xs.reduce(0, fun (sum: Int, key: String, value: Int): Int {
+          return sum + value
+      })
+      let length = xs.reduce(0, fun (length: Int, key: String, value: Int): Int {
+          return length + key.length
+      })
+      let empty = ({} as {String: Int}).reduce("initial", fun (s: String, key: String, value: Int): String {
+          return key
+      })
+    `)
+
+	assert.Equal(t,
+		interpreter.NewIntValueFromInt64(6),
+		inter.Globals["sum"].Value,
+	)
+
+	assert.Equal(t,
+		interpreter.NewIntValueFromInt64(3),
+		inter.Globals["length"].Value,
+	)
+
+	assert.Equal(t,
+		interpreter.NewStringValue("initial"),
+		inter.Globals["empty"].Value,
+	)
+}
+
+func TestInterpretDictionarySortedKeys(t *testing.T) {
+
+	t.Parallel()
+
+	inter := parseCheckAndInterpret(t, `
+      let ints = {3: "c", -1: "a", 2: "b"}.sortedKeys()
+      let strings = {"def": 2, "abc": 1, "a": 3}.sortedKeys()
+      let addresses = {Address(0x2): 2, Address(0x1): 1}.sortedKeys()
+    `)
+
+	assert.Equal(t,
+		interpreter.NewArrayValueUnownedNonCopying(
+			interpreter.NewIntValueFromInt64(-1),
+			interpreter.NewIntValueFromInt64(2),
+			interpreter.NewIntValueFromInt64(3),
+		),
+		inter.Globals["ints"].Value,
+	)
+
+	assert.Equal(t,
+		interpreter.NewArrayValueUnownedNonCopying(
+			interpreter.NewStringValue("a"),
+			interpreter.NewStringValue("abc"),
+			interpreter.NewStringValue("def"),
+		),
+		inter.Globals["strings"].Value,
+	)
+
+	assert.Equal(t,
+		interpreter.NewArrayValueUnownedNonCopying(
+			interpreter.NewAddressValueFromBytes([]byte{0x1}),
+			interpreter.NewAddressValueFromBytes([]byte{0x2}),
+		),
+		inter.Globals["addresses"].Value,
+	)
+}
+
 func TestInterpretDictionaryKeyTypes(t *testing.T) {
 
 	t.Parallel()
@@ -8302,3 +9296,31 @@ func TestInterpretCopyOnReturn(t *testing.T) {
 		value,
 	)
 }
+
+func TestInterpretArrayEncodeHex(t *testing.T) {
+
+	t.Parallel()
+
+	inter := parseCheckAndInterpret(t, `
+      let x: UInt64 = 0x436164656e63ff
+      let hex = x.toBigEndianBytes().encodeHex()
+      let roundTrip = "436164656e636521".decodeHex().encodeHex()
+      let bytes: [UInt8] = []
+      let empty = bytes.encodeHex()
+    `)
+
+	assert.Equal(t,
+		interpreter.NewStringValue("00436164656e63ff"),
+		inter.Globals["hex"].Value,
+	)
+
+	assert.Equal(t,
+		interpreter.NewStringValue("436164656e636521"),
+		inter.Globals["roundTrip"].Value,
+	)
+
+	assert.Equal(t,
+		interpreter.NewStringValue(""),
+		inter.Globals["empty"].Value,
+	)
+}
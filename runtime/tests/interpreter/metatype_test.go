@@ -22,8 +22,12 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	"github.com/onflow/cadence/runtime/interpreter"
+	"github.com/onflow/cadence/runtime/parser2"
+	"github.com/onflow/cadence/runtime/sema"
+	"github.com/onflow/cadence/runtime/tests/utils"
 )
 
 func TestInterpretMetaType(t *testing.T) {
@@ -67,6 +71,36 @@ func TestInterpretMetaType(t *testing.T) {
 		)
 	})
 
+	t.Run("composite equality", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := parseCheckAndInterpret(t, `
+          struct S {}
+          struct T {}
+
+          let s: AnyStruct = S()
+
+          let ss = Type<S>() == Type<S>()
+          let st = Type<S>() == Type<T>()
+          let sOptional = Type<S>() == Type<S?>()
+          let dynamic = s.getType() == Type<S>()
+        `)
+
+		for name, expected := range map[string]bool{
+			"ss":        true,
+			"st":        false,
+			"sOptional": false,
+			"dynamic":   true,
+		} {
+			assert.Equal(t,
+				interpreter.BoolValue(expected),
+				inter.Globals[name].Value,
+				name,
+			)
+		}
+	})
+
 	t.Run("identifier", func(t *testing.T) {
 
 		t.Parallel()
@@ -81,6 +115,72 @@ func TestInterpretMetaType(t *testing.T) {
 			inter.Globals["identifier"].Value,
 		)
 	})
+
+	t.Run("identifier, restricted and capability types", func(t *testing.T) {
+
+		t.Parallel()
+
+		inter := parseCheckAndInterpret(t, `
+          resource interface I1 {}
+
+          resource interface I2 {}
+
+          resource R: I1, I2 {}
+
+          let restrictedReference = Type<&AnyResource{I1, I2}>().identifier
+          let restrictedCompositeReference = Type<auth &R{I1}>().identifier
+          let capability = Type<Capability<&R>>().identifier
+          let restrictedCapability = Type<Capability<&AnyResource{I2}>>().identifier
+          let untypedCapability = Type<Capability>().identifier
+          let optionalRestricted = Type<@AnyResource{I1}?>().identifier
+        `)
+
+		rType := inter.Checker.GlobalTypes["R"].Type
+		i1Type := inter.Checker.GlobalTypes["I1"].Type.(*sema.InterfaceType)
+		i2Type := inter.Checker.GlobalTypes["I2"].Type.(*sema.InterfaceType)
+
+		for name, expectedType := range map[string]sema.Type{
+			"restrictedReference": &sema.ReferenceType{
+				Type: &sema.RestrictedType{
+					Type:         &sema.AnyResourceType{},
+					Restrictions: []*sema.InterfaceType{i1Type, i2Type},
+				},
+			},
+			"restrictedCompositeReference": &sema.ReferenceType{
+				Authorized: true,
+				Type: &sema.RestrictedType{
+					Type:         rType,
+					Restrictions: []*sema.InterfaceType{i1Type},
+				},
+			},
+			"capability": &sema.CapabilityType{
+				BorrowType: &sema.ReferenceType{
+					Type: rType,
+				},
+			},
+			"restrictedCapability": &sema.CapabilityType{
+				BorrowType: &sema.ReferenceType{
+					Type: &sema.RestrictedType{
+						Type:         &sema.AnyResourceType{},
+						Restrictions: []*sema.InterfaceType{i2Type},
+					},
+				},
+			},
+			"untypedCapability": &sema.CapabilityType{},
+			"optionalRestricted": &sema.OptionalType{
+				Type: &sema.RestrictedType{
+					Type:         &sema.AnyResourceType{},
+					Restrictions: []*sema.InterfaceType{i1Type},
+				},
+			},
+		} {
+			assert.Equal(t,
+				interpreter.NewStringValue(string(expectedType.ID())),
+				inter.Globals[name].Value,
+				name,
+			)
+		}
+	})
 }
 
 func TestInterpretIsInstance(t *testing.T) {
@@ -165,3 +265,263 @@ func TestInterpretIsInstance(t *testing.T) {
 		})
 	}
 }
+
+func TestInterpretGetType(t *testing.T) {
+
+	t.Parallel()
+
+	cases := map[string]struct {
+		code   string
+		result bool
+	}{
+		"int": {
+			`
+          let value: AnyStruct = 1
+          let result = value.getType() == Type<Int>()
+			`,
+			true,
+		},
+		"int is not string": {
+			`
+          let value: AnyStruct = 1
+          let result = value.getType() == Type<String>()
+			`,
+			false,
+		},
+		"optional": {
+			`
+          let value: AnyStruct = "abc" as String?
+          let result = value.getType() == Type<String?>()
+			`,
+			true,
+		},
+		"array": {
+			`
+          let value: AnyStruct = [1, 2]
+          let result = value.getType() == Type<[Int]>()
+			`,
+			true,
+		},
+		"heterogeneous array": {
+			`
+          let a: AnyStruct = 1
+          let b: AnyStruct = "2"
+          let value: AnyStruct = [a, b]
+          let result = value.getType() == Type<[AnyStruct]>()
+			`,
+			true,
+		},
+		"dictionary": {
+			`
+          let value: AnyStruct = {"a": true}
+          let result = value.getType() == Type<{String: Bool}>()
+			`,
+			true,
+		},
+		"struct": {
+			`
+          struct S {}
+
+          let value: AnyStruct = S()
+          let result = value.getType() == Type<S>()
+			`,
+			true,
+		},
+		"resource": {
+			`
+          resource R {}
+
+          let r: @AnyResource <- create R()
+          let result = r.getType() == Type<@R>()
+			`,
+			true,
+		},
+		"downcast": {
+			`
+          struct S {}
+
+          let value: AnyStruct = S()
+          let result = value.isInstance(value.getType())
+			`,
+			true,
+		},
+	}
+
+	for name, cases := range cases {
+		t.Run(name, func(t *testing.T) {
+			inter := parseCheckAndInterpret(t, cases.code)
+
+			assert.Equal(t,
+				interpreter.BoolValue(cases.result),
+				inter.Globals["result"].Value,
+			)
+		})
+	}
+}
+
+func BenchmarkInterpretIsInstanceAndTypeEquality(b *testing.B) {
+
+	const code = `
+      struct S {}
+
+      fun test() {
+          let number: AnyStruct = 1
+          let string: AnyStruct = "test"
+          let s: AnyStruct = S()
+          var i = 0
+          while i < 100 {
+              number.isInstance(Type<Int>())
+              string.isInstance(Type<String>())
+              s.isInstance(Type<S>())
+              number.getType() == Type<Int>()
+              s.getType() == Type<S>()
+              i = i + 1
+          }
+      }
+    `
+
+	program, err := parser2.ParseProgram(code)
+	require.NoError(b, err)
+
+	checker, err := sema.NewChecker(
+		program,
+		utils.TestLocation,
+		sema.WithAccessCheckMode(sema.AccessCheckModeNotSpecifiedUnrestricted),
+	)
+	require.NoError(b, err)
+
+	err = checker.Check()
+	require.NoError(b, err)
+
+	inter, err := interpreter.NewInterpreter(checker)
+	require.NoError(b, err)
+
+	err = inter.Interpret()
+	require.NoError(b, err)
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		_, err := inter.Invoke("test")
+		require.NoError(b, err)
+	}
+}
+
+func TestInterpretGetType_UserDefinedMember(t *testing.T) {
+
+	t.Parallel()
+
+	// The user-defined member is used when the value is accessed through its own type,
+	// the built-in function is used when the value is accessed as a top type,
+	// also through a reference
+
+	inter := parseCheckAndInterpret(t, `
+      struct S {
+          fun getType(): Int {
+              return 1
+          }
+      }
+
+      let s = S()
+      let userDefined = s.getType()
+      let builtin = (s as AnyStruct).getType() == Type<S>()
+      let builtinReference = (&s as &AnyStruct).getType() == Type<S>()
+    `)
+
+	assert.Equal(t,
+		interpreter.NewIntValueFromInt64(1),
+		inter.Globals["userDefined"].Value,
+	)
+
+	assert.Equal(t,
+		interpreter.BoolValue(true),
+		inter.Globals["builtin"].Value,
+	)
+
+	assert.Equal(t,
+		interpreter.BoolValue(true),
+		inter.Globals["builtinReference"].Value,
+	)
+}
+
+func TestInterpretDowncast(t *testing.T) {
+
+	t.Parallel()
+
+	inter := parseCheckAndInterpret(t, `
+      struct S {}
+
+      let int: AnyStruct = 1
+      let s: AnyStruct = S()
+
+      let intAsInt = int.downcast<Int>()
+      let intAsString = int.downcast<String>()
+      let intAsInteger = int.downcast<Integer>()
+      let sAsS = s.downcast<S>()
+      let sAsInt = s.downcast<Int>()
+    `)
+
+	assert.Equal(t,
+		interpreter.NewSomeValueOwningNonCopying(interpreter.NewIntValueFromInt64(1)),
+		inter.Globals["intAsInt"].Value,
+	)
+
+	assert.Equal(t,
+		interpreter.NilValue{},
+		inter.Globals["intAsString"].Value,
+	)
+
+	assert.Equal(t,
+		interpreter.NewSomeValueOwningNonCopying(interpreter.NewIntValueFromInt64(1)),
+		inter.Globals["intAsInteger"].Value,
+	)
+
+	require.IsType(t,
+		&interpreter.SomeValue{},
+		inter.Globals["sAsS"].Value,
+	)
+
+	assert.IsType(t,
+		&interpreter.CompositeValue{},
+		inter.Globals["sAsS"].Value.(*interpreter.SomeValue).Value,
+	)
+
+	assert.Equal(t,
+		interpreter.NilValue{},
+		inter.Globals["sAsInt"].Value,
+	)
+}
+
+func TestInterpretDowncast_UserDefinedMember(t *testing.T) {
+
+	t.Parallel()
+
+	inter := parseCheckAndInterpret(t, `
+      struct S {
+          fun downcast(): Int {
+              return 1
+          }
+      }
+
+      let s = S()
+      let userDefined = s.downcast()
+      let builtin = (s as AnyStruct).downcast<Int>()
+      let builtinReference = (&s as &AnyStruct).downcast<Int>()
+    `)
+
+	assert.Equal(t,
+		interpreter.NewIntValueFromInt64(1),
+		inter.Globals["userDefined"].Value,
+	)
+
+	assert.Equal(t,
+		interpreter.NilValue{},
+		inter.Globals["builtin"].Value,
+	)
+
+	assert.Equal(t,
+		interpreter.NilValue{},
+		inter.Globals["builtinReference"].Value,
+	)
+}
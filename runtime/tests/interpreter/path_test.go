@@ -26,6 +26,7 @@ import (
 
 	"github.com/onflow/cadence/runtime/common"
 	"github.com/onflow/cadence/runtime/interpreter"
+	"github.com/onflow/cadence/runtime/sema"
 )
 
 func TestInterpretPath(t *testing.T) {
@@ -55,3 +56,47 @@ func TestInterpretPath(t *testing.T) {
 		})
 	}
 }
+
+func TestInterpretPathConstructor(t *testing.T) {
+
+	t.Parallel()
+
+	for domain, functionName := range sema.PathConstructorFunctionNames {
+
+		t.Run(domain.Name(), func(t *testing.T) {
+
+			inter := parseCheckAndInterpret(t,
+				fmt.Sprintf(
+					`
+                      fun path(_ identifier: String): Path? {
+                          return %s(identifier: identifier)
+                      }
+
+                      let valid = path("foo_1")
+                      let empty = path("")
+                      let slash = path("foo/bar")
+                      let digit = path("1foo")
+                    `,
+					functionName,
+				),
+			)
+
+			assert.Equal(t,
+				interpreter.NewSomeValueOwningNonCopying(
+					interpreter.PathValue{
+						Domain:     domain,
+						Identifier: "foo_1",
+					},
+				),
+				inter.Globals["valid"].Value,
+			)
+
+			for _, name := range []string{"empty", "slash", "digit"} {
+				assert.Equal(t,
+					interpreter.NilValue{},
+					inter.Globals[name].Value,
+				)
+			}
+		})
+	}
+}
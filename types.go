@@ -617,6 +617,36 @@ func (t *ContractType) CompositeInitializers() [][]Parameter {
 	return t.Initializers
 }
 
+// EnumType
+
+type EnumType struct {
+	TypeID       string
+	Identifier   string
+	RawType      Type
+	Fields       []Field
+	Initializers [][]Parameter
+}
+
+func (*EnumType) isType() {}
+
+func (t *EnumType) ID() string {
+	return t.TypeID
+}
+
+func (*EnumType) isCompositeType() {}
+
+func (t *EnumType) CompositeIdentifier() string {
+	return t.Identifier
+}
+
+func (t *EnumType) CompositeFields() []Field {
+	return t.Fields
+}
+
+func (t *EnumType) CompositeInitializers() [][]Parameter {
+	return t.Initializers
+}
+
 // InterfaceType
 
 type InterfaceType interface {